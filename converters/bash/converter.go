@@ -3,18 +3,24 @@ package bash
 import (
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/monstermichl/typeshell/parser"
 	"github.com/monstermichl/typeshell/transpiler"
 )
 
+const argsSlice = "_args" // Slice which holds the script arguments.
+
 type funcInfo struct {
-	name string
+	name       string
+	startIndex int      // Index of the function's first code line.
+	locals     []string // Stores the variables which need to be declared local.
 }
 
 type converter struct {
 	interpreter                   string
+	logicalOperations             []string // Stores the result variables of the currently evaluated logical operations.
 	startCode                     []string
 	code                          []string
 	varCounter                    int
@@ -23,7 +29,20 @@ type converter struct {
 	funcCounter                   int
 	sliceAssignmentHelperRequired bool
 	sliceCopyHelperRequired       bool
+	sliceRangeHelperRequired      bool
 	stringSubscriptHelperRequired bool
+	mapAssignmentHelperRequired   bool
+	mapEvaluationHelperRequired   bool
+	mapDeleteHelperRequired       bool
+	mapKeysHelperRequired         bool
+	floatHelperRequired           bool
+	envGetHelperRequired          bool
+	envSetHelperRequired          bool
+	envUnsetHelperRequired        bool
+	environHelperRequired         bool
+	quoteHelperRequired           bool
+	atoiHelperRequired            bool
+	argsRequired                  bool
 }
 
 func New() *converter {
@@ -33,8 +52,10 @@ func New() *converter {
 	}
 }
 
+// StringToString escapes all characters which have a special meaning within double quotes. Therefore,
+// all values must be embedded in double quotes.
 func (c *converter) StringToString(value string) string {
-	return value
+	return escapeString(value)
 }
 
 func (c *converter) Dump() (string, error) {
@@ -63,35 +84,197 @@ func (c *converter) ProgramEnd() error {
 		// $3: Assignment value
 		// $4: Default value
 		c.addHelper("slice assignment", "_sah",
-			"local _i=${2}",
-			fmt.Sprintf(`local _l=%s`, c.sliceLenString("${1}")),
-			`for ((_c=${_l};_c<${_i};_c++)); do`,
-			c.sliceAssignmentString("${1}", "${_c}", "${4}", false),
+			"local _tsh__i=${2}",
+			fmt.Sprintf(`local _tsh__l=%s`, c.sliceLenString("${1}")),
+			`for ((_tsh__c=${_tsh__l};_tsh__c<${_tsh__i};_tsh__c++)); do`,
+			c.sliceAssignmentString("${1}", "${_tsh__c}", "${4}", false),
 			`done`,
-			c.sliceAssignmentString("${1}", "${_i}", "${3}", false),
+			c.sliceAssignmentString("${1}", "${_tsh__i}", "${3}", false),
 		)
 	}
 
 	if c.sliceCopyHelperRequired {
 		c.addHelper("slice copy", "_sch",
-			"local _i=0",
-			fmt.Sprintf(`local _l=%s`, c.sliceLenString("${2}")),
-			"local _n=$(eval \"echo \\${${1}}\")",
-			"while [ ${_i} -lt ${_l} ]; do",
-			fmt.Sprintf("local _v=%s", c.sliceEvaluationString("${2}", "${_i}")),
-			c.sliceAssignmentString("${_n}", "${_i}", "${_v}", false),
-			"_i=$((${_i}+1))",
+			"local _tsh__i=0",
+			fmt.Sprintf(`local _tsh__l=%s`, c.sliceLenString("${2}")),
+			`local _tsh__n="${!1}"`,
+			"while [ ${_tsh__i} -lt ${_tsh__l} ]; do",
+			`local _tsh__r="${2}[${_tsh__i}]"`,
+			`local _tsh__v="${!_tsh__r}"`,
+			c.sliceAssignmentString("${_tsh__n}", "${_tsh__i}", "${_tsh__v}", false),
+			"_tsh__i=$((${_tsh__i}+1))",
 			"done",
 		)
 	}
 
+	if c.sliceRangeHelperRequired {
+		// Indices are clamped to the slice bounds. The values are appended to the destination slice.
+		//
+		// $1: Destination slice name
+		// $2: Source slice name
+		// $3: Start index
+		// $4: End index (excluded)
+		c.addHelper("slice range", "_srh",
+			fmt.Sprintf(`local _tsh__i=%s`, c.sliceLenString("${1}")),
+			"local _tsh__s=$((${3}))",
+			"local _tsh__e=$((${4}))",
+			fmt.Sprintf(`local _tsh__l=%s`, c.sliceLenString("${2}")),
+			"if [ ${_tsh__s} -lt 0 ]; then _tsh__s=0; fi",
+			"if [ ${_tsh__e} -gt ${_tsh__l} ]; then _tsh__e=${_tsh__l}; fi",
+			"while [ ${_tsh__s} -lt ${_tsh__e} ]; do",
+			`local _tsh__r="${2}[${_tsh__s}]"`,
+			c.sliceAssignmentString("${1}", "${_tsh__i}", "${!_tsh__r}", false),
+			"_tsh__i=$((${_tsh__i}+1))",
+			"_tsh__s=$((${_tsh__s}+1))",
+			"done",
+		)
+	}
+
+	// Map keys are prefixed with "_" because Bash doesn't allow empty keys in associative arrays.
+	if c.mapAssignmentHelperRequired {
+		// $1: Map name
+		// $2: Key
+		// $3: Value
+		c.addHelper("map assignment", "_mah",
+			`local -n _tsh__m="${1}"`,
+			`_tsh__m[_${2}]="${3}"`,
+		)
+	}
+
+	if c.mapEvaluationHelperRequired {
+		// $1: Map name
+		// $2: Key
+		// $3: Default value
+		c.addHelper("map evaluation", "_mgh",
+			`local -n _tsh__m="${1}"`,
+			`if [ -n "${_tsh__m[_${2}]+x}" ]; then`,
+			`_tsh__mv="${_tsh__m[_${2}]}"`,
+			fmt.Sprintf(`_tsh__mok=%s`, transpiler.BoolToString(true)),
+			`else`,
+			`_tsh__mv="${3}"`,
+			fmt.Sprintf(`_tsh__mok=%s`, transpiler.BoolToString(false)),
+			`fi`,
+		)
+	}
+
+	if c.mapDeleteHelperRequired {
+		// $1: Map name
+		// $2: Key
+		c.addHelper("map delete", "_mdh",
+			`local -n _tsh__m="${1}"`,
+			`unset '_tsh__m[_${2}]'`,
+		)
+	}
+
+	if c.mapKeysHelperRequired {
+		// $1: Map name
+		// $2: Slice name
+		c.addHelper("map keys", "_mkh",
+			`local -n _tsh__m="${1}"`,
+			`local -n _tsh__k="${2}"`,
+			`local _tsh__mk`,
+			`_tsh__k=()`,
+			`for _tsh__mk in "${!_tsh__m[@]}"; do`,
+			`_tsh__k+=("${_tsh__mk:1}")`,
+			`done`,
+		)
+	}
+
+	// Bash only supports integer arithmetic, therefore awk is used for float operations and comparisons.
+	if c.floatHelperRequired {
+		// $1: Left value
+		// $2: Operator
+		// $3: Right value
+		c.addHelper("float", "_flh", floatHelperCommand())
+	}
+
 	if c.stringSubscriptHelperRequired {
 		c.addHelper("substring", "_ssh",
-			`_ls=$((${2}))`,
-			`_ll=$(((${3}-${2})+1))`,
-			`_ret="${1:${_ls}:${_ll}}"`,
+			`_tsh__ls=$((${2}))`,
+			`_tsh__ll=$(((${3}-${2})+1))`,
+			`_tsh__ret="${1:${_tsh__ls}:${_tsh__ll}}"`,
+		)
+	}
+
+	// Environment variable names are validated because they are expanded indirectly (e.g. "a[$(ls)]" would
+	// execute ls) and because Bash can only handle valid variable names.
+	if c.envGetHelperRequired {
+		// $1: Name
+		c.addHelper("environment get", "_egh",
+			`_tsh__ret=""`,
+			fmt.Sprintf(`_tsh__ok=%s`, transpiler.BoolToString(false)),
+			fmt.Sprintf(`if %s && [[ "$(declare -p "${1}" 2>/dev/null)" =~ ^declare\ -[a-zA-Z]*x ]]; then`, envNameCheckString()), // Only exported variables belong to the environment.
+			`_tsh__ret="${!1}"`,
+			fmt.Sprintf(`_tsh__ok=%s`, transpiler.BoolToString(true)),
+			"fi",
+		)
+	}
+
+	if c.envSetHelperRequired {
+		// $1: Name
+		// $2: Value
+		c.addHelper("environment set", "_esh",
+			fmt.Sprintf(`if %s; then export "${1}=${2}"; fi`, envNameCheckString()),
 		)
 	}
+
+	if c.envUnsetHelperRequired {
+		// $1: Name
+		c.addHelper("environment unset", "_euh",
+			fmt.Sprintf(`if %s; then unset -v "${1}"; fi`, envNameCheckString()),
+		)
+	}
+
+	if c.environHelperRequired {
+		// $1: Slice name
+		c.addHelper("environment list", "_elh",
+			"local _tsh__i=0",
+			"local _tsh__n",
+			"for _tsh__n in $(compgen -e); do",
+			fmt.Sprintf(`if [[ "${_tsh__n}" == %s* ]]; then continue; fi`, transpiler.VarPrefix),
+			c.sliceAssignmentString("${1}", "${_tsh__i}", "${_tsh__n}=${!_tsh__n}", false),
+			"_tsh__i=$((${_tsh__i}+1))",
+			"done",
+		)
+	}
+
+	if c.quoteHelperRequired {
+		// $1: Value
+		c.addHelper("quote", "_qh",
+			`_tsh__ret="${1//\\/\\\\}"`,
+			`_tsh__ret="${_tsh__ret//\"/\\\"}"`,
+			`_tsh__ret="${_tsh__ret//$'\n'/\\n}"`,
+			`_tsh__ret="${_tsh__ret//$'\r'/\\r}"`,
+			`_tsh__ret="${_tsh__ret//$'\t'/\\t}"`,
+			`_tsh__ret="\"${_tsh__ret}\""`,
+		)
+	}
+
+	if c.atoiHelperRequired {
+		// Leading zeros are removed and the value is evaluated as base 10 to avoid an octal interpretation.
+		// The magnitude of the minimum value exceeds the maximum value, therefore it's assigned directly.
+		//
+		// $1: Value
+		c.addHelper("atoi", "_aih",
+			"_tsh__atv=0",
+			`_tsh__ate=""`,
+			`local _tsh__d="${1#[+-]}"`,
+			`if [[ ! "${_tsh__d}" =~ ^[0-9]+$ ]]; then _tsh__ate="strconv.Atoi: parsing \"${1}\": invalid syntax"; return; fi`,
+			`_tsh__d="${_tsh__d#"${_tsh__d%%[!0]*}"}"`,
+			`local _tsh__l="9223372036854775807"`,
+			`if [[ "${1}" == -* ]]; then _tsh__l="9223372036854775808"; fi`,
+			`if [ ${#_tsh__d} -gt 19 ] || { [ ${#_tsh__d} -eq 19 ] && [[ "${_tsh__d}" > "${_tsh__l}" ]]; }; then _tsh__ate="strconv.Atoi: parsing \"${1}\": value out of range"; return; fi`,
+			`if [ "${_tsh__d}" = "9223372036854775808" ]; then _tsh__atv=$((-9223372036854775807-1)); return; fi`,
+			"_tsh__atv=$((10#${_tsh__d:-0}))",
+			`if [[ "${1}" == -* ]]; then _tsh__atv=$((-_tsh__atv)); fi`,
+		)
+	}
+
+	// The arguments are stored at the top level because $@ holds the function arguments inside functions.
+	if c.argsRequired {
+		c.addStartLine("# global arguments")
+		c.addStartLine(fmt.Sprintf(`%s=("$@")`, c.varName(argsSlice, true)))
+	}
 	return nil
 }
 
@@ -110,16 +293,28 @@ func (c *converter) SliceAssignment(name string, index string, value string, def
 	return nil
 }
 
+func (c *converter) MapAssignment(name string, key string, value string, global bool) error {
+	c.mapAssignmentHelperRequired = true
+	c.addLine(fmt.Sprintf(`_mah %s "%s" "%s"`, c.varEvaluationString(name, global), key, value))
+	return nil
+}
+
+func (c *converter) MapDelete(name string, key string) error {
+	c.mapDeleteHelperRequired = true
+	c.addLine(fmt.Sprintf(`_mdh %s "%s"`, name, key))
+	return nil
+}
+
 func (c *converter) FuncStart(name string, params []string, returnTypes []parser.ValueType) error {
 	c.funcs = append(c.funcs, funcInfo{
-		name: name,
+		name:       name,
+		startIndex: len(c.code),
 	})
 	c.funcCounter++
 	c.addLine(fmt.Sprintf("%s() {", name))
 
 	for i, param := range params {
-		s := c.varAssignmentString(param, fmt.Sprintf("$%d", i+1), false)
-		c.addLine(fmt.Sprintf("local %s", s))
+		c.addLine(fmt.Sprintf(`local %s="${%d}"`, c.varName(param, false), i+1))
 	}
 	return nil
 }
@@ -128,6 +323,12 @@ func (c *converter) FuncEnd() error {
 	c.addLine("}")
 
 	lastIndex := len(c.funcs) - 1
+	currFunc := c.funcs[lastIndex]
+
+	// Declare all function variables local to give each call its own frame (required for recursion).
+	if len(currFunc.locals) > 0 {
+		c.code = slices.Insert(c.code, currFunc.startIndex+1, fmt.Sprintf("local %s", strings.Join(currFunc.locals, " ")))
+	}
 	c.funcs = slices.Delete(c.funcs, lastIndex, lastIndex+1)
 	return nil
 }
@@ -141,7 +342,8 @@ func (c *converter) Return(values []transpiler.ReturnValue) error {
 }
 
 func (c *converter) IfStart(condition string) error {
-	return c.ifStart(condition, "if")
+	c.addLine(fmt.Sprintf("if [ %s -eq %s ]; then", condition, transpiler.BoolToString(true)))
+	return nil
 }
 
 func (c *converter) IfEnd() error {
@@ -149,14 +351,6 @@ func (c *converter) IfEnd() error {
 	return nil
 }
 
-func (c *converter) ElseIfStart(condition string) error {
-	return c.ifStart(condition, "elif")
-}
-
-func (c *converter) ElseIfEnd() error {
-	return nil
-}
-
 func (c *converter) ElseStart() error {
 	c.addLine("else")
 	return nil
@@ -167,19 +361,19 @@ func (c *converter) ElseEnd() error {
 }
 
 func (c *converter) ForStart() error {
-	c.addLine(fmt.Sprintf(`%s=`, c.mustCurrentForVar()))
+	c.addLine(c.varAssignmentString(c.mustCurrentForVar(), "", false))
 	c.addLine("while true; do")
 	return nil
 }
 
 func (c *converter) ForIncrementStart() error {
-	c.addLine(fmt.Sprintf(`if [ ! -z ${%s} ]; then`, c.mustCurrentForVar())) // https://stackoverflow.com/a/13864829
+	c.addLine(fmt.Sprintf(`if [ ! -z %s ]; then`, c.varEvaluationString(c.mustCurrentForVar(), false))) // https://stackoverflow.com/a/13864829
 	return nil
 }
 
 func (c *converter) ForIncrementEnd() error {
 	c.addLine("fi")
-	c.addLine(fmt.Sprintf(`%s=1`, c.mustCurrentForVar()))
+	c.addLine(c.varAssignmentString(c.mustCurrentForVar(), "1", false))
 	return nil
 }
 
@@ -205,22 +399,51 @@ func (c *converter) Continue() error {
 	return nil
 }
 
-func (c *converter) Print(values []string) error {
-	c.addLine(fmt.Sprintf("echo \"%s\"", strings.Join(values, " ")))
+func (c *converter) Print(values []transpiler.PrintValue, stderr bool, newline bool) error {
+	format := "%s"
+	redirect := ""
+
+	if newline {
+		format += `\n`
+	}
+	if stderr {
+		redirect = " >&2"
+	}
+	c.addLine(fmt.Sprintf(`printf '%s' "%s"%s`, format, strings.Join(c.printStrings(values), " "), redirect))
 	return nil
 }
 
 func (c *converter) Panic(value string) error {
-	c.addLine(fmt.Sprintf("echo \"%s\"", value))
-	c.addLine("exit 1")
+	c.addLine(fmt.Sprintf(`printf '%%s\n' "%s" >&2`, value))
+	return c.Exit("1")
+}
+
+func (c *converter) Exit(code string) error {
+	c.addLine(fmt.Sprintf(`exit "%s"`, code))
 	return nil
 }
 
 func (c *converter) WriteFile(path string, content string, append string) error {
-	helper := c.nextHelperVar()
+	c.addLine(fmt.Sprintf(`if [ "%s" -eq "%s" ]; then printf '%%s\n' "%s" >> "%s"; else printf '%%s\n' "%s" > "%s"; fi`,
+		append,
+		transpiler.BoolToString(true),
+		content,
+		path,
+		content,
+		path,
+	))
+	return nil
+}
+
+func (c *converter) EnvSet(name string, value string) error {
+	c.envSetHelperRequired = true
+	c.addLine(fmt.Sprintf(`_esh "%s" "%s"`, name, value))
+	return nil
+}
 
-	c.VarAssignment(helper, fmt.Sprintf(`$(if [ "%s" -eq "%s" ]; then echo ">>"; else echo ">"; fi)`, append, transpiler.BoolToString(true)), false)
-	c.addLine(fmt.Sprintf(`eval "echo \"%s\" %s %s"`, content, c.varEvaluationString(helper, false), path))
+func (c *converter) EnvUnset(name string) error {
+	c.envUnsetHelperRequired = true
+	c.addLine(fmt.Sprintf(`_euh "%s"`, name))
 	return nil
 }
 
@@ -229,6 +452,11 @@ func (c *converter) Nop() error {
 	return nil
 }
 
+func (c *converter) Marker(marker string) error {
+	c.addLine(marker)
+	return nil
+}
+
 func (c *converter) UnaryOperation(expr string, operator parser.UnaryOperator, valueType parser.ValueType, valueUsed bool) (string, error) {
 	helper := c.nextHelperVar()
 
@@ -272,10 +500,22 @@ func (c *converter) BinaryOperation(left string, operator parser.BinaryOperator,
 			return notAllowedError()
 		}
 		c.VarAssignment(helper, fmt.Sprintf("$((%s%s%s))", left, operator, right), false) // Backslash is required for * operator to prevent pattern expansion (https://www.shell-tips.com/bash/math-arithmetic-calculation/#using-the-expr-command-line).
+	case parser.DATA_TYPE_FLOAT:
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION,
+			parser.BINARY_OPERATOR_DIVISION,
+			parser.BINARY_OPERATOR_ADDITION,
+			parser.BINARY_OPERATOR_SUBTRACTION:
+			// These operations are fine.
+		default:
+			return notAllowedError()
+		}
+		c.floatHelperRequired = true
+		c.VarAssignment(helper, fmt.Sprintf(`$(_flh "%s" "%s" "%s")`, left, operator, right), false)
 	case parser.DATA_TYPE_STRING:
 		switch operator {
 		case parser.BINARY_OPERATOR_ADDITION:
-			c.VarAssignment(helper, fmt.Sprintf("\"%s%s\"", left, right), false)
+			c.VarAssignment(helper, fmt.Sprintf("%s%s", left, right), false)
 		default:
 			return notAllowedError()
 		}
@@ -288,6 +528,15 @@ func (c *converter) BinaryOperation(left string, operator parser.BinaryOperator,
 func (c *converter) Comparison(left string, operator parser.CompareOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
 	var operatorString string
 
+	// Floats are compared by the float helper which returns the result as bool.
+	if valueType.IsFloat() {
+		helper := c.nextHelperVar()
+		c.floatHelperRequired = true
+
+		c.VarAssignment(helper, fmt.Sprintf(`$(_flh "%s" "%s" "%s")`, left, operator, right), false)
+		return c.VarEvaluation(helper, valueUsed, false)
+	}
+
 	if !valueType.IsSlice() {
 		switch valueType.DataType() {
 		case parser.DATA_TYPE_BOOLEAN:
@@ -341,33 +590,33 @@ func (c *converter) Comparison(left string, operator parser.CompareOperator, rig
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
-func (c *converter) LogicalOperation(left string, operator parser.LogicalOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
+func (c *converter) LogicalOperationStart(left string, operator parser.LogicalOperator) error {
 	var operatorString string
 
+	// The right side only needs to be evaluated if the left side is true for "&&" or false for "||".
 	switch operator {
 	case parser.LOGICAL_OPERATOR_AND:
-		operatorString = "&&"
+		operatorString = "-eq"
 	case parser.LOGICAL_OPERATOR_OR:
-		operatorString = "||"
+		operatorString = "-ne"
 	default:
-		return "", fmt.Errorf("unknown logical operator \"%s\"", operator)
+		return fmt.Errorf("unknown logical operator \"%s\"", operator)
 	}
-	trueString := transpiler.BoolToString(true)
 	helper := c.nextHelperVar()
+	c.logicalOperations = append(c.logicalOperations, helper)
 
-	c.VarAssignment(
-		helper,
-		fmt.Sprintf("$(if [ \"%s\" -eq \"%s\" ] %s [ \"%s\" -eq \"%s\" ]; then echo %s; else echo %s; fi)",
-			left,
-			trueString,
-			operatorString,
-			right,
-			trueString,
-			trueString,
-			transpiler.BoolToString(false),
-		),
-		false,
-	)
+	c.VarAssignment(helper, left, false)
+	c.addLine(fmt.Sprintf(`if [ "%s" %s "%s" ]; then`, c.varEvaluationString(helper, false), operatorString, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) LogicalOperationEnd(right string, valueUsed bool) (string, error) {
+	lastIndex := len(c.logicalOperations) - 1
+	helper := c.logicalOperations[lastIndex]
+	c.logicalOperations = slices.Delete(c.logicalOperations, lastIndex, lastIndex+1)
+
+	c.VarAssignment(helper, right, false)
+	c.addLine("fi")
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
@@ -376,28 +625,24 @@ func (c *converter) VarEvaluation(name string, valueUsed bool, global bool) (str
 }
 
 func (c *converter) SliceInstantiation(values []string, valueUsed bool) (string, error) {
-	c.addLine(fmt.Sprintf(`_dvc=$((%s+1))`, c.varEvaluationString("_dvc", true))) // Dynamic variable counter.
+	c.addLine(fmt.Sprintf(`%s=$((%s+1))`, c.varName("_dvc", true), c.varEvaluationString("_dvc", true))) // Dynamic variable counter.
 	helper := c.nextHelperVar()
-	c.VarAssignment(helper, fmt.Sprintf(`_dv%s`, c.varEvaluationString("_dvc", true)), false)
-
-	if len(values) > 0 {
-		vals := ""
+	c.VarAssignment(helper, fmt.Sprintf(`%s%s`, c.varName("_dv", true), c.varEvaluationString("_dvc", true)), false)
 
-		for _, value := range values {
-			vals = fmt.Sprintf(`%s \"%s\"`, vals, value)
-		}
-		c.addLine(fmt.Sprintf(`eval "%s=(%s)"`, c.varEvaluationString(helper, false), strings.TrimSpace(vals)))
+	// Init slice values.
+	for i, value := range values {
+		c.addLine(c.sliceAssignmentString(c.varEvaluationString(helper, false), strconv.Itoa(i), value, false))
 	}
 	return c.varEvaluationString(helper, false), nil
 }
 
-func (c *converter) SliceEvaluation(name string, index string, valueUsed bool) (string, error) {
+func (c *converter) SliceEvaluation(name string, index string, defaultValue string, valueUsed bool) (string, error) {
 	helper := c.nextHelperVar()
-	c.VarAssignment(
-		helper,
-		c.sliceEvaluationString(name, index),
-		false,
-	)
+
+	// Use indirect expansion to make sure the value is not evaluated again (https://www.gnu.org/software/bash/manual/html_node/Shell-Parameter-Expansion.html).
+	c.VarAssignment(helper, fmt.Sprintf("%s[%s]", name, index), false)
+	c.VarAssignment(helper, fmt.Sprintf("${!%s-%s}", c.varName(helper, false), defaultValue), false)
+
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
@@ -408,6 +653,86 @@ func (c *converter) SliceLen(name string, valueUsed bool) (string, error) {
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
+func (c *converter) SliceRange(name string, startIndex string, endIndex string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.addLine(fmt.Sprintf(`_srh %s %s "%s" "%s"`, slice, name, startIndex, endIndex))
+
+	return slice, nil
+}
+
+func (c *converter) Append(name string, values []string, inPlace bool, valueUsed bool) (string, error) {
+	slice, err := c.appendDestination(name, c.sliceLenString(name), inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Assign each value to the current length to append it.
+	for _, value := range values {
+		c.addLine(c.sliceAssignmentString(slice, c.sliceLenString(slice), value, false))
+	}
+	return slice, nil
+}
+
+func (c *converter) AppendSlice(name string, source string, inPlace bool, valueUsed bool) (string, error) {
+	slice, err := c.appendDestination(name, c.sliceLenString(name), inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.addLine(fmt.Sprintf(`_srh %s %s "0" "%s"`, slice, source, c.sliceLenString(source)))
+
+	return slice, nil
+}
+
+func (c *converter) MapInstantiation(keys []string, values []string, valueUsed bool) (string, error) {
+	c.addLine(fmt.Sprintf(`%s=$((%s+1))`, c.varName("_dvc", true), c.varEvaluationString("_dvc", true))) // Dynamic variable counter.
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, fmt.Sprintf(`%s%s`, c.varName("_dv", true), c.varEvaluationString("_dvc", true)), false)
+	c.addLine(fmt.Sprintf(`declare -gA "%s"`, c.varEvaluationString(helper, false)))
+
+	// Init map values.
+	for i, key := range keys {
+		c.mapAssignmentHelperRequired = true
+		c.addLine(fmt.Sprintf(`_mah %s "%s" "%s"`, c.varEvaluationString(helper, false), key, values[i]))
+	}
+	return c.varEvaluationString(helper, false), nil
+}
+
+func (c *converter) MapEvaluation(name string, key string, defaultValue string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	okHelper := c.nextHelperVar()
+
+	c.mapEvaluationHelperRequired = true
+	c.addLine(fmt.Sprintf(`_mgh %s "%s" "%s"`, name, key, defaultValue))
+	c.VarAssignment(valueHelper, c.varEvaluationString("_mv", true), false)
+	c.VarAssignment(okHelper, c.varEvaluationString("_mok", true), false)
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(okHelper, false), nil
+}
+
+func (c *converter) MapLen(name string, valueUsed bool) (string, error) {
+	return c.SliceLen(name, valueUsed) // Bash uses the same syntax to get the length of arrays and associative arrays.
+}
+
+func (c *converter) MapKeys(name string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.mapKeysHelperRequired = true
+	c.addLine(fmt.Sprintf(`_mkh %s %s`, name, slice))
+
+	return slice, nil
+}
+
 func (c *converter) StringSubscript(value string, startIndex string, endIndex string, valueUsed bool) (string, error) {
 	helper := c.nextHelperVar()
 
@@ -427,6 +752,42 @@ func (c *converter) StringLen(value string, valueUsed bool) (string, error) {
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
+func (c *converter) FloatToInt(value string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, value, false)
+
+	// Remove the decimal places to truncate the value like Go does (arithmetic expansion turns -0 into 0).
+	c.VarAssignment(helper, fmt.Sprintf("$((${%s%%.*}))", c.varName(helper, false)), false)
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Atoi(value string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	errHelper := c.nextHelperVar()
+
+	c.atoiHelperRequired = true
+	c.addLine(fmt.Sprintf(`_aih "%s"`, value))
+	c.VarAssignment(valueHelper, c.varEvaluationString("_atv", true), false)
+	c.VarAssignment(errHelper, c.varEvaluationString("_ate", true), false)
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(errHelper, false), nil
+}
+
+func (c *converter) Format(value string, valueType parser.ValueType, verb parser.FormatVerb, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	if valueType.IsBool() {
+		value = c.boolString(value)
+	}
+	if verb.Verb() == 'q' {
+		c.quoteHelperRequired = true
+		c.addLine(fmt.Sprintf(`_qh "%s"`, value))
+		value = c.varEvaluationString("_ret", true)
+	}
+	c.addLine(fmt.Sprintf(`printf -v %s '%s' "%s"`, c.varName(helper, false), transpiler.PrintfFormat(verb), value))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
 func (c *converter) Group(value string, valueUsed bool) (string, error) {
 	return fmt.Sprintf("(%s)", value), nil
 }
@@ -464,35 +825,46 @@ func (c *converter) AppCall(calls []transpiler.AppCall, valueUsed bool) ([]strin
 	for _, call := range callsCopy {
 		argsCopy := call.Args()
 
+		// Quote all arguments to make sure they are passed as they are.
 		for j, arg := range argsCopy {
-			// If argument is a variable or contains whitespaces, quote it.
-			if strings.HasPrefix(arg, "$") || len(strings.Split(arg, " ")) > 1 {
-				arg = fmt.Sprintf("\"%s\"", arg)
+			if call.Spread() && j == len(argsCopy)-1 {
+				helper := c.nextHelperVar()
+
+				// Copy the spread slice into a helper array to pass its values as separate arguments.
+				c.addLine(fmt.Sprintf(`eval "%s=(\"\${%s[@]}\")"`, c.localVarName(helper), arg))
+				argsCopy[j] = fmt.Sprintf(`"${%s[@]}"`, c.varName(helper, false))
+			} else {
+				argsCopy[j] = fmt.Sprintf(`"%s"`, arg)
 			}
-			argsCopy[j] = arg
 		}
 		space := ""
 
 		if len(argsCopy) > 0 {
 			space = " "
 		}
-		callStrings = append(callStrings, fmt.Sprintf("%s%s%s", call.Name(), space, strings.Join(argsCopy, " ")))
+		callStrings = append(callStrings, fmt.Sprintf(`"%s"%s%s`, escapeString(call.Name()), space, strings.Join(argsCopy, " ")))
 	}
 	callString := strings.Join(callStrings, " | ")
 
 	if valueUsed {
-		callString = fmt.Sprintf("$(%s)", callString)
-		helper1 := c.nextHelperVar()
-		helper2 := c.nextHelperVar()
-
-		c.VarDefinition(helper1, callString, false)
-		eval, err := c.VarEvaluation(helper1, valueUsed, false)
-
-		if err != nil {
-			return nil, err
-		}
-		c.VarDefinition(helper2, "$?", false)
-		return []string{eval, "", c.varEvaluationString(helper2, false)}, nil // TODO: Return stderr (https://github.com/monstermichl/TypeShell/issues/28).
+		fileHelper := c.nextHelperVar()
+		stdoutHelper := c.nextHelperVar()
+		codeHelper := c.nextHelperVar()
+		stderrHelper := c.nextHelperVar()
+		file := c.varEvaluationString(fileHelper, false)
+
+		// Stderr is redirected to a temporary file to keep it separated from stdout.
+		c.VarDefinition(fileHelper, "$(mktemp)", false)
+		c.VarDefinition(stdoutHelper, fmt.Sprintf(`$({ %s; } 2>"%s")`, callString, file), false)
+		c.VarDefinition(codeHelper, "$?", false)
+		c.VarDefinition(stderrHelper, fmt.Sprintf(`$(cat "%s")`, file), false)
+		c.addLine(fmt.Sprintf(`rm -f "%s"`, file))
+
+		return []string{
+			c.varEvaluationString(stdoutHelper, false),
+			c.varEvaluationString(stderrHelper, false),
+			c.varEvaluationString(codeHelper, false),
+		}, nil
 	}
 	c.addLine(callString)
 	return []string{"", "", "0"}, nil
@@ -504,19 +876,42 @@ func (c *converter) Input(prompt string, valueUsed bool) (string, error) {
 	if len(prompt) > 0 {
 		prompt = fmt.Sprintf(" -p \"%s\"", prompt)
 	}
-	c.addLine(fmt.Sprintf("read%s %s", prompt, helper))
+	c.addLine(fmt.Sprintf("read -r%s %s", prompt, c.localVarName(helper)))
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
-func (c *converter) Copy(destination string, source string, valueUsed bool, global bool) (string, error) {
-	destination = c.varName(destination, global)
+func (c *converter) Args(valueUsed bool) (string, error) {
+	c.argsRequired = true
+	return c.varName(argsSlice, true), nil
+}
+
+func (c *converter) EnvGet(name string, valueUsed bool) (string, error) {
+	return c.envGet(name, "_ret", valueUsed)
+}
+
+func (c *converter) EnvExists(name string, valueUsed bool) (string, error) {
+	return c.envGet(name, "_ok", valueUsed)
+}
+
+func (c *converter) Environ(valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.environHelperRequired = true
+	c.addLine(fmt.Sprintf(`_elh %s`, slice))
+
+	return slice, nil
+}
 
-	c.addLine(fmt.Sprintf("_sch %s %s", destination, source))
+func (c *converter) Copy(destination string, source string, valueUsed bool, global bool) (string, error) {
+	c.addLine(fmt.Sprintf("_sch %s %s", c.varName(destination, global), source))
 	c.sliceAssignmentHelperRequired = true
 	c.sliceCopyHelperRequired = true
 
 	helper := c.nextHelperVar()
-	c.VarAssignment(helper, c.sliceLenString(c.varEvaluationString(destination, true)), false)
+	c.VarAssignment(helper, c.sliceLenString(c.varEvaluationString(destination, global)), false)
 
 	return c.varEvaluationString(helper, false), nil
 }
@@ -541,29 +936,68 @@ func (c *converter) ReadFile(path string, valueUsed bool) (string, error) {
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
+// printStrings converts the print values to strings. Bools are printed as true/false.
+func (c *converter) printStrings(values []transpiler.PrintValue) []string {
+	strs := []string{}
+
+	for _, value := range values {
+		s := value.Value()
+
+		if value.ValueType().IsBool() {
+			s = c.boolString(s)
+		}
+		strs = append(strs, s)
+	}
+	return strs
+}
+
+// boolString converts a bool value to true/false and returns the evaluation of the helper variable.
+func (c *converter) boolString(value string) string {
+	helper := c.nextHelperVar()
+
+	c.addLine(fmt.Sprintf(`if [ "%s" = "%s" ]; then %s; else %s; fi`,
+		value,
+		transpiler.BoolToString(true),
+		c.varAssignmentString(helper, "true", false),
+		c.varAssignmentString(helper, "false", false),
+	))
+	return c.varEvaluationString(helper, false)
+}
+
 func (c *converter) mustCurrentForVar() string {
 	return fmt.Sprintf("_fv%d", c.forCounter)
 }
 
+// varName returns the name of the shell variable. All variables are prefixed to keep them apart from
+// environment variables which share the same namespace in Bash.
 func (c *converter) varName(name string, global bool) string {
 	if c.inFunction() && !global {
 		name = fmt.Sprintf("f%d_%s", c.funcCounter, name)
 	}
-	return name
+	return fmt.Sprintf("%s%s", transpiler.VarPrefix, name)
 }
 
-func (c *converter) varAssignmentString(name string, value string, global bool) string {
-	length := len(value)
+func (c *converter) localVarName(name string) string {
+	name = c.varName(name, false)
 
-	if length > 0 {
-		if string(value[length-1]) != `"` {
-			value = fmt.Sprintf(`%s"`, value)
-		}
-		if string(value[0]) != `"` {
-			value = fmt.Sprintf(`"%s`, value)
+	// Keep track of function variables to declare them local.
+	if c.inFunction() {
+		currFunc := &c.funcs[len(c.funcs)-1]
+
+		if !slices.Contains(currFunc.locals, name) {
+			currFunc.locals = append(currFunc.locals, name)
 		}
 	}
-	return fmt.Sprintf("%s=%s", c.varName(name, global), value)
+	return name
+}
+
+func (c *converter) varAssignmentString(name string, value string, global bool) string {
+	if global {
+		name = c.varName(name, global)
+	} else {
+		name = c.localVarName(name)
+	}
+	return fmt.Sprintf(`%s="%s"`, name, value)
 }
 
 func (c *converter) varEvaluationString(name string, global bool) string {
@@ -572,26 +1006,37 @@ func (c *converter) varEvaluationString(name string, global bool) string {
 
 func (c *converter) sliceAssignmentString(name string, index string, value string, global bool) string {
 	c.sliceAssignmentHelperRequired = true
-	return fmt.Sprintf(`eval "%s[%s]=\"%s\""`, name, index, value)
-}
-
-func (c *converter) sliceEvaluationString(name string, index string) string {
-	return fmt.Sprintf(`$(eval "echo \${%s[%s]}")`, name, index)
+	return fmt.Sprintf(`printf -v "%s[%s]" '%%s' "%s"`, name, index, value) // Use printf instead of eval to make sure the value is not evaluated again.
 }
 
 func (c *converter) sliceLenString(name string) string {
 	return fmt.Sprintf(`$(eval "echo \${#%s[@]}")`, name)
 }
 
-func (c *converter) ifStart(condition string, startWord string) error {
-	c.addLine(fmt.Sprintf("%s [ %s -eq %s ]; then", startWord, condition, transpiler.BoolToString(true)))
-	return nil
+// appendDestination returns the slice to which values are appended. Unless the values are appended in
+// place, it's a copy of the passed slice.
+func (c *converter) appendDestination(name string, length string, inPlace bool, valueUsed bool) (string, error) {
+	if inPlace {
+		return name, nil
+	}
+	return c.SliceRange(name, "0", length, valueUsed)
 }
 
 func (c *converter) inFunction() bool {
 	return len(c.funcs) > 0
 }
 
+// envGet calls the environment get helper and returns the provided result variable (_ret or _ok).
+func (c *converter) envGet(name string, resultVar string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.envGetHelperRequired = true
+	c.addLine(fmt.Sprintf(`_egh "%s"`, name))
+	c.VarAssignment(helper, c.varEvaluationString(resultVar, true), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
 func (c *converter) addHelper(helperType string, functionName string, code ...string) {
 	c.addStartLine(fmt.Sprintf("# global %s helper", helperType))
 	c.addStartLine(fmt.Sprintf("%s() {", functionName))
@@ -610,6 +1055,31 @@ func (c *converter) addLine(line string) {
 	c.code = append(c.code, line)
 }
 
+// floatHelperCommand returns an awk command which applies the operator ($2) to the left ($1) and the
+// right ($3) value. Arithmetic results are rounded to transpiler.FloatPrecision decimal places.
+func floatHelperCommand() string {
+	return fmt.Sprintf(`awk -v l="${1}" -v o="${2}" -v r="${3}" 'BEGIN { l += 0; r += 0; if (o == "+") v = l + r; else if (o == "-") v = l - r; else if (o == "*") v = l * r; else if (o == "/") v = l / r; else { c = (o == "==" && l == r) || (o == "!=" && l != r) || (o == "<" && l < r) || (o == "<=" && l <= r) || (o == ">" && l > r) || (o == ">=" && l >= r); print (c ? %s : %s); exit } s = sprintf("%%.%df", v); sub(/0+$/, "", s); sub(/\.$/, "", s); if (s == "-0") s = "0"; print s }'`,
+		transpiler.BoolToString(true),
+		transpiler.BoolToString(false),
+		transpiler.FloatPrecision,
+	)
+}
+
+// envNameCheckString returns the condition which checks if the first helper argument is a valid variable name
+// which doesn't belong to the script's variables.
+func envNameCheckString() string {
+	return fmt.Sprintf(`[[ "${1}" =~ ^[a-zA-Z_][a-zA-Z0-9_]*$ && "${1}" != %s* ]]`, transpiler.VarPrefix)
+}
+
+func escapeString(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"$", `\$`,
+		"`", "\\`",
+	).Replace(value)
+}
+
 func (c *converter) nextHelperVar() string {
 	helperVar := fmt.Sprintf("_h%d", c.varCounter)
 	c.varCounter++
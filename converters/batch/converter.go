@@ -20,12 +20,35 @@ const (
 	sliceLenGetHelper     helperName = "_slg"  // Slice length get
 	sliceAssignmentHelper helperName = "_sah"  // Slice assignment
 	sliceCopyHelper       helperName = "_sch"  // Slice copy
+	sliceRangeHelper      helperName = "_srh"  // Slice range
+	sliceSpreadHelper     helperName = "_ssh"  // Slice spread
+	mapFindHelper         helperName = "_mfh"  // Map find
+	mapAssignmentHelper   helperName = "_mah"  // Map assignment
+	mapEvaluationHelper   helperName = "_mgh"  // Map evaluation
+	mapDeleteHelper       helperName = "_mdh"  // Map delete
 	stringSubscriptHelper helperName = "_stsh" // String subscript
 	stringLengthHelper    helperName = "_stlh" // String length
 	stringEscapeHelper    helperName = "_seh"  // String escape
 	echoHelper            helperName = "_ech"  // Echo
+	echoInlineHelper      helperName = "_eih"  // Echo without newline
+	floatParseHelper      helperName = "_fph"  // Float parse
+	floatFormatHelper     helperName = "_ffh"  // Float format
+	floatArithmeticHelper helperName = "_fah"  // Float arithmetic
+	floatMultiplyHelper   helperName = "_fmh"  // Float multiplication
+	floatDivideHelper     helperName = "_fdh"  // Float division
+	floatDigitHelper      helperName = "_fqh"  // Float division quotient digit
+	floatComparisonHelper helperName = "_fch"  // Float comparison
+	environHelper         helperName = "_elh"  // Environment variable list
+	formatPadHelper       helperName = "_fmp"  // Format padding
+	quoteHelper           helperName = "_qh"   // Quote
+	atoiHelper            helperName = "_aih"  // String to integer
 )
 
+const frameDepthVar = "_tsh__fd" // Function call depth, used to give each function call its own variables.
+const argsSlice = "_tsh__args"   // Slice which holds the script arguments.
+const exitCodeVar = "_tsh__e"    // Exit code, only defined if the program exits explicitly.
+const codePageVar = "_tsh__cp"   // Code page which was active before the script started.
+
 type funcInfo struct {
 	name string
 }
@@ -53,17 +76,33 @@ type converter struct {
 	funcCounter                   int
 	fors                          []forInfo
 	ifs                           []ifInfo
+	logicalOperations             []string // Stores the result variables of the currently evaluated logical operations.
 	lfSet                         bool
+	escapeVarsSet                 bool
 	appCallHelperRequired         bool
 	readHelperRequired            bool
 	sliceAssignmentHelperRequired bool
 	sliceCopyHelperRequired       bool
+	sliceRangeHelperRequired      bool
+	sliceSpreadHelperRequired     bool
 	sliceLenSetHelperRequired     bool
 	sliceLenGetHelperRequired     bool
 	stringSubscriptHelperRequired bool
 	stringLenHelperRequired       bool
+	mapFindHelperRequired         bool
+	mapAssignmentHelperRequired   bool
+	mapEvaluationHelperRequired   bool
+	mapDeleteHelperRequired       bool
 	fileWriteHelperRequired       bool
 	echoHelperRequired            bool
+	echoInlineHelperRequired      bool
+	floatArithmeticHelperRequired bool
+	floatComparisonHelperRequired bool
+	environHelperRequired         bool
+	formatPadHelperRequired       bool
+	quoteHelperRequired           bool
+	atoiHelperRequired            bool
+	argsRequired                  bool
 }
 
 func New() *converter {
@@ -74,24 +113,35 @@ func returnValVar(subscript int) string {
 	return fmt.Sprintf("_rv%d", subscript)
 }
 
+// appCallArgVar returns the variable which holds an argument of a captured program call.
+func appCallArgVar(subscript int) string {
+	return fmt.Sprintf("_tsh__aa%d", subscript)
+}
+
 func funcArgVar(subscript int) string {
-	return fmt.Sprintf("_fa%d", subscript)
+	return fmt.Sprintf("_tsh__fa%d", subscript)
 }
 
 func forLabel(count int) string {
 	return fmt.Sprintf(":_f%d", count)
 }
 
+// StringToString escapes all characters which have a special meaning within double quotes. Therefore,
+// all values must be embedded in double quotes.
 func (c *converter) StringToString(value string) string {
 	c.addLf()
+	c.addEscapeVars()
 
-	// Escape all "!".
-	value = strings.ReplaceAll(value, "!", "^!")
-
-	// Replace "\n" with Batch newline value.
-	value = strings.ReplaceAll(value, "\n", "!LF!")
-
-	return value
+	// Carets are only removed by delayed expansion if the line contains a "!", quotes would end the
+	// string and percent signs would be expanded. Therefore, they are replaced by variables. All
+	// replacements are done in a single pass to make sure replaced values are not replaced again.
+	return strings.NewReplacer(
+		"^", "!_tsh__c!",
+		"!", "^!", // Escape all "!".
+		`"`, "!_tsh__q!",
+		"%", "!_tsh__p!",
+		"\n", "!_tsh__nl!", // Replace "\n" with Batch newline value.
+	).Replace(value)
 }
 
 func (c *converter) Dump() (string, error) {
@@ -117,46 +167,86 @@ func (c *converter) Extension() string {
 
 func (c *converter) ProgramStart() error {
 	c.addStartLine("@echo off")
+	// The script is written in UTF-8. Therefore, the code page is switched to UTF-8 and the previous one is
+	// restored on exit.
+	c.addStartLine(fmt.Sprintf(`for /f "tokens=2 delims=:." %%%%i in ('chcp') do set "%s=%%%%i"`, codePageVar))
+	c.addStartLine("chcp 65001 >nul")
 	c.addStartLine("setlocal EnableDelayedExpansion")
 	c.addStartLine("setlocal")
-	c.addStartLine(`set "_e=0"`)
+	c.addStartLine(fmt.Sprintf(`set "%s="`, exitCodeVar)) // The exit code is only set if the program exits explicitly.
+	c.addStartLine(fmt.Sprintf(`set "%s=0"`, frameDepthVar))
 
 	return nil
 }
 
 func (c *converter) ProgramEnd() error {
 	if c.fileWriteHelperRequired {
+		// %1: Append
+		// arg0: Content
+		// arg1: Path
 		c.addHelper("file write", fileWriteHelper,
-			`set "_a=>"`,
-			fmt.Sprintf(`if "%%2" equ "%s" set "_a=>>"`, transpiler.BoolToString(true)),
-			fmt.Sprintf(`for /f "delims=" %%%%i in ("!%s!") do (`, funcArgVar(0)),
-			`for /f "delims=" %%j in ('echo %%i!_a! %~1') do (`,
-			"rem", // NOP (https://stackoverflow.com/a/7191952). The for-loop is just required to expand !_a! delayed.
-			`)`,
-			`set "_a=>>"`,
+			fmt.Sprintf(`if "%%1" equ "%s" (`, transpiler.BoolToString(true)),
+			fmt.Sprintf(`(echo(!%s!)>>"!%s!"`, funcArgVar(0), funcArgVar(1)), // echo( also works for empty values and values like "/?" (https://stackoverflow.com/a/20691061).
+			") else (",
+			fmt.Sprintf(`(echo(!%s!)>"!%s!"`, funcArgVar(0), funcArgVar(1)),
 			")",
 		)
 	}
 
 	if c.appCallHelperRequired {
 		c.addHelper("app call", appCallHelper,
-			`set "_h="`,
-			`set "_te="`, // Temporary error variable.
-			fmt.Sprintf(`for /f "delims=" %%%%i in ('cmd /V:ON /C "!%s! & echo ^!errorlevel^!"') do (`, funcArgVar(0)), // Use the carets in ^!errorlevel^! to make sure errorlevel is expanded within cmd.
-			`if defined _h set "_h=!_h!!LF!"`,
-			`set "_h=!_h!!_te!"`,
-			`set _te=%%i`,
+			`set "_tsh__h="`,
+			`set "_tsh__te="`, // Temporary error variable.
+			`set "_tsh__he="`, // Stderr variable.
+			`set "_tsh__ef=%TEMP%\_tsh_!random!!random!.err"`,                                                                          // Stderr is redirected to a temporary file to keep it separated from stdout.
+			fmt.Sprintf(`for /f "delims=" %%%%i in ('cmd /V:ON /C "!%s! & echo ^!errorlevel^!" 2^>"!_tsh__ef!"') do (`, funcArgVar(0)), // Use the carets in ^!errorlevel^! to make sure errorlevel is expanded within cmd.
+			`if defined _tsh__h set "_tsh__h=!_tsh__h!!_tsh__nl!"`,
+			`set "_tsh__h=!_tsh__h!!_tsh__te!"`,
+			`set _tsh__te=%%i`,
+			")",
+			`if exist "!_tsh__ef!" (`,
+			`for /f "usebackq delims=" %%i in ("!_tsh__ef!") do (`,
+			`if defined _tsh__he set "_tsh__he=!_tsh__he!!_tsh__nl!"`,
+			`set "_tsh__he=!_tsh__he!%%i"`,
+			")",
+			`del "!_tsh__ef!"`,
 			")",
 		)
 	}
 
 	if c.readHelperRequired {
+		// The lines are numbered by findstr because for /f skips empty lines. The for-variable must be
+		// assigned while delayed expansion is disabled, otherwise "!" would be removed. To append the line
+		// to the result (where delayed expansion is enabled), "^" and "!" are escaped by _frh_esc and
+		// the value is passed on via for /f (the double quotes make sure it also works for empty lines).
+		// The caret in findstr's search string is doubled because delayed expansion removes it.
+		//
+		// arg0: Path
 		c.addHelper("read", fileReadHelper,
-			`set "_h="`,
-			`for /f "delims=" %%i in (%~1) do (`,
-			`if defined _h set "_h=!_h!!LF!"`,
-			`set "_h=!_h!%%i"`,
+			`set "_tsh__h="`,
+			`set "_tsh__rs="`,
+			fmt.Sprintf(`if not exist "!%s!" exit /B`, funcArgVar(0)),
+			fmt.Sprintf(`for /f "delims=" %%%%i in ('findstr /n "^^" "!%s!"') do (`, funcArgVar(0)),
+			"setlocal DisableDelayedExpansion",
+			`set "_tsh__rl=%%i"`,
+			"call :_frh_esc",
+			"setlocal EnableDelayedExpansion",
+			`set "_tsh__rl=!_tsh__rl:~1!"`,
+			`for /f "delims=" %%j in (""!_tsh__rl!"") do endlocal & endlocal & set "_tsh__h=!_tsh__h!!_tsh__rs!%%~j" & set "_tsh__rs=!_tsh__nl!"`,
 			")",
+			"exit /B",
+			// Replaces the line number by "#" (to never be empty) and escapes the value. "!" can only be replaced
+			// by percent expansion, which is safe if all quotes are doubled (all characters stay within quotes).
+			":_frh_esc",
+			"setlocal EnableDelayedExpansion",
+			`set "_tsh__rl=#!_tsh__rl:*:=!"`,
+			`set "_tsh__rl=!_tsh__rl:^=^^!"`,
+			`set "_tsh__rl=!_tsh__rl:"=""!"`,
+			`for /f "delims=" %%j in (""!_tsh__rl!"") do endlocal & set "_tsh__rl=%%~j"`,
+			`set "_tsh__rl=%_tsh__rl:!=^!%"`,
+			"setlocal EnableDelayedExpansion",
+			`set "_tsh__rl=!_tsh__rl:""="!"`,
+			`for /f "delims=" %%j in (""!_tsh__rl!"") do endlocal & set "_tsh__rl=%%~j"`,
 		)
 	}
 
@@ -168,16 +258,170 @@ func (c *converter) ProgramEnd() error {
 		// %1: Destination slice
 		// %2: Source slice
 		c.addHelper("slice copy", sliceCopyHelper,
-			`set "_i=0"`,
+			`set "_tsh__i=0"`,
 			c.callFuncString(sliceLenGetHelper, []string{}, "%2"),
 			":_sch_loop",
-			`if "!_i!" lss "!_len!" (`,
-			`for /f "delims=" %%i in ("%2_!_i!") do set "_v=!%%i!"`,
-			c.sliceAssignmentString("!%1!", "!_i!", "!_v!", false),
-			`set /A "_i=!_i!+1"`,
+			`if !_tsh__i! lss !_tsh__len! (`,
+			`for /f "delims=" %%i in ("%2_!_tsh__i!") do set "_tsh__v=!%%i!"`,
+			c.sliceAssignmentString("!%1!", "!_tsh__i!", "!_tsh__v!", false),
+			`set /A "_tsh__i=!_tsh__i!+1"`,
 			"goto :_sch_loop",
 			")",
-			c.callFuncString(sliceLenSetHelper, []string{}, "!%1!", "!_i!"),
+			c.callFuncString(sliceLenSetHelper, []string{}, "!%1!", "!_tsh__i!"),
+		)
+	}
+
+	if c.sliceRangeHelperRequired {
+		c.sliceLenGetHelperRequired = true
+		c.sliceLenSetHelperRequired = true
+
+		// Indices are clamped to the slice bounds. The values are appended to the destination slice.
+		//
+		// %1: Destination slice
+		// %2: Source slice
+		// %3: Start index
+		// %4: End index (excluded)
+		c.addHelper("slice range", sliceRangeHelper,
+			c.callFuncString(sliceLenGetHelper, []string{}, "%1"),
+			`set "_tsh__i=!_tsh__len!"`,
+			`set /A "_tsh__rs=%3"`,
+			`set /A "_tsh__re=%4"`,
+			c.callFuncString(sliceLenGetHelper, []string{}, "%2"),
+			`if !_tsh__rs! lss 0 set "_tsh__rs=0"`,
+			`if !_tsh__re! gtr !_tsh__len! set "_tsh__re=!_tsh__len!"`,
+			":_srh_loop",
+			`if !_tsh__rs! lss !_tsh__re! (`,
+			`for /f "delims=" %%i in ("%2_!_tsh__rs!") do set "_tsh__v=!%%i!"`,
+			c.sliceAssignmentString("%1", "!_tsh__i!", "!_tsh__v!", false),
+			`set /A "_tsh__i=!_tsh__i!+1"`,
+			`set /A "_tsh__rs=!_tsh__rs!+1"`,
+			"goto :_srh_loop",
+			")",
+			c.callFuncString(sliceLenSetHelper, []string{}, "%1", "!_tsh__i!"),
+		)
+	}
+
+	if c.sliceSpreadHelperRequired {
+		c.sliceLenGetHelperRequired = true
+
+		// Joins the quoted references of the slice values to pass them as separate program arguments
+		// (see AppCall). The carets are doubled because the references are outside of quotes.
+		//
+		// %1: Slice
+		c.addHelper("slice spread", sliceSpreadHelper,
+			`set "_tsh__ss="`,
+			`set "_tsh__i=0"`,
+			c.callFuncString(sliceLenGetHelper, []string{}, "%1"),
+			":_ssh_loop",
+			`if !_tsh__i! lss !_tsh__len! (`,
+			`set "_tsh__ss=!_tsh__ss! "^^!%1_!_tsh__i!^^!""`,
+			`set /A "_tsh__i=!_tsh__i!+1"`,
+			"goto :_ssh_loop",
+			")",
+		)
+	}
+
+	// Maps are stored as two slices (<map>_k and <map>_v) which hold the keys and the values at the same index.
+	if c.mapAssignmentHelperRequired {
+		c.mapFindHelperRequired = true
+		c.sliceLenGetHelperRequired = true
+		c.sliceLenSetHelperRequired = true
+
+		// %1: Map name
+		// arg0: Key
+		// arg1: Value
+		c.addHelper("map assignment", mapAssignmentHelper,
+			c.callFuncString(mapFindHelper, []string{}, "%1"),
+			`if !_tsh__mi! lss 0 (`,
+			c.callFuncString(sliceLenGetHelper, []string{}, "%1_k"),
+			`set "_tsh__mi=!_tsh__len!"`,
+			`set /A "_tsh__len=!_tsh__len!+1"`,
+			c.callFuncString(sliceLenSetHelper, []string{}, "%1_k", "!_tsh__len!"),
+			c.callFuncString(sliceLenSetHelper, []string{}, "%1_v", "!_tsh__len!"),
+			c.sliceAssignmentString("%1_k", "!_tsh__mi!", fmt.Sprintf("!%s!", funcArgVar(0)), false),
+			")",
+			c.sliceAssignmentString("%1_v", "!_tsh__mi!", fmt.Sprintf("!%s!", funcArgVar(1)), false),
+		)
+	}
+
+	if c.mapEvaluationHelperRequired {
+		c.mapFindHelperRequired = true
+
+		// %1: Map name
+		// arg0: Key
+		// arg1: Default value
+		c.addHelper("map evaluation", mapEvaluationHelper,
+			c.callFuncString(mapFindHelper, []string{}, "%1"),
+			`if !_tsh__mi! lss 0 (`,
+			fmt.Sprintf(`set "_tsh__mv=!%s!"`, funcArgVar(1)),
+			fmt.Sprintf(`set "_tsh__mok=%s"`, transpiler.BoolToString(false)),
+			") else (",
+			`for /f "delims=" %%i in ("%1_v_!_tsh__mi!") do set "_tsh__mv=!%%i!"`,
+			fmt.Sprintf(`set "_tsh__mok=%s"`, transpiler.BoolToString(true)),
+			")",
+		)
+	}
+
+	if c.mapDeleteHelperRequired {
+		c.mapFindHelperRequired = true
+		c.sliceLenGetHelperRequired = true
+		c.sliceLenSetHelperRequired = true
+
+		// The last entry is moved to the position of the deleted one.
+		//
+		// %1: Map name
+		// arg0: Key
+		c.addHelper("map delete", mapDeleteHelper,
+			c.callFuncString(mapFindHelper, []string{}, "%1"),
+			`if !_tsh__mi! geq 0 (`,
+			c.callFuncString(sliceLenGetHelper, []string{}, "%1_k"),
+			`set /A "_tsh__len=!_tsh__len!-1"`,
+			`for /f "delims=" %%i in ("%1_k_!_tsh__len!") do set "%1_k_!_tsh__mi!=!%%i!"`,
+			`for /f "delims=" %%i in ("%1_v_!_tsh__len!") do set "%1_v_!_tsh__mi!=!%%i!"`,
+			`set "%1_k_!_tsh__len!="`,
+			`set "%1_v_!_tsh__len!="`,
+			c.callFuncString(sliceLenSetHelper, []string{}, "%1_k", "!_tsh__len!"),
+			c.callFuncString(sliceLenSetHelper, []string{}, "%1_v", "!_tsh__len!"),
+			")",
+		)
+	}
+
+	if c.mapFindHelperRequired {
+		c.sliceLenGetHelperRequired = true
+
+		// Sets _mi to the index of the key or to -1 if the key doesn't exist.
+		//
+		// %1: Map name
+		// arg0: Key
+		c.addHelper("map find", mapFindHelper,
+			`set "_tsh__mi=-1"`,
+			`set "_tsh__i=0"`,
+			c.callFuncString(sliceLenGetHelper, []string{}, "%1_k"),
+			":_mfh_loop",
+			`if !_tsh__i! lss !_tsh__len! (`,
+			fmt.Sprintf(`for /f "delims=" %%%%i in ("%%1_k_!_tsh__i!") do if "!%%%%i!" equ "!%s!" (`, funcArgVar(0)),
+			`set "_tsh__mi=!_tsh__i!"`,
+			"exit /B",
+			")",
+			`set /A "_tsh__i=!_tsh__i!+1"`,
+			"goto :_mfh_loop",
+			")",
+		)
+	}
+
+	// Batch doesn't distinguish between environment and script variables, therefore the list also
+	// contains the script's own variables.
+	if c.environHelperRequired {
+		c.sliceLenSetHelperRequired = true
+
+		// %1: Slice name
+		c.addHelper("environment list", environHelper,
+			`set "_tsh__i=0"`,
+			fmt.Sprintf(`for /f "delims=" %%%%i in ('set ^| findstr /b /i /v "%s"') do (`, transpiler.VarPrefix), // Skip the script's variables.
+			c.sliceAssignmentString("%1", "!_tsh__i!", "%%i", false),
+			`set /A "_tsh__i=!_tsh__i!+1"`,
+			")",
+			c.callFuncString(sliceLenSetHelper, []string{}, "%1", "!_tsh__i!"),
 		)
 	}
 
@@ -192,15 +436,15 @@ func (c *converter) ProgramEnd() error {
 		// arg0: Assigned value
 		c.addHelper("slice assignment", sliceAssignmentHelper,
 			c.callFuncString(sliceLenGetHelper, []string{}, "!%1!"), // Get current slice length.
-			`set "_i=!_len!"`,
+			`set "_tsh__i=!_tsh__len!"`,
 			":_sah_loop",
-			`if "!_i!" lss "%2" (`,
-			c.sliceAssignmentString("!%1!", "!_i!", "%3", false),
-			`set /A "_i=!_i!+1"`,
+			`if !_tsh__i! lss %2 (`,
+			c.sliceAssignmentString("!%1!", "!_tsh__i!", "%3", false),
+			`set /A "_tsh__i=!_tsh__i!+1"`,
 			"goto :_sah_loop",
 			") else (",
-			`set /A "_len=%2+1"`,
-			c.callFuncString(sliceLenSetHelper, []string{}, "!%1!", "!_len!"),
+			`set /A "_tsh__len=%2+1"`,
+			c.callFuncString(sliceLenSetHelper, []string{}, "!%1!", "!_tsh__len!"),
 			")",
 			c.sliceAssignmentString("!%1!", "%2", fmt.Sprintf("!%s!", funcArgVar(0)), false),
 		)
@@ -214,36 +458,285 @@ func (c *converter) ProgramEnd() error {
 
 	if c.sliceLenGetHelperRequired {
 		c.addHelper("slice length get", sliceLenGetHelper,
-			`set "_len=!%1_len!"`,
+			`set "_tsh__len=!%1_len!"`,
 		)
 	}
 
 	if c.stringSubscriptHelperRequired {
 		c.addHelper("string subscript", stringSubscriptHelper,
-			`set /A "_sh=(%2-%1)+1"`,
-			fmt.Sprintf(`set "_sub=!%s:~%%1,%%_sh%%!"`, funcArgVar(0)), // https://stackoverflow.com/a/636391
+			`set /A "_tsh__sh=(%2-%1)+1"`,
+			fmt.Sprintf(`set "_tsh__sub=!%s:~%%1,%%_tsh__sh%%!"`, funcArgVar(0)), // https://stackoverflow.com/a/636391
+		)
+	}
+
+	if c.atoiHelperRequired {
+		c.addEscapeVars()
+
+		// The value is checked by removing all digits. Leading zeros are removed to avoid an octal
+		// interpretation and the value is compared as string to make sure it fits into 32 bits. Since
+		// set /A can't parse 2147483648, the lowest value is calculated.
+		//
+		// arg0: Value
+		c.addHelper("atoi", atoiHelper,
+			`set "_tsh__atv=0"`,
+			`set "_tsh__ate="`,
+			`set "_tsh__ats="`,
+			fmt.Sprintf(`set "_tsh__atd=!%s!"`, funcArgVar(0)),
+			"if not defined _tsh__atd goto :_atie",
+			`if "!_tsh__atd:~0,1!" equ "-" (set "_tsh__ats=-" & set "_tsh__atd=!_tsh__atd:~1!") else if "!_tsh__atd:~0,1!" equ "+" set "_tsh__atd=!_tsh__atd:~1!"`,
+			"if not defined _tsh__atd goto :_atie",
+			`set "_tsh__atc=x!_tsh__atd!"`,
+			`for %%d in (0 1 2 3 4 5 6 7 8 9) do set "_tsh__atc=!_tsh__atc:%%d=!"`,
+			`if not "!_tsh__atc!" equ "x" goto :_atie`,
+			":_atzl",
+			`if "!_tsh__atd:~1!" equ "" goto :_atze`,
+			`if not "!_tsh__atd:~0,1!" equ "0" goto :_atze`,
+			`set "_tsh__atd=!_tsh__atd:~1!"`,
+			"goto :_atzl",
+			":_atze",
+			`if not "!_tsh__atd:~10!" equ "" goto :_atre`,
+			`set "_tsh__atm=2147483647"`,
+			`if defined _tsh__ats set "_tsh__atm=2147483648"`,
+			`if not "!_tsh__atd:~9!" equ "" if "x!_tsh__atd!" gtr "x!_tsh__atm!" goto :_atre`,
+			`if "!_tsh__ats!!_tsh__atd!" equ "-2147483648" (set /A "_tsh__atv=-2147483647-1" & exit /B)`,
+			`set /A "_tsh__atv=!_tsh__ats!!_tsh__atd!"`,
+			"exit /B",
+			":_atie",
+			fmt.Sprintf(`set "_tsh__ate=strconv.Atoi: parsing !_tsh__q!!%s!!_tsh__q!: invalid syntax"`, funcArgVar(0)),
+			"exit /B",
+			":_atre",
+			fmt.Sprintf(`set "_tsh__ate=strconv.Atoi: parsing !_tsh__q!!%s!!_tsh__q!: value out of range"`, funcArgVar(0)),
+		)
+	}
+
+	if c.formatPadHelperRequired {
+		c.stringLenHelperRequired = true
+
+		// %1: Width
+		// %2: Alignment (l = left, r = right, z = right with leading zeros)
+		// arg0: Value
+		c.addHelper("format padding", formatPadHelper,
+			c.callFuncString(stringLengthHelper, []string{}),
+			`set /A "_tsh__fmn=%1-_tsh__l"`,
+			`set "_tsh__fms="`,
+			`set "_tsh__fmc= "`,
+			`if "%2" equ "z" set "_tsh__fmc=0"`,
+			":_fmpl",
+			"if !_tsh__fmn! leq 0 goto :_fmple",
+			`set "_tsh__fms=!_tsh__fms!!_tsh__fmc!"`,
+			`set /A "_tsh__fmn-=1"`,
+			"goto :_fmpl",
+			":_fmple",
+			fmt.Sprintf(`if "%%2" equ "l" (set "_tsh__fmr=!%[1]s!!_tsh__fms!") else set "_tsh__fmr=!_tsh__fms!!%[1]s!"`, funcArgVar(0)),
+			fmt.Sprintf(`if "%%2" equ "z" if "!%[1]s:~0,1!" equ "-" set "_tsh__fmr=-!_tsh__fms!!%[1]s:~1!"`, funcArgVar(0)), // Like in Go, the sign stays in front of the zeros.
 		)
 	}
 
 	if c.stringLenHelperRequired {
 		c.addHelper("string length", stringLengthHelper,
-			"set _l=0",
+			"set _tsh__l=0",
 			":_stlhl",
-			fmt.Sprintf(`if "!%s!" equ "" (goto :_stlhle) else if "!%s:~%%_l%%!" equ "" goto :_stlhle`, funcArgVar(0), funcArgVar(0)), // https://www.geeksforgeeks.org/batch-script-string-length/
-			`set /A "_l=%_l%+1"`,
+			fmt.Sprintf(`if "!%s!" equ "" (goto :_stlhle) else if "!%s:~%%_tsh__l%%!" equ "" goto :_stlhle`, funcArgVar(0), funcArgVar(0)), // https://www.geeksforgeeks.org/batch-script-string-length/
+			`set /A "_tsh__l=%_tsh__l%+1"`,
 			"goto :_stlhl",
 			":_stlhle",
 		)
 	}
 
+	if c.quoteHelperRequired {
+		c.addLf()
+
+		// arg0: Value
+		c.addHelper("quote", quoteHelper,
+			fmt.Sprintf(`set "_tsh__qr=!%s!"`, funcArgVar(0)),
+			"if not defined _tsh__qr goto :_qhe",
+			`set "_tsh__qr=!_tsh__qr:\=\\!"`,
+			`set "_tsh__qr=!_tsh__qr:"=\"!"`,
+			`for %%L in ("!_tsh__nl!") do set "_tsh__qr=!_tsh__qr:%%~L=\n!"`, // Newlines can't be used directly in substitutions, therefore the for variable holds it.
+			"set \"_tsh__qr=!_tsh__qr:\t=\\t!\"",
+			":_qhe",
+			`set "_tsh__qr="!_tsh__qr!""`,
+		)
+	}
+
+	if c.floatComparisonHelperRequired {
+		c.floatArithmeticHelperRequired = true
+
+		// The comparison is done by subtracting the right value from the left value and comparing the result with 0.
+		//
+		// %1: Batch compare operator (e.g. lss)
+		// arg0: Left value
+		// arg1: Right value
+		c.addHelper("float comparison", floatComparisonHelper,
+			c.callFuncString(floatArithmeticHelper, []string{}, "sub"),
+			`set "_tsh__fc=1"`,
+			`if "!_tsh__fr!" equ "0" set "_tsh__fc=0"`,
+			`if "!_tsh__fr:~0,1!" equ "-" set "_tsh__fc=-1"`,
+			fmt.Sprintf(`if !_tsh__fc! %%1 0 (set "_tsh__fr=%s") else set "_tsh__fr=%s"`, transpiler.BoolToString(true), transpiler.BoolToString(false)),
+		)
+	}
+
+	// Batch only supports 32-bit integer arithmetic. Therefore, floats are split into their integer part (<prefix>i)
+	// and their decimal places (<prefix>f, in millionths) which are calculated separately. Both parts always have
+	// the same sign.
+	if c.floatArithmeticHelperRequired {
+		// %1: Operation (add, sub, mul or div)
+		// arg0: Left value
+		// arg1: Right value
+		c.addHelper("float arithmetic", floatArithmeticHelper,
+			c.callFuncString(floatParseHelper, []string{}, funcArgVar(0), "_tsh__l"),
+			c.callFuncString(floatParseHelper, []string{}, funcArgVar(1), "_tsh__r"),
+			`if "%1" equ "mul" (`,
+			c.callFuncString(floatMultiplyHelper, []string{}),
+			`) else if "%1" equ "div" (`,
+			c.callFuncString(floatDivideHelper, []string{}),
+			`) else if "%1" equ "add" (`,
+			`set /A "_tsh__oi=_tsh__li+_tsh__ri, _tsh__of=_tsh__lf+_tsh__rf"`,
+			") else (",
+			`set /A "_tsh__oi=_tsh__li-_tsh__ri, _tsh__of=_tsh__lf-_tsh__rf"`,
+			")",
+			`if !_tsh__of! geq 1000000 set /A "_tsh__oi+=1, _tsh__of-=1000000"`,
+			`if !_tsh__of! leq -1000000 set /A "_tsh__oi-=1, _tsh__of+=1000000"`,
+			`if !_tsh__oi! gtr 0 if !_tsh__of! lss 0 set /A "_tsh__oi-=1, _tsh__of+=1000000"`,
+			`if !_tsh__oi! lss 0 if !_tsh__of! gtr 0 set /A "_tsh__oi+=1, _tsh__of-=1000000"`,
+			c.callFuncString(floatFormatHelper, []string{}, "_tsh__o"),
+		)
+
+		// %1: Name of the variable which holds the value
+		// %2: Result prefix
+		c.addHelper("float parse", floatParseHelper,
+			`set "_tsh__fs=!%1!"`,
+			`set "_tsh__fn="`,
+			`if "!_tsh__fs:~0,1!" equ "-" (`,
+			`set "_tsh__fn=1"`,
+			`set "_tsh__fs=!_tsh__fs:~1!"`,
+			")",
+			`for /f "tokens=1,2 delims=." %%i in ("!_tsh__fs!.0") do (`,
+			`set "%2i=%%i"`,
+			`set "_tsh__ff=%%j000000"`,
+			")",
+			`set /A "%2f=1!_tsh__ff:~0,6!-1000000"`, // Prefix with 1 to make sure leading zeros don't result in an octal number.
+			`if defined _tsh__fn set /A "%2i=-%2i, %2f=-%2f"`,
+		)
+
+		// %1: Value prefix
+		c.addHelper("float format", floatFormatHelper,
+			`set "_tsh__fr="`,
+			`if !%1i! lss 0 set "_tsh__fr=-"`,
+			`if !%1f! lss 0 set "_tsh__fr=-"`,
+			`if defined _tsh__fr set /A "%1i=-%1i, %1f=-%1f"`,
+			`set /A "_tsh__ff=%1f+1000000"`,
+			`set "_tsh__ff=!_tsh__ff:~1!"`,
+			":_ffh_loop",
+			`if defined _tsh__ff if "!_tsh__ff:~-1!" equ "0" (`,
+			`set "_tsh__ff=!_tsh__ff:~0,-1!"`,
+			"goto :_ffh_loop",
+			")",
+			`set "_tsh__fr=!_tsh__fr!!%1i!"`,
+			`if defined _tsh__ff set "_tsh__fr=!_tsh__fr!.!_tsh__ff!"`,
+		)
+
+		// The values are split into base 1000 digits which are multiplied like in a written multiplication
+		// to avoid overflows. _c1 is initialized with 500 to round the result to millionths.
+		c.addHelper("float multiplication", floatMultiplyHelper,
+			`set "_tsh__fz=0"`,
+			floatAbsString("_tsh__l"),
+			floatAbsString("_tsh__r"),
+			`set /A "_tsh__a0=_tsh__lf %% 1000, _tsh__a1=_tsh__lf/1000, _tsh__a2=_tsh__li %% 1000, _tsh__a3=_tsh__li/1000 %% 1000, _tsh__a4=_tsh__li/1000000"`,
+			`set /A "_tsh__b0=_tsh__rf %% 1000, _tsh__b1=_tsh__rf/1000, _tsh__b2=_tsh__ri %% 1000, _tsh__b3=_tsh__ri/1000 %% 1000, _tsh__b4=_tsh__ri/1000000"`,
+			`for /L %%i in (0,1,8) do set "_tsh__c%%i=0"`,
+			`set "_tsh__c1=500"`,
+			`for /L %%i in (0,1,4) do for /L %%j in (0,1,4) do (`,
+			`set /A "_k=%%i+%%j"`,
+			`set /A "_tsh__c!_k!+=_tsh__a%%i*_tsh__b%%j"`,
+			")",
+			`set "_tsh__t=0"`,
+			`for /L %%i in (0,1,8) do set /A "_tsh__t+=_tsh__c%%i, _tsh__c%%i=_tsh__t %% 1000, _tsh__t/=1000"`,
+			`set /A "_tsh__of=_tsh__c3*1000+_tsh__c2, _tsh__oi=_tsh__c7*1000000000+_tsh__c6*1000000+_tsh__c5*1000+_tsh__c4"`,
+			`if !_tsh__fz! equ 1 set /A "_tsh__oi=-_tsh__oi, _tsh__of=-_tsh__of"`,
+		)
+
+		// The division is done like a written division. The digits of the left value (in millionths) are
+		// divided by the right value (in millionths) which results in the integer part of the result. Then
+		// zeros are added to calculate the decimal places and one more digit to round the result.
+		c.addHelper("float division", floatDivideHelper,
+			`set "_tsh__fz=0"`,
+			floatAbsString("_tsh__l"),
+			floatAbsString("_tsh__r"),
+			`set /A "_tsh__ff=_tsh__lf+1000000"`,
+			`set "_tsh__fg=!_tsh__li!!_tsh__ff:~1!"`,
+			`set "_tsh__oi=0"`,
+			`set "_tsh__of=0"`,
+			`set "_tsh__mh=0"`,
+			`set "_tsh__ml=0"`,
+			`set "_tsh__fk=0"`,
+			":_fdh_loop",
+			`for %%k in (!_tsh__fk!) do set "_tsh__fx=!_tsh__fg:~%%k,1!"`,
+			"if defined _tsh__fx (",
+			c.callFuncString(floatDigitHelper, []string{}, "!_tsh__fx!"),
+			`set /A "_tsh__oi=_tsh__oi*10+_tsh__qd, _tsh__fk+=1"`,
+			"goto :_fdh_loop",
+			")",
+			fmt.Sprintf("for /L %%%%i in (1,1,%d) do (", transpiler.FloatPrecision),
+			c.callFuncString(floatDigitHelper, []string{}, "0"),
+			`set /A "_tsh__of=_tsh__of*10+_tsh__qd"`,
+			")",
+			c.callFuncString(floatDigitHelper, []string{}, "0"),
+			`if !_tsh__qd! geq 5 set /A "_tsh__of+=1"`,
+			`if !_tsh__of! geq 1000000 set /A "_tsh__oi+=1, _tsh__of-=1000000"`,
+			`if !_tsh__fz! equ 1 set /A "_tsh__oi=-_tsh__oi, _tsh__of=-_tsh__of"`,
+		)
+
+		// Adds the digit to the remainder (stored as _mh millions and _ml units) and calculates how
+		// often the right value fits into it.
+		//
+		// %1: Digit
+		c.addHelper("float division digit", floatDigitHelper,
+			`set /A "_tsh__mh=_tsh__mh*10+_tsh__ml/100000, _tsh__ml=_tsh__ml %% 100000*10+%1, _tsh__qd=0"`,
+			"for /L %%i in (1,1,9) do (",
+			`set "_tsh__ge="`,
+			`if !_tsh__mh! gtr !_tsh__ri! (set "_tsh__ge=1") else if !_tsh__mh! equ !_tsh__ri! if !_tsh__ml! geq !_tsh__rf! set "_tsh__ge=1"`,
+			"if defined _tsh__ge (",
+			`set /A "_tsh__qd+=1, _tsh__mh-=_tsh__ri, _tsh__ml-=_tsh__rf"`,
+			`if !_tsh__ml! lss 0 set /A "_tsh__ml+=1000000, _tsh__mh-=1"`,
+			")",
+			")",
+		)
+	}
+
 	if c.echoHelperRequired {
-		v := c.varEvaluationString(funcArgVar(0), true)
 		c.addHelper("echo", echoHelper,
-			fmt.Sprintf(`if "%s" neq "" (echo %s) else echo.`, v, v), // echo. could be problematic (see discussion: https://stackoverflow.com/a/20691061).
+			fmt.Sprintf("echo(!%s!", funcArgVar(0)), // echo( also works for empty values and values like "/?" (https://stackoverflow.com/a/20691061).
+		)
+	}
+
+	if c.echoInlineHelperRequired {
+		c.addHelper("echo inline", echoInlineHelper,
+			fmt.Sprintf(`<nul set /p "=!%s!"`, funcArgVar(0)), // set /p doesn't add a newline (https://stackoverflow.com/a/7105690).
+		)
+	}
+	// The arguments are stored before delayed expansion is enabled to keep "!" and at the top level
+	// because %1, %2, ... hold the function arguments inside functions.
+	if c.argsRequired {
+		c.startCode = slices.Insert(c.startCode, 1,
+			":: global arguments begin",
+			"setlocal DisableDelayedExpansion",
+			fmt.Sprintf(`set "%s_len=0"`, argsSlice),
+			":_agl",
+			`set "_tsh__aq=%1"`,
+			"if not defined _tsh__aq goto :_age",
+			fmt.Sprintf(`set "%[1]s_%%%[1]s_len%%=%%~1"`, argsSlice),
+			fmt.Sprintf(`set /A "%s_len+=1"`, argsSlice),
+			"shift",
+			"goto :_agl",
+			":_age",
+			":: global arguments end",
 		)
 	}
 	c.addEndLine(":end")
-	c.addEndLine("endlocal & exit /B %_e%")
+	c.addEndLine(fmt.Sprintf(`if not defined %[1]s set "%[1]s=0"`, exitCodeVar))
+	c.addEndLine(fmt.Sprintf("chcp !%s! >nul", codePageVar))
+	c.addEndLine(fmt.Sprintf("endlocal & exit /B %%%s%%", exitCodeVar))
 	return nil
 }
 
@@ -263,6 +756,18 @@ func (c *converter) SliceAssignment(name string, index string, value string, def
 	return nil
 }
 
+func (c *converter) MapAssignment(name string, key string, value string, global bool) error {
+	c.mapAssignmentHelperRequired = true
+	c.callFunc(mapAssignmentHelper, []string{key, value}, c.varEvaluationString(name, global))
+	return nil
+}
+
+func (c *converter) MapDelete(name string, key string) error {
+	c.mapDeleteHelperRequired = true
+	c.callFunc(mapDeleteHelper, []string{key}, name)
+	return nil
+}
+
 func (c *converter) FuncStart(name string, params []string, returnTypes []parser.ValueType) error {
 	c.funcCounter++
 	c.funcs = append(c.funcs, funcInfo{
@@ -271,9 +776,10 @@ func (c *converter) FuncStart(name string, params []string, returnTypes []parser
 	c.addLine(fmt.Sprintf(":: %s function begin", name))
 	c.addLine(fmt.Sprintf("goto :_eo_%s", name))
 	c.addLine(fmt.Sprintf(":%s", name))
+	c.addLine(fmt.Sprintf(`set /A "%s=!%s!+1"`, frameDepthVar, frameDepthVar))
 
 	for i, param := range params {
-		c.addLine(c.varAssignmentString(param, c.varEvaluationString(funcArgVar(i), true), false))
+		c.addLine(c.varAssignmentString(param, fmt.Sprintf("!%s!", funcArgVar(i)), false))
 	}
 	return nil
 }
@@ -282,6 +788,7 @@ func (c *converter) FuncEnd() error {
 	name := c.mustCurrentFuncInfo().name
 
 	c.addLine(fmt.Sprintf(":_ret_%s", name))
+	c.addLine(fmt.Sprintf(`set /A "%s=!%s!-1"`, frameDepthVar, frameDepthVar))
 	c.addLine("exit /B")
 	c.addLine(fmt.Sprintf(":_eo_%s", name))
 	c.addLine(fmt.Sprintf(":: %s function end", name))
@@ -305,7 +812,8 @@ func (c *converter) IfStart(condition string) error {
 	c.ifs = append(c.ifs, ifInfo{
 		label: c.nextIfLabel(),
 	})
-	return c.ifStart(condition, "")
+	c.addLine(fmt.Sprintf(`if "%s" equ "%s" (`, condition, transpiler.BoolToString(true)))
+	return nil
 }
 
 func (c *converter) IfEnd() error {
@@ -321,15 +829,6 @@ func (c *converter) IfEnd() error {
 	return nil
 }
 
-func (c *converter) ElseIfStart(condition string) error {
-	c.addLine(fmt.Sprintf("goto %s", c.mustCurrentIfInfo().label))
-	return c.ifStart(condition, ") else ")
-}
-
-func (c *converter) ElseIfEnd() error {
-	return nil
-}
-
 func (c *converter) ElseStart() error {
 	c.addLine(fmt.Sprintf("goto %s", c.mustCurrentIfInfo().label))
 	c.addLine(") else (")
@@ -346,19 +845,19 @@ func (c *converter) ForStart() error {
 	c.fors = append(c.fors, forInfo{
 		label: label,
 	})
-	c.addLine(fmt.Sprintf(`set "%s="`, c.mustCurrentForVar()))
+	c.addLine(c.varAssignmentString(c.mustCurrentForVar(), "", false))
 	c.addLine(label)
 	return nil
 }
 
 func (c *converter) ForIncrementStart() error {
-	c.addLine(fmt.Sprintf(`if defined %s (`, c.mustCurrentForVar()))
+	c.addLine(fmt.Sprintf(`if defined %s (`, c.varName(c.mustCurrentForVar(), false)))
 	return nil
 }
 
 func (c *converter) ForIncrementEnd() error {
 	c.addLine(")")
-	c.addLine(fmt.Sprintf(`set "%s=1"`, c.mustCurrentForVar()))
+	c.addLine(c.varAssignmentString(c.mustCurrentForVar(), "1", false))
 	return nil
 }
 
@@ -392,14 +891,31 @@ func (c *converter) Continue() error {
 	return nil
 }
 
-func (c *converter) Print(values []string) error {
-	c.callEchoFunc(values...)
+func (c *converter) Print(values []transpiler.PrintValue, stderr bool, newline bool) error {
+	strs := []string{}
+
+	// Bools are printed as true/false.
+	for _, value := range values {
+		s := value.Value()
+
+		if value.ValueType().IsBool() {
+			s = c.boolString(s)
+		}
+		strs = append(strs, s)
+	}
+	c.callEchoFunc(strings.Join(strs, " "), stderr, newline)
 	return nil
 }
 
 func (c *converter) Panic(value string) error {
-	c.callEchoFunc(value)
-	c.addLine(`set "_e=1"`)
+	c.callEchoFunc(value, true, true)
+	return c.Exit("1")
+}
+
+// Exit jumps to the end of the script. Within a function, this only leaves the current call. Therefore,
+// each function call checks if the exit code has been set and keeps on exiting (see FuncCall).
+func (c *converter) Exit(code string) error {
+	c.addLine(fmt.Sprintf(`set /A "%s=%s"`, exitCodeVar, code))
 	c.addLine("goto :end")
 	return nil
 }
@@ -407,10 +923,21 @@ func (c *converter) Panic(value string) error {
 func (c *converter) WriteFile(path string, content string, append string) error {
 	c.fileWriteHelperRequired = true
 
-	// Use global variable to pass content to write file helper because Batch doesn't
-	// support newline passing because it splits arguments at newlines.
-	c.callFunc(fileWriteHelper, []string{content}, path, append)
+	// Use global variables to pass content and path to write file helper because Batch doesn't
+	// support newline passing because it splits arguments at newlines. Furthermore, call expands
+	// percent signs again.
+	c.callFunc(fileWriteHelper, []string{content, path}, append)
+
+	return nil
+}
+
+func (c *converter) EnvSet(name string, value string) error {
+	c.addEnvNameLoop(name, fmt.Sprintf(`set "%%%%i=%s"`, value))
+	return nil
+}
 
+func (c *converter) EnvUnset(name string) error {
+	c.addEnvNameLoop(name, `set "%%i="`)
 	return nil
 }
 
@@ -419,6 +946,11 @@ func (c *converter) Nop() error {
 	return nil
 }
 
+func (c *converter) Marker(marker string) error {
+	c.addLine(marker)
+	return nil
+}
+
 func (c *converter) UnaryOperation(expr string, operator parser.UnaryOperator, valueType parser.ValueType, valueUsed bool) (string, error) {
 	helper := c.nextHelperVar()
 
@@ -462,6 +994,24 @@ func (c *converter) BinaryOperation(left string, operator parser.BinaryOperator,
 			return notAllowedError()
 		}
 		c.addLine(fmt.Sprintf(`set /A "%s=%s%s%s"`, c.varName(helper, false), left, operator, right))
+	case parser.DATA_TYPE_FLOAT:
+		var operation string
+
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION:
+			operation = "mul"
+		case parser.BINARY_OPERATOR_DIVISION:
+			operation = "div"
+		case parser.BINARY_OPERATOR_ADDITION:
+			operation = "add"
+		case parser.BINARY_OPERATOR_SUBTRACTION:
+			operation = "sub"
+		default:
+			return notAllowedError()
+		}
+		c.floatArithmeticHelperRequired = true
+		c.callFunc(floatArithmeticHelper, []string{left, right}, operation)
+		c.VarAssignment(helper, c.varEvaluationString("_fr", true), false)
 	case parser.DATA_TYPE_STRING:
 		switch operator {
 		case parser.BINARY_OPERATOR_ADDITION:
@@ -491,7 +1041,7 @@ func (c *converter) Comparison(left string, operator parser.CompareOperator, rig
 			case parser.COMPARE_OPERATOR_NOT_EQUAL:
 				operatorString = NOT_EQUAL_OPERATOR
 			}
-		case parser.DATA_TYPE_INTEGER:
+		case parser.DATA_TYPE_INTEGER, parser.DATA_TYPE_FLOAT:
 			switch operator {
 			case parser.COMPARE_OPERATOR_EQUAL:
 				operatorString = EQUAL_OPERATOR
@@ -521,6 +1071,15 @@ func (c *converter) Comparison(left string, operator parser.CompareOperator, rig
 		return "", fmt.Errorf("comparison %s is not allowed on type %s", operator, valueType.String())
 	}
 	helper := c.nextHelperVar()
+
+	// Floats are compared by the float comparison helper which returns the result as bool.
+	if valueType.IsFloat() {
+		c.floatComparisonHelperRequired = true
+		c.callFunc(floatComparisonHelper, []string{left, right}, operatorString)
+		c.VarAssignment(helper, c.varEvaluationString("_fr", true), false)
+
+		return c.VarEvaluation(helper, valueUsed, false)
+	}
 	c.addLine(
 		fmt.Sprintf(`if %s%s%s %s %s%s%s (%s) else %s`,
 			quote,
@@ -537,40 +1096,33 @@ func (c *converter) Comparison(left string, operator parser.CompareOperator, rig
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
-func (c *converter) LogicalOperation(left string, operator parser.LogicalOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
-	var line string
-	trueString := transpiler.BoolToString(true)
-	falseString := transpiler.BoolToString(false)
-	helper := c.nextHelperVar()
-	trueAssignment := c.varAssignmentString(helper, trueString, false)
-	falseAssignment := c.varAssignmentString(helper, falseString, false)
+func (c *converter) LogicalOperationStart(left string, operator parser.LogicalOperator) error {
+	var operatorString string
 
+	// The right side only needs to be evaluated if the left side is true for "&&" or false for "||".
 	switch operator {
 	case parser.LOGICAL_OPERATOR_AND:
-		line = fmt.Sprintf(`if %s equ %s (if %s equ %s (%s) else %s) else %s`,
-			left,
-			trueString,
-			right,
-			trueString,
-			trueAssignment,
-			falseAssignment,
-			falseAssignment,
-		)
+		operatorString = "equ"
 	case parser.LOGICAL_OPERATOR_OR:
-		line = fmt.Sprintf(`if %s equ %s (%s) else if %s equ %s (%s) else %s`,
-			left,
-			trueString,
-			trueAssignment,
-			right,
-			trueString,
-			trueAssignment,
-			falseAssignment,
-		)
+		operatorString = "neq"
 	default:
-		return "", fmt.Errorf(`unknown logical operator "%s"`, operator)
+		return fmt.Errorf(`unknown logical operator "%s"`, operator)
 	}
+	helper := c.nextHelperVar()
+	c.logicalOperations = append(c.logicalOperations, helper)
 
-	c.addLine(line)
+	c.VarAssignment(helper, left, false)
+	c.addLine(fmt.Sprintf(`if "%s" %s "%s" (`, c.varEvaluationString(helper, false), operatorString, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) LogicalOperationEnd(right string, valueUsed bool) (string, error) {
+	lastIndex := len(c.logicalOperations) - 1
+	helper := c.logicalOperations[lastIndex]
+	c.logicalOperations = slices.Delete(c.logicalOperations, lastIndex, lastIndex+1)
+
+	c.VarAssignment(helper, right, false)
+	c.addLine(")")
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
@@ -579,9 +1131,9 @@ func (c *converter) VarEvaluation(name string, valueUsed bool, global bool) (str
 }
 
 func (c *converter) SliceInstantiation(values []string, valueUsed bool) (string, error) {
-	c.addLine(`set /A "_dvc=!_dvc!+1"`) // Dynamic variable counter.
+	c.addLine(`set /A "_tsh__dvc=!_tsh__dvc!+1"`) // Dynamic variable counter.
 	helper := c.nextHelperVar()
-	c.VarAssignment(helper, "_dv!_dvc!", false)
+	c.VarAssignment(helper, "_tsh__dv!_tsh__dvc!", false)
 
 	c.sliceAssignmentHelperRequired = true
 	c.callFunc(sliceLenSetHelper, []string{}, c.varEvaluationString(helper, false), strconv.Itoa(len(values)))
@@ -593,7 +1145,7 @@ func (c *converter) SliceInstantiation(values []string, valueUsed bool) (string,
 	return c.varEvaluationString(helper, false), nil
 }
 
-func (c *converter) SliceEvaluation(name string, index string, valueUsed bool) (string, error) {
+func (c *converter) SliceEvaluation(name string, index string, defaultValue string, valueUsed bool) (string, error) {
 	helper := c.nextHelperVar()
 
 	// A for-loop is required because the evaluation wouldn't work with the following code as expected.
@@ -616,6 +1168,8 @@ func (c *converter) SliceEvaluation(name string, index string, valueUsed bool) (
 			c.varName(helper, false),
 		),
 	)
+	c.addLine(fmt.Sprintf("if not defined %s %s", c.varName(helper, false), c.varAssignmentString(helper, defaultValue, false)))
+
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
@@ -629,6 +1183,114 @@ func (c *converter) SliceLen(name string, valueUsed bool) (string, error) {
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
+func (c *converter) SliceRange(name string, startIndex string, endIndex string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.callFunc(sliceRangeHelper, []string{}, slice, name, startIndex, endIndex)
+
+	return slice, nil
+}
+
+func (c *converter) Append(name string, values []string, inPlace bool, valueUsed bool) (string, error) {
+	length, err := c.SliceLen(name, true)
+
+	if err != nil {
+		return "", err
+	}
+	slice, err := c.appendDestination(name, length, inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, length, false)
+
+	// Assign each value to the current length to append it.
+	for _, value := range values {
+		c.addLine(c.sliceAssignmentString(slice, c.varEvaluationString(helper, false), value, false))
+		c.addLine(fmt.Sprintf(`set /A "%s=%s+1"`, c.varName(helper, false), c.varEvaluationString(helper, false)))
+	}
+	c.callFunc(sliceLenSetHelper, []string{}, slice, c.varEvaluationString(helper, false))
+
+	return slice, nil
+}
+
+func (c *converter) AppendSlice(name string, source string, inPlace bool, valueUsed bool) (string, error) {
+	length, err := c.SliceLen(name, true)
+
+	if err != nil {
+		return "", err
+	}
+	slice, err := c.appendDestination(name, length, inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	sourceLength, err := c.SliceLen(source, true)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.callFunc(sliceRangeHelper, []string{}, slice, source, "0", sourceLength)
+
+	return slice, nil
+}
+
+func (c *converter) MapInstantiation(keys []string, values []string, valueUsed bool) (string, error) {
+	c.addLine(`set /A "_tsh__dvc=!_tsh__dvc!+1"`) // Dynamic variable counter.
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, "_tsh__dv!_tsh__dvc!", false)
+	name := c.varEvaluationString(helper, false)
+
+	c.sliceLenSetHelperRequired = true
+	c.callFunc(sliceLenSetHelper, []string{}, fmt.Sprintf("%s_k", name), "0")
+	c.callFunc(sliceLenSetHelper, []string{}, fmt.Sprintf("%s_v", name), "0")
+
+	// Init map values.
+	for i, key := range keys {
+		c.mapAssignmentHelperRequired = true
+		c.callFunc(mapAssignmentHelper, []string{key, values[i]}, name)
+	}
+	return name, nil
+}
+
+func (c *converter) MapEvaluation(name string, key string, defaultValue string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	okHelper := c.nextHelperVar()
+
+	c.mapEvaluationHelperRequired = true
+	c.callFunc(mapEvaluationHelper, []string{key, defaultValue}, name)
+	c.VarAssignment(valueHelper, c.varEvaluationString("_mv", true), false)
+	c.VarAssignment(okHelper, c.varEvaluationString("_mok", true), false)
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(okHelper, false), nil
+}
+
+func (c *converter) MapLen(name string, valueUsed bool) (string, error) {
+	return c.SliceLen(fmt.Sprintf("%s_k", name), valueUsed)
+}
+
+func (c *converter) MapKeys(name string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, slice, false)
+
+	// Copy the keys slice to make sure it's not modified if the map is modified.
+	c.sliceCopyHelperRequired = true
+	c.callFunc(sliceCopyHelper, []string{}, c.varName(helper, false), fmt.Sprintf("%s_k", name))
+
+	return c.varEvaluationString(helper, false), nil
+}
+
 func (c *converter) StringSubscript(value string, startIndex string, endIndex string, valueUsed bool) (string, error) {
 	helper := c.nextHelperVar()
 	c.stringSubscriptHelperRequired = true
@@ -649,6 +1311,53 @@ func (c *converter) StringLen(value string, valueUsed bool) (string, error) {
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
+func (c *converter) FloatToInt(value string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	// Remove the decimal places to truncate the value like Go does (set /A turns -0 into 0).
+	c.addLine(fmt.Sprintf(`for /f "delims=." %%%%i in ("%s") do set /A "%s=%%%%i"`, value, c.varName(helper, false)))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Atoi(value string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	errHelper := c.nextHelperVar()
+
+	c.atoiHelperRequired = true
+	c.callFunc(atoiHelper, []string{value})
+	c.VarAssignment(valueHelper, c.varEvaluationString("_atv", true), false)
+	c.VarAssignment(errHelper, c.varEvaluationString("_ate", true), false)
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(errHelper, false), nil
+}
+
+func (c *converter) Format(value string, valueType parser.ValueType, verb parser.FormatVerb, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	if valueType.IsBool() {
+		value = c.boolString(value)
+	}
+	if verb.Verb() == 'q' {
+		c.quoteHelperRequired = true
+		c.callFunc(quoteHelper, []string{value})
+		value = c.varEvaluationString("_qr", true)
+	}
+	if verb.Width() > 0 {
+		alignment := "r"
+
+		if verb.LeftAlign() {
+			alignment = "l"
+		} else if verb.ZeroPad() {
+			alignment = "z"
+		}
+		c.formatPadHelperRequired = true
+		c.callFunc(formatPadHelper, []string{value}, strconv.Itoa(verb.Width()), alignment)
+		value = c.varEvaluationString("_fmr", true)
+	}
+	c.VarAssignment(helper, value, false)
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
 func (c *converter) Group(value string, valueUsed bool) (string, error) {
 	return fmt.Sprintf("(%s)", value), nil
 }
@@ -656,6 +1365,7 @@ func (c *converter) Group(value string, valueUsed bool) (string, error) {
 func (c *converter) FuncCall(name string, args []string, returnTypes []parser.ValueType, valueUsed bool) ([]string, error) {
 	returnValues := []string{}
 	c.callFunc(name, args)
+	c.addLine(fmt.Sprintf("if defined %s goto :end", exitCodeVar)) // Keep on exiting if the function called exit.
 
 	if valueUsed {
 		for i := range returnTypes {
@@ -674,18 +1384,36 @@ func (c *converter) FuncCall(name string, args []string, returnTypes []parser.Va
 }
 
 func (c *converter) AppCall(calls []transpiler.AppCall, valueUsed bool) ([]string, error) {
-	callsCopy := calls
+	// The call string is embedded into quotes (cmd /C "..." or a variable assignment). Therefore, the
+	// quotes must be escaped to not end them.
+	c.addEscapeVars()
+	quote := "!_tsh__q!"
 	callStrings := []string{}
+	argCounter := 0
 
-	for _, call := range callsCopy {
+	for _, call := range calls {
 		argsCopy := call.Args()
 
+		// Quote all arguments to make sure they are passed as they are.
 		for j, arg := range argsCopy {
-			// If argument is a variable or contains whitespaces, quote it.
-			if strings.HasPrefix(arg, "%") || len(strings.Split(arg, " ")) > 1 {
-				arg = fmt.Sprintf(`"%s"`, arg)
+			if call.Spread() && j == len(argsCopy)-1 {
+				helper := c.nextHelperVar()
+				c.sliceSpreadHelperRequired = true
+
+				// The spread helper quotes the values itself.
+				c.callFunc(sliceSpreadHelper, []string{}, arg)
+				c.VarAssignment(helper, c.varEvaluationString("_ss", true), false)
+				argsCopy[j] = c.varEvaluationString(helper, false)
+			} else {
+				// Calls are run by cmd /V:ON which would parse the values again. Therefore, the arguments
+				// are stored in variables and only the references are passed (the expanded values are not
+				// parsed again).
+				argVar := appCallArgVar(argCounter)
+				argCounter++
+
+				c.addLine(fmt.Sprintf(`set "%s=%s"`, argVar, arg))
+				argsCopy[j] = fmt.Sprintf("%s^!%s^!%s", quote, argVar, quote)
 			}
-			argsCopy[j] = arg
 		}
 		space := ""
 
@@ -696,32 +1424,68 @@ func (c *converter) AppCall(calls []transpiler.AppCall, valueUsed bool) ([]strin
 	}
 
 	if valueUsed {
-		helper1 := c.nextHelperVar()
-		helper2 := c.nextHelperVar()
+		stdoutHelper := c.nextHelperVar()
+		stderrHelper := c.nextHelperVar()
+		codeHelper := c.nextHelperVar()
 		c.appCallHelperRequired = true
 
 		c.addLf()
 		c.callFunc(appCallHelper, []string{strings.Join(callStrings, " | ")})
-		c.VarAssignment(helper1, c.varEvaluationString("_h", true), false)
-
-		eval, err := c.VarEvaluation(helper1, valueUsed, false)
-
-		if err != nil {
-			return nil, err
-		}
-		c.VarAssignment(helper2, c.varEvaluationString("_te", true), false)
-		return []string{eval, "", c.varEvaluationString(helper2, false)}, nil // TODO: Return stderr (https://github.com/monstermichl/TypeShell/issues/28).
+		c.VarAssignment(stdoutHelper, c.varEvaluationString("_h", true), false)
+		c.VarAssignment(stderrHelper, c.varEvaluationString("_he", true), false)
+		c.VarAssignment(codeHelper, c.varEvaluationString("_te", true), false)
+
+		return []string{
+			c.varEvaluationString(stdoutHelper, false),
+			c.varEvaluationString(stderrHelper, false),
+			c.varEvaluationString(codeHelper, false),
+		}, nil
 	}
-	c.addLine(fmt.Sprintf("call %s", strings.Join(callStrings, " | ")))
+	c.addLine(fmt.Sprintf(`cmd /V:ON /C "%s"`, strings.Join(callStrings, " | ")))
 	return []string{"", "", "0"}, nil
 }
 
 func (c *converter) Input(prompt string, valueUsed bool) (string, error) {
 	helper := c.nextHelperVar()
-	c.addLine(fmt.Sprintf(`set /p "%s=%s"`, helper, prompt))
+	c.addLine(fmt.Sprintf(`set /p "%s=%s"`, c.varName(helper, false), prompt))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Args(valueUsed bool) (string, error) {
+	c.argsRequired = true
+	return argsSlice, nil
+}
+
+func (c *converter) EnvGet(name string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.VarAssignment(helper, "", false)
+	c.addEnvNameLoop(name, fmt.Sprintf(`set "%s=!%%%%i!"`, c.varName(helper, false)))
+
 	return c.VarEvaluation(helper, valueUsed, false)
 }
 
+func (c *converter) EnvExists(name string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.VarAssignment(helper, transpiler.BoolToString(false), false)
+	c.addEnvNameLoop(name, fmt.Sprintf(`if defined %%%%i %s`, c.varAssignmentString(helper, transpiler.BoolToString(true), false)))
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Environ(valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.environHelperRequired = true
+	c.callFunc(environHelper, []string{}, slice)
+
+	return slice, nil
+}
+
 func (c *converter) Copy(destination string, source string, valueUsed bool, global bool) (string, error) {
 	c.sliceCopyHelperRequired = true
 	c.callFunc(sliceCopyHelper, []string{}, c.varName(destination, global), source)
@@ -746,7 +1510,7 @@ func (c *converter) ReadFile(path string, valueUsed bool) (string, error) {
 	c.readHelperRequired = true
 
 	c.addLf()
-	c.callFunc(fileReadHelper, []string{}, path)
+	c.callFunc(fileReadHelper, []string{path})
 	c.VarAssignment(helper, c.varEvaluationString("_h", true), false)
 
 	return c.VarEvaluation(helper, valueUsed, false)
@@ -754,7 +1518,7 @@ func (c *converter) ReadFile(path string, valueUsed bool) (string, error) {
 
 func (c *converter) callFuncString(name string, globalArgs []string, args ...string) string {
 	for i, arg := range globalArgs {
-		c.VarAssignment(funcArgVar(i), arg, true)
+		c.addLine(fmt.Sprintf(`set "%s=%s"`, funcArgVar(i), arg))
 	}
 	return fmt.Sprintf("call :%s %s", strings.TrimLeft(name, ":"), strings.Join(args, " "))
 }
@@ -763,16 +1527,43 @@ func (c *converter) callFunc(name string, globalArgs []string, args ...string) {
 	c.addLine(c.callFuncString(name, globalArgs, args...))
 }
 
-func (c *converter) callEchoFunc(values ...string) {
-	c.echoHelperRequired = true
-	c.addLine(c.callFuncString(echoHelper, []string{strings.Join(values, " ")}))
+// boolString converts a bool value to true/false and returns the evaluation of the helper variable.
+func (c *converter) boolString(value string) string {
+	helper := c.nextHelperVar()
+
+	c.addLine(fmt.Sprintf(`if "%s" equ "%s" (%s) else %s`,
+		value,
+		transpiler.BoolToString(true),
+		c.varAssignmentString(helper, "true", false),
+		c.varAssignmentString(helper, "false", false),
+	))
+	return c.varEvaluationString(helper, false)
+}
+
+func (c *converter) callEchoFunc(value string, stderr bool, newline bool) {
+	helper := echoHelper
+	redirect := ""
+
+	if newline {
+		c.echoHelperRequired = true
+	} else {
+		helper = echoInlineHelper
+		c.echoInlineHelperRequired = true
+	}
+	if stderr {
+		redirect = " 1>&2"
+	}
+	c.addLine(c.callFuncString(helper, []string{value}) + redirect)
 }
 
+// varName returns the name of the Batch variable. All variables are prefixed to keep them apart from
+// environment variables which share the same namespace in Batch.
 func (c *converter) varName(name string, global bool) string {
 	if c.inFunction() && !global {
-		name = fmt.Sprintf("f%d_%s", c.funcCounter, name)
+		// Add the call depth to make sure recursive calls don't overwrite each other's variables.
+		name = fmt.Sprintf("f%d_%s_%%%s%%", c.funcCounter, name, frameDepthVar)
 	}
-	return name
+	return fmt.Sprintf("%s%s", transpiler.VarPrefix, name)
 }
 
 func (c *converter) varAssignmentString(name string, value string, global bool) string {
@@ -783,11 +1574,6 @@ func (c *converter) varEvaluationString(name string, global bool) string {
 	return fmt.Sprintf("!%s!", c.varName(name, global))
 }
 
-func (c *converter) ifStart(condition string, startAddition string) error {
-	c.addLine(fmt.Sprintf(`%sif "%s" equ "%s" (`, startAddition, condition, transpiler.BoolToString(true)))
-	return nil
-}
-
 func (c *converter) addStartLine(line string) {
 	c.startCode = append(c.startCode, line)
 }
@@ -855,6 +1641,15 @@ func (c *converter) addHelper(helperType string, label string, code ...string) {
 	c.addHelperLine(fmt.Sprintf(":: global %s helper end", helperType))
 }
 
+// appendDestination returns the slice to which values are appended. Unless the values are appended in
+// place, it's a copy of the passed slice.
+func (c *converter) appendDestination(name string, length string, inPlace bool, valueUsed bool) (string, error) {
+	if inPlace {
+		return name, nil
+	}
+	return c.SliceRange(name, "0", length, valueUsed)
+}
+
 func (c *converter) inFunction() bool {
 	return len(c.funcs) > 0
 }
@@ -896,9 +1691,33 @@ func (c *converter) sliceAssignmentString(name string, index string, value strin
 	return fmt.Sprintf(`set "%s_%s=%s"`, name, index, value)
 }
 
+// addEnvNameLoop adds a for-loop which provides the variable name in %%i to the command. The loop is
+// required because the name is usually the value of another variable (same as in SliceEvaluation). Names
+// with the variable prefix are skipped because they belong to the script's variables.
+func (c *converter) addEnvNameLoop(name string, command string) {
+	c.addLine(fmt.Sprintf(`set "_tsh__en=%s"`, name))
+	c.addLine(fmt.Sprintf(`if /i not "!_tsh__en:~0,%d!" equ "%s" for /f "delims=" %%%%i in ("!_tsh__en!") do %s`, len(transpiler.VarPrefix), transpiler.VarPrefix, command))
+}
+
+// floatAbsString returns the line which makes the parsed float with the provided prefix positive and
+// toggles the sign flag (_fz) if it was negative.
+func floatAbsString(prefix string) string {
+	return fmt.Sprintf(`if !%[1]si! lss 0 (set /A "_tsh__fz=1-_tsh__fz, %[1]si=-%[1]si, %[1]sf=-%[1]sf") else if !%[1]sf! lss 0 set /A "_tsh__fz=1-_tsh__fz, %[1]sf=-%[1]sf"`, prefix)
+}
+
+func (c *converter) addEscapeVars() {
+	if !c.escapeVarsSet {
+		c.addStartLine(`set _tsh__q=^"`)
+		c.addStartLine(`set "_tsh__c=^"`)
+		c.addStartLine(`set "_tsh__p=%%"`)
+
+		c.escapeVarsSet = true
+	}
+}
+
 func (c *converter) addLf() {
 	if !c.lfSet {
-		c.addStartLine("(set LF=^") // https://stackoverflow.com/a/60389149
+		c.addStartLine("(set _tsh__nl=^") // https://stackoverflow.com/a/60389149
 		c.addStartLine("")
 		c.addStartLine(")")
 
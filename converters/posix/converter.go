@@ -0,0 +1,1288 @@
+package posix
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/monstermichl/typeshell/parser"
+	"github.com/monstermichl/typeshell/transpiler"
+)
+
+type helperName = string
+
+const (
+	stackPushHelper       helperName = "_sph" // Stack push
+	stackPopHelper        helperName = "_spp" // Stack pop
+	sliceValueHelper      helperName = "_svh" // Slice value set
+	sliceAssignmentHelper helperName = "_sah" // Slice assignment
+	sliceEvaluationHelper helperName = "_sgh" // Slice evaluation
+	sliceCopyHelper       helperName = "_sch" // Slice copy
+	sliceRangeHelper      helperName = "_srh" // Slice range
+	mapFindHelper         helperName = "_mfh" // Map find
+	mapAssignmentHelper   helperName = "_mah" // Map assignment
+	mapEvaluationHelper   helperName = "_mgh" // Map evaluation
+	mapDeleteHelper       helperName = "_mdh" // Map delete
+	stringSubscriptHelper helperName = "_ssh" // String subscript
+	floatHelper           helperName = "_flh" // Float operation
+	envGetHelper          helperName = "_egh" // Environment variable get
+	envSetHelper          helperName = "_esh" // Environment variable set
+	envUnsetHelper        helperName = "_euh" // Environment variable unset
+	environHelper         helperName = "_elh" // Environment variable list
+	quoteHelper           helperName = "_qh"  // Quote
+	atoiHelper            helperName = "_aih" // String to integer
+)
+
+const argsSlice = "_args" // Slice which holds the script arguments.
+const tempDirVar = "_td"  // Private directory for temporary files.
+
+type funcInfo struct {
+	name        string
+	startIndex  int      // Index of the function's first code line.
+	returnLines []int    // Indices of the function's return statements.
+	locals      []string // Stores the variables which need to be restored when the function returns.
+}
+
+type converter struct {
+	interpreter                   string
+	logicalOperations             []string // Stores the result variables of the currently evaluated logical operations.
+	startCode                     []string
+	code                          []string
+	varCounter                    int
+	forCounter                    int
+	funcs                         []funcInfo
+	funcCounter                   int
+	stackHelperRequired           bool
+	sliceValueHelperRequired      bool
+	sliceAssignmentHelperRequired bool
+	sliceEvaluationHelperRequired bool
+	sliceCopyHelperRequired       bool
+	sliceRangeHelperRequired      bool
+	mapFindHelperRequired         bool
+	mapAssignmentHelperRequired   bool
+	mapEvaluationHelperRequired   bool
+	mapDeleteHelperRequired       bool
+	stringSubscriptHelperRequired bool
+	floatHelperRequired           bool
+	envGetHelperRequired          bool
+	envSetHelperRequired          bool
+	envUnsetHelperRequired        bool
+	environHelperRequired         bool
+	quoteHelperRequired           bool
+	atoiHelperRequired            bool
+	tempDirRequired               bool
+	argsRequired                  bool
+}
+
+func New() *converter {
+	return &converter{
+		interpreter: "/bin/sh",
+		code:        []string{},
+	}
+}
+
+// StringToString escapes all characters which have a special meaning within double quotes. Therefore,
+// all values must be embedded in double quotes.
+func (c *converter) StringToString(value string) string {
+	return escapeString(value)
+}
+
+func (c *converter) Dump() (string, error) {
+	allCode := []string{}
+
+	allCode = append(allCode, c.startCode...)
+	allCode = append(allCode, c.code...)
+	allCode = append(allCode, "") // Add a terminating newline.
+
+	return strings.Join(allCode, "\n"), nil
+}
+
+func (c *converter) Extension() string {
+	return "sh"
+}
+
+func (c *converter) ProgramStart() error {
+	c.addStartLine(fmt.Sprintf("#!%s", c.interpreter))
+	return nil
+}
+
+// POSIX sh doesn't support arrays. Therefore, slices are stored as one variable per element (<slice>_<index>)
+// plus a length variable (<slice>_len). Maps are stored as two slices (<map>_k and <map>_v) which hold the keys
+// and the values at the same index. To access these variables, eval is used. Values are never embedded into
+// the evaluated code, only names, which makes sure values are not evaluated again.
+func (c *converter) ProgramEnd() error {
+	// POSIX sh doesn't support local variables. Therefore, the variables of a function are pushed
+	// to a stack when the function is called and restored when it returns (required for recursion).
+	if c.stackHelperRequired {
+		// $@: Variable names
+		c.addHelper("stack push", stackPushHelper,
+			`for _tsh__spn in "$@"; do`,
+			"_tsh__sp=$((${_tsh__sp:-0}+1))",
+			`eval "_tsh__st${_tsh__sp}=\${${_tsh__spn}}"`,
+			"done",
+		)
+
+		// $@: Variable names (in reverse order)
+		c.addHelper("stack pop", stackPopHelper,
+			`for _tsh__spn in "$@"; do`,
+			`eval "${_tsh__spn}=\${_tsh__st${_tsh__sp}}"`,
+			"_tsh__sp=$((_tsh__sp-1))",
+			"done",
+		)
+	}
+
+	if c.mapAssignmentHelperRequired {
+		c.mapFindHelperRequired = true
+		c.sliceValueHelperRequired = true
+
+		// $1: Map name
+		// $2: Key
+		// $3: Value
+		c.addHelper("map assignment", mapAssignmentHelper,
+			`_mfh "${1}" "${2}"`,
+			`if [ "${_tsh__mi}" -lt 0 ]; then`,
+			`eval "_tsh__mi=\${${1}_k_len:-0}"`,
+			`_svh "${1}_k" "${_tsh__mi}" "${2}"`,
+			"fi",
+			`_svh "${1}_v" "${_tsh__mi}" "${3}"`,
+		)
+	}
+
+	if c.mapEvaluationHelperRequired {
+		c.mapFindHelperRequired = true
+
+		// $1: Map name
+		// $2: Key
+		// $3: Default value
+		c.addHelper("map evaluation", mapEvaluationHelper,
+			`_mfh "${1}" "${2}"`,
+			`if [ "${_tsh__mi}" -lt 0 ]; then`,
+			`_tsh__mv="${3}"`,
+			fmt.Sprintf("_tsh__mok=%s", transpiler.BoolToString(false)),
+			"else",
+			`eval "_tsh__mv=\${${1}_v_${_tsh__mi}}"`,
+			fmt.Sprintf("_tsh__mok=%s", transpiler.BoolToString(true)),
+			"fi",
+		)
+	}
+
+	if c.mapDeleteHelperRequired {
+		c.mapFindHelperRequired = true
+
+		// The last entry is moved to the position of the deleted one.
+		//
+		// $1: Map name
+		// $2: Key
+		c.addHelper("map delete", mapDeleteHelper,
+			`_mfh "${1}" "${2}"`,
+			`if [ "${_tsh__mi}" -ge 0 ]; then`,
+			`eval "_tsh__mdl=\$((\${${1}_k_len}-1))"`,
+			`eval "${1}_k_${_tsh__mi}=\${${1}_k_${_tsh__mdl}}"`,
+			`eval "${1}_v_${_tsh__mi}=\${${1}_v_${_tsh__mdl}}"`,
+			`unset "${1}_k_${_tsh__mdl}" "${1}_v_${_tsh__mdl}"`,
+			`eval "${1}_k_len=\${_tsh__mdl}"`,
+			`eval "${1}_v_len=\${_tsh__mdl}"`,
+			"fi",
+		)
+	}
+
+	if c.mapFindHelperRequired {
+		// Sets _mi to the index of the key or to -1 if the key doesn't exist.
+		//
+		// $1: Map name
+		// $2: Key
+		c.addHelper("map find", mapFindHelper,
+			"_tsh__mi=-1",
+			"_tsh__mfi=0",
+			`eval "_tsh__mfl=\${${1}_k_len:-0}"`,
+			`while [ "${_tsh__mfi}" -lt "${_tsh__mfl}" ]; do`,
+			`eval "_tsh__mfk=\${${1}_k_${_tsh__mfi}}"`,
+			`if [ "${_tsh__mfk}" = "${2}" ]; then`,
+			"_tsh__mi=${_tsh__mfi}",
+			"return",
+			"fi",
+			"_tsh__mfi=$((_tsh__mfi+1))",
+			"done",
+		)
+	}
+
+	if c.sliceCopyHelperRequired {
+		// $1: Destination slice name
+		// $2: Source slice name
+		c.addHelper("slice copy", sliceCopyHelper,
+			"_tsh__sci=0",
+			`eval "_tsh__scl=\${${2}_len:-0}"`,
+			`while [ "${_tsh__sci}" -lt "${_tsh__scl}" ]; do`,
+			`eval "${1}_${_tsh__sci}=\${${2}_${_tsh__sci}}"`,
+			"_tsh__sci=$((_tsh__sci+1))",
+			"done",
+			`eval "_tsh__scd=\${${1}_len:-0}"`,
+			`if [ "${_tsh__scl}" -gt "${_tsh__scd}" ]; then eval "${1}_len=\${_tsh__scl}"; fi`,
+		)
+	}
+
+	if c.sliceRangeHelperRequired {
+		// Indices are clamped to the slice bounds. The values are appended to the destination slice.
+		//
+		// $1: Destination slice name
+		// $2: Source slice name
+		// $3: Start index
+		// $4: End index (excluded)
+		c.addHelper("slice range", sliceRangeHelper,
+			`eval "_tsh__sri=\${${1}_len:-0}"`,
+			"_tsh__srs=$((${3}))",
+			"_tsh__sre=$((${4}))",
+			`eval "_tsh__srl=\${${2}_len:-0}"`,
+			`if [ "${_tsh__srs}" -lt 0 ]; then _tsh__srs=0; fi`,
+			`if [ "${_tsh__sre}" -gt "${_tsh__srl}" ]; then _tsh__sre=${_tsh__srl}; fi`,
+			`while [ "${_tsh__srs}" -lt "${_tsh__sre}" ]; do`,
+			`eval "${1}_${_tsh__sri}=\${${2}_${_tsh__srs}}"`,
+			"_tsh__sri=$((_tsh__sri+1))",
+			"_tsh__srs=$((_tsh__srs+1))",
+			"done",
+			`eval "${1}_len=\${_tsh__sri}"`,
+		)
+	}
+
+	if c.environHelperRequired {
+		c.sliceValueHelperRequired = true
+
+		// $1: Slice name
+		c.addHelper("environment list", environHelper,
+			"_tsh__eli=0",
+			`for _tsh__eln in $(env | sed -n 's/^\([a-zA-Z_][a-zA-Z0-9_]*\)=.*/\1/p'); do`,
+			fmt.Sprintf(`case "${_tsh__eln}" in %s*) continue ;; esac`, transpiler.VarPrefix),
+			`eval "_tsh__elv=\${${_tsh__eln}}"`,
+			fmt.Sprintf(`%s "${1}" "${_tsh__eli}" "${_tsh__eln}=${_tsh__elv}"`, sliceValueHelper),
+			"_tsh__eli=$((_tsh__eli+1))",
+			"done",
+		)
+	}
+
+	if c.quoteHelperRequired {
+		// The value is quoted character by character because awk implementations handle backslashes in gsub differently.
+		//
+		// $1: Value
+		c.addHelper("quote", quoteHelper,
+			`_tsh__ret=$(printf '%s\n' "${1}" | awk '{ if (NR > 1) r = r "\\n"; for (i = 1; i <= length($0); i++) { c = substr($0, i, 1); if (c == "\\" || c == "\"") r = r "\\" c; else if (c == "\t") r = r "\\t"; else if (c == "\r") r = r "\\r"; else r = r c } } END { printf "\"%s\"", r }')`,
+		)
+	}
+
+	if c.atoiHelperRequired {
+		// Leading zeros are removed to avoid an octal interpretation. Since POSIX test can't compare strings
+		// by size, 19 digit values are split to check if they exceed the 64-bit range. The magnitude of the
+		// minimum value exceeds the maximum value, therefore it's assigned directly.
+		//
+		// $1: Value
+		c.addHelper("atoi", atoiHelper,
+			"_tsh__atv=0",
+			`_tsh__ate=""`,
+			`_tsh__aid="${1#[+-]}"`,
+			`case "${_tsh__aid}" in ''|*[!0-9]*) _tsh__ate="strconv.Atoi: parsing \"${1}\": invalid syntax"; return;; esac`,
+			`_tsh__aid="${_tsh__aid#"${_tsh__aid%%[!0]*}"}"`,
+			`_tsh__aia="${_tsh__aid%?}"`,
+			"_tsh__ail=7",
+			`case "${1}" in -*) _tsh__ail=8;; esac`,
+			`if [ ${#_tsh__aid} -gt 19 ] || { [ ${#_tsh__aid} -eq 19 ] && { [ "${_tsh__aia}" -gt 922337203685477580 ] || { [ "${_tsh__aia}" -eq 922337203685477580 ] && [ "${_tsh__aid#"${_tsh__aia}"}" -gt "${_tsh__ail}" ]; }; }; }; then _tsh__ate="strconv.Atoi: parsing \"${1}\": value out of range"; return; fi`,
+			`if [ "${_tsh__aid}" = "9223372036854775808" ]; then _tsh__atv=$((-9223372036854775807-1)); return; fi`,
+			"_tsh__atv=$((${_tsh__aid:-0}))",
+			`case "${1}" in -*) _tsh__atv=$((-_tsh__atv));; esac`,
+		)
+	}
+
+	if c.sliceAssignmentHelperRequired {
+		c.sliceValueHelperRequired = true
+
+		// $1: Slice name
+		// $2: Assignment index
+		// $3: Assignment value
+		// $4: Default value
+		c.addHelper("slice assignment", sliceAssignmentHelper,
+			"_tsh__sai=$((${2}))",
+			`eval "_tsh__sal=\${${1}_len:-0}"`,
+			`while [ "${_tsh__sal}" -lt "${_tsh__sai}" ]; do`,
+			`_svh "${1}" "${_tsh__sal}" "${4}"`,
+			"_tsh__sal=$((_tsh__sal+1))",
+			"done",
+			`_svh "${1}" "${_tsh__sai}" "${3}"`,
+		)
+	}
+
+	if c.sliceValueHelperRequired {
+		// Sets the value and increases the length if required.
+		//
+		// $1: Slice name
+		// $2: Index
+		// $3: Value
+		c.addHelper("slice value", sliceValueHelper,
+			"_tsh__svi=$((${2}))",
+			`eval "${1}_${_tsh__svi}=\${3}"`,
+			`eval "_tsh__svl=\${${1}_len:-0}"`,
+			`if [ "${_tsh__svi}" -ge "${_tsh__svl}" ]; then eval "${1}_len=$((_tsh__svi+1))"; fi`,
+		)
+	}
+
+	if c.sliceEvaluationHelperRequired {
+		// $1: Slice name
+		// $2: Index
+		// $3: Default value
+		c.addHelper("slice evaluation", sliceEvaluationHelper,
+			`eval "_tsh__ret=\${${1}_$((${2}))-\${3}}"`,
+		)
+	}
+
+	// POSIX sh only supports integer arithmetic, therefore awk is used for float operations and comparisons.
+	if c.floatHelperRequired {
+		// $1: Left value
+		// $2: Operator
+		// $3: Right value
+		c.addHelper("float", floatHelper, floatHelperCommand())
+	}
+
+	if c.stringSubscriptHelperRequired {
+		// Characters are removed one by one because POSIX sh doesn't support substrings (https://pubs.opengroup.org/onlinepubs/9699919799/utilities/V3_chap02.html#tag_18_06_02).
+		//
+		// $1: String
+		// $2: Start index
+		// $3: End index
+		c.addHelper("substring", stringSubscriptHelper,
+			`_tsh__sss="${1}"`,
+			"_tsh__ssb=$((${2}))",
+			"_tsh__ssl=$(((${3})-_tsh__ssb+1))",
+			`if [ "${_tsh__ssb}" -lt 0 ]; then _tsh__ssb=$((${#_tsh__sss}+_tsh__ssb)); fi`, // Negative start indices count from the end (like in Bash).
+			`_tsh__ret=""`,
+			`while [ "${_tsh__ssb}" -gt 0 ] && [ -n "${_tsh__sss}" ]; do`,
+			`_tsh__sss="${_tsh__sss#?}"`,
+			"_tsh__ssb=$((_tsh__ssb-1))",
+			"done",
+			`while [ "${_tsh__ssl}" -gt 0 ] && [ -n "${_tsh__sss}" ]; do`,
+			`_tsh__ret="${_tsh__ret}${_tsh__sss%"${_tsh__sss#?}"}"`,
+			`_tsh__sss="${_tsh__sss#?}"`,
+			"_tsh__ssl=$((_tsh__ssl-1))",
+			"done",
+		)
+	}
+
+	// Environment variable names are validated because they are expanded via eval.
+	if c.envGetHelperRequired {
+		// $1: Name
+		c.addHelper("environment get", envGetHelper,
+			`_tsh__ret=""`,
+			fmt.Sprintf(`_tsh__ok=%s`, transpiler.BoolToString(false)),
+			envNameCheckString(),
+			fmt.Sprintf(`if env | grep -q "^${1}="; then eval "_tsh__ret=\${${1}}"; _tsh__ok=%s; fi`, transpiler.BoolToString(true)), // Only exported variables belong to the environment.
+		)
+	}
+
+	if c.envSetHelperRequired {
+		// $1: Name
+		// $2: Value
+		c.addHelper("environment set", envSetHelper,
+			envNameCheckString(),
+			`export "${1}=${2}"`,
+		)
+	}
+
+	if c.envUnsetHelperRequired {
+		// $1: Name
+		c.addHelper("environment unset", envUnsetHelper,
+			envNameCheckString(),
+			`unset "${1}"`,
+		)
+	}
+
+	// mktemp is not part of POSIX, therefore a private directory is created for temporary files. mkdir
+	// fails if the path already exists (also if it's a symlink), therefore the name is changed until it
+	// succeeds. The directory is removed when the script exits, signals are turned into an exit to do
+	// so as well.
+	if c.tempDirRequired {
+		c.addStartLine("# global temporary directory")
+		c.addStartLine("_tsh__tdn=0")
+		c.addStartLine(fmt.Sprintf(`until %s="${TMPDIR:-/tmp}/_tsh_$$_${_tsh__tdn}"; mkdir -m 700 "%s" 2>/dev/null; do`, c.varName(tempDirVar, true), c.varEvaluationString(tempDirVar, true)))
+		c.addStartLine("_tsh__tdn=$((_tsh__tdn+1))")
+		c.addStartLine(`if [ "${_tsh__tdn}" -ge 100 ]; then echo "failed to create a temporary directory" >&2; exit 1; fi`)
+		c.addStartLine("done")
+		c.addStartLine(fmt.Sprintf(`trap 'rm -rf "%s"' EXIT`, c.varEvaluationString(tempDirVar, true)))
+		c.addStartLine("trap 'exit 129' HUP")
+		c.addStartLine("trap 'exit 130' INT")
+		c.addStartLine("trap 'exit 143' TERM")
+	}
+
+	// The arguments are stored at the top level because $@ holds the function arguments inside functions.
+	if c.argsRequired {
+		args := c.varName(argsSlice, true)
+
+		c.addStartLine("# global arguments")
+		c.addStartLine(fmt.Sprintf("%s_len=0", args))
+		c.addStartLine(`for _tsh__a in "$@"; do`)
+		c.addStartLine(fmt.Sprintf(`eval "%[1]s_${%[1]s_len}=\${_tsh__a}"`, args))
+		c.addStartLine(fmt.Sprintf("%[1]s_len=$((%[1]s_len+1))", args))
+		c.addStartLine("done")
+	}
+	return nil
+}
+
+func (c *converter) VarDefinition(name string, value string, global bool) error {
+	return c.VarAssignment(name, value, global)
+}
+
+func (c *converter) VarAssignment(name string, value string, global bool) error {
+	c.addLine(c.varAssignmentString(name, value, global))
+	return nil
+}
+
+func (c *converter) SliceAssignment(name string, index string, value string, defaultValue string, global bool) error {
+	c.sliceAssignmentHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s" "%s"`, sliceAssignmentHelper, c.varEvaluationString(name, global), index, value, defaultValue))
+	return nil
+}
+
+func (c *converter) MapAssignment(name string, key string, value string, global bool) error {
+	c.mapAssignmentHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s"`, mapAssignmentHelper, c.varEvaluationString(name, global), key, value))
+	return nil
+}
+
+func (c *converter) MapDelete(name string, key string) error {
+	c.mapDeleteHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s"`, mapDeleteHelper, name, key))
+	return nil
+}
+
+func (c *converter) FuncStart(name string, params []string, returnTypes []parser.ValueType) error {
+	c.funcs = append(c.funcs, funcInfo{
+		name:       name,
+		startIndex: len(c.code),
+	})
+	c.funcCounter++
+	c.addLine(fmt.Sprintf("%s() {", funcName(name)))
+
+	for i, param := range params {
+		c.VarAssignment(param, fmt.Sprintf("${%d}", i+1), false)
+	}
+	return nil
+}
+
+func (c *converter) FuncEnd() error {
+	c.addRestoreLine()
+	c.addLine("}")
+
+	lastIndex := len(c.funcs) - 1
+	currFunc := c.funcs[lastIndex]
+
+	if len(currFunc.locals) > 0 {
+		reversedLocals := slices.Clone(currFunc.locals)
+		slices.Reverse(reversedLocals)
+
+		// Restore the variables before each return. Start from the back to keep the indices valid.
+		for i := len(currFunc.returnLines) - 1; i >= 0; i-- {
+			c.code = slices.Insert(c.code, currFunc.returnLines[i], fmt.Sprintf("%s %s", stackPopHelper, strings.Join(reversedLocals, " ")))
+		}
+		c.code = slices.Insert(c.code, currFunc.startIndex+1, fmt.Sprintf("%s %s", stackPushHelper, strings.Join(currFunc.locals, " ")))
+		c.stackHelperRequired = true
+	}
+	c.funcs = slices.Delete(c.funcs, lastIndex, lastIndex+1)
+	return nil
+}
+
+func (c *converter) Return(values []transpiler.ReturnValue) error {
+	for i, value := range values {
+		c.VarDefinition(fmt.Sprintf("_rv%d", i), value.Value(), true)
+	}
+	c.addRestoreLine()
+	c.addLine("return")
+	return nil
+}
+
+func (c *converter) IfStart(condition string) error {
+	c.addLine(fmt.Sprintf(`if [ "%s" -eq %s ]; then`, condition, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) IfEnd() error {
+	c.addLine("fi")
+	return nil
+}
+
+func (c *converter) ElseStart() error {
+	c.addLine("else")
+	return nil
+}
+
+func (c *converter) ElseEnd() error {
+	return nil
+}
+
+func (c *converter) ForStart() error {
+	c.addLine(c.varAssignmentString(c.mustCurrentForVar(), "", false))
+	c.addLine("while true; do")
+	return nil
+}
+
+func (c *converter) ForIncrementStart() error {
+	c.addLine(fmt.Sprintf(`if [ -n "%s" ]; then`, c.varEvaluationString(c.mustCurrentForVar(), false)))
+	return nil
+}
+
+func (c *converter) ForIncrementEnd() error {
+	c.addLine("fi")
+	c.addLine(c.varAssignmentString(c.mustCurrentForVar(), "1", false))
+	return nil
+}
+
+func (c *converter) ForCondition(condition string) error {
+	c.addLine(fmt.Sprintf(`if [ "%s" -ne %s ]; then break; fi`, condition, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) ForEnd() error {
+	c.addLine("done")
+	c.forCounter++
+
+	return nil
+}
+
+func (c *converter) Break() error {
+	c.addLine("break")
+	return nil
+}
+
+func (c *converter) Continue() error {
+	c.addLine("continue")
+	return nil
+}
+
+func (c *converter) Print(values []transpiler.PrintValue, stderr bool, newline bool) error {
+	format := "%s"
+	redirect := ""
+
+	if newline {
+		format += `\n`
+	}
+	if stderr {
+		redirect = " >&2"
+	}
+	c.addLine(fmt.Sprintf(`printf '%s' "%s"%s`, format, strings.Join(c.printStrings(values), " "), redirect))
+	return nil
+}
+
+func (c *converter) Panic(value string) error {
+	c.addLine(fmt.Sprintf(`printf '%%s\n' "%s" >&2`, value))
+	return c.Exit("1")
+}
+
+func (c *converter) Exit(code string) error {
+	c.addLine(fmt.Sprintf("exit $(((%s) & 255))", code)) // Some shells (e.g. dash) don't accept negative exit codes.
+	return nil
+}
+
+func (c *converter) WriteFile(path string, content string, append string) error {
+	c.addLine(fmt.Sprintf(`if [ "%s" -eq "%s" ]; then printf '%%s\n' "%s" >> "%s"; else printf '%%s\n' "%s" > "%s"; fi`,
+		append,
+		transpiler.BoolToString(true),
+		content,
+		path,
+		content,
+		path,
+	))
+	return nil
+}
+
+func (c *converter) EnvSet(name string, value string) error {
+	c.envSetHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s"`, envSetHelper, name, value))
+	return nil
+}
+
+func (c *converter) EnvUnset(name string) error {
+	c.envUnsetHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s"`, envUnsetHelper, name))
+	return nil
+}
+
+func (c *converter) Nop() error {
+	c.addLine(": # No operation")
+	return nil
+}
+
+func (c *converter) Marker(marker string) error {
+	c.addLine(marker)
+	return nil
+}
+
+func (c *converter) UnaryOperation(expr string, operator parser.UnaryOperator, valueType parser.ValueType, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	switch operator {
+	case parser.UNARY_OPERATOR_NEGATE:
+		c.addLine(c.ifAssignmentString(
+			helper,
+			fmt.Sprintf(`[ "%s" -eq "%s" ]`, expr, transpiler.BoolToString(true)),
+			transpiler.BoolToString(false),
+			transpiler.BoolToString(true),
+		))
+	default:
+		return "", fmt.Errorf(`unknown unary operator "%s"`, operator)
+	}
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) BinaryOperation(left string, operator parser.BinaryOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	notAllowedError := func() (string, error) {
+		return "", fmt.Errorf("binary operation %s is not allowed on type %s", operator, valueType.String())
+	}
+
+	if valueType.IsSlice() {
+		return notAllowedError()
+	}
+
+	switch valueType.DataType() {
+	case parser.DATA_TYPE_INTEGER:
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION,
+			parser.BINARY_OPERATOR_DIVISION,
+			parser.BINARY_OPERATOR_MODULO,
+			parser.BINARY_OPERATOR_ADDITION,
+			parser.BINARY_OPERATOR_SUBTRACTION:
+			// These operations are fine.
+		default:
+			return notAllowedError()
+		}
+		c.VarAssignment(helper, fmt.Sprintf("$((%s%s%s))", left, operator, right), false)
+	case parser.DATA_TYPE_FLOAT:
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION,
+			parser.BINARY_OPERATOR_DIVISION,
+			parser.BINARY_OPERATOR_ADDITION,
+			parser.BINARY_OPERATOR_SUBTRACTION:
+			// These operations are fine.
+		default:
+			return notAllowedError()
+		}
+		c.floatHelperRequired = true
+		c.VarAssignment(helper, fmt.Sprintf(`$(%s "%s" "%s" "%s")`, floatHelper, left, operator, right), false)
+	case parser.DATA_TYPE_STRING:
+		switch operator {
+		case parser.BINARY_OPERATOR_ADDITION:
+			c.VarAssignment(helper, fmt.Sprintf("%s%s", left, right), false)
+		default:
+			return notAllowedError()
+		}
+	default:
+		return notAllowedError()
+	}
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Comparison(left string, operator parser.CompareOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
+	var operatorString string
+
+	// Floats are compared by the float helper which returns the result as bool.
+	if valueType.IsFloat() {
+		helper := c.nextHelperVar()
+		c.floatHelperRequired = true
+
+		c.VarAssignment(helper, fmt.Sprintf(`$(%s "%s" "%s" "%s")`, floatHelper, left, operator, right), false)
+		return c.VarEvaluation(helper, valueUsed, false)
+	}
+
+	if !valueType.IsSlice() {
+		switch valueType.DataType() {
+		case parser.DATA_TYPE_BOOLEAN:
+			switch operator {
+			case parser.COMPARE_OPERATOR_EQUAL:
+				operatorString = "-eq"
+			case parser.COMPARE_OPERATOR_NOT_EQUAL:
+				operatorString = "-ne"
+			}
+		case parser.DATA_TYPE_INTEGER:
+			switch operator {
+			case parser.COMPARE_OPERATOR_EQUAL:
+				operatorString = "-eq"
+			case parser.COMPARE_OPERATOR_NOT_EQUAL:
+				operatorString = "-ne"
+			case parser.COMPARE_OPERATOR_GREATER:
+				operatorString = "-gt"
+			case parser.COMPARE_OPERATOR_GREATER_OR_EQUAL:
+				operatorString = "-ge"
+			case parser.COMPARE_OPERATOR_LESS:
+				operatorString = "-lt"
+			case parser.COMPARE_OPERATOR_LESS_OR_EQUAL:
+				operatorString = "-le"
+			}
+		case parser.DATA_TYPE_STRING:
+			switch operator {
+			case parser.COMPARE_OPERATOR_EQUAL:
+				operatorString = "=" // "==" is not supported by POSIX test.
+			case parser.COMPARE_OPERATOR_NOT_EQUAL:
+				operatorString = "!="
+			}
+		}
+	}
+
+	if len(operatorString) == 0 {
+		return "", fmt.Errorf("comparison %s is not allowed on type %s", operator, valueType.String())
+	}
+	helper := c.nextHelperVar()
+
+	c.addLine(c.ifAssignmentString(
+		helper,
+		fmt.Sprintf(`[ "%s" %s "%s" ]`, left, operatorString, right),
+		transpiler.BoolToString(true),
+		transpiler.BoolToString(false),
+	))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) LogicalOperationStart(left string, operator parser.LogicalOperator) error {
+	var operatorString string
+
+	// The right side only needs to be evaluated if the left side is true for "&&" or false for "||".
+	switch operator {
+	case parser.LOGICAL_OPERATOR_AND:
+		operatorString = "-eq"
+	case parser.LOGICAL_OPERATOR_OR:
+		operatorString = "-ne"
+	default:
+		return fmt.Errorf(`unknown logical operator "%s"`, operator)
+	}
+	helper := c.nextHelperVar()
+	c.logicalOperations = append(c.logicalOperations, helper)
+
+	c.VarAssignment(helper, left, false)
+	c.addLine(fmt.Sprintf(`if [ "%s" %s "%s" ]; then`, c.varEvaluationString(helper, false), operatorString, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) LogicalOperationEnd(right string, valueUsed bool) (string, error) {
+	lastIndex := len(c.logicalOperations) - 1
+	helper := c.logicalOperations[lastIndex]
+	c.logicalOperations = slices.Delete(c.logicalOperations, lastIndex, lastIndex+1)
+
+	c.VarAssignment(helper, right, false)
+	c.addLine("fi")
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) VarEvaluation(name string, valueUsed bool, global bool) (string, error) {
+	return c.varEvaluationString(name, global), nil
+}
+
+func (c *converter) SliceInstantiation(values []string, valueUsed bool) (string, error) {
+	helper := c.nextDynamicVar()
+	name := c.varEvaluationString(helper, false)
+
+	// Init slice values.
+	for i, value := range values {
+		c.sliceValueHelperRequired = true
+		c.addLine(fmt.Sprintf(`%s "%s" %d "%s"`, sliceValueHelper, name, i, value))
+	}
+	return name, nil
+}
+
+func (c *converter) SliceEvaluation(name string, index string, defaultValue string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.sliceEvaluationHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s"`, sliceEvaluationHelper, name, index, defaultValue))
+	c.VarAssignment(helper, c.varEvaluationString("_ret", true), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) SliceLen(name string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.addLine(c.sliceLenString(helper, name))
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) SliceRange(name string, startIndex string, endIndex string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s" "%s"`, sliceRangeHelper, slice, name, startIndex, endIndex))
+
+	return slice, nil
+}
+
+func (c *converter) Append(name string, values []string, inPlace bool, valueUsed bool) (string, error) {
+	length, err := c.SliceLen(name, true)
+
+	if err != nil {
+		return "", err
+	}
+	slice, err := c.appendDestination(name, length, inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+
+	for i, value := range values {
+		c.sliceValueHelperRequired = true
+		c.addLine(fmt.Sprintf(`%s "%s" "%s+%d" "%s"`, sliceValueHelper, slice, length, i, value))
+	}
+	return slice, nil
+}
+
+func (c *converter) AppendSlice(name string, source string, inPlace bool, valueUsed bool) (string, error) {
+	length, err := c.SliceLen(name, true)
+
+	if err != nil {
+		return "", err
+	}
+	slice, err := c.appendDestination(name, length, inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	sourceLength, err := c.SliceLen(source, true)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "0" "%s"`, sliceRangeHelper, slice, source, sourceLength))
+
+	return slice, nil
+}
+
+func (c *converter) MapInstantiation(keys []string, values []string, valueUsed bool) (string, error) {
+	helper := c.nextDynamicVar()
+	name := c.varEvaluationString(helper, false)
+
+	// Init map values.
+	for i, key := range keys {
+		c.mapAssignmentHelperRequired = true
+		c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s"`, mapAssignmentHelper, name, key, values[i]))
+	}
+	return name, nil
+}
+
+func (c *converter) MapEvaluation(name string, key string, defaultValue string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	okHelper := c.nextHelperVar()
+
+	c.mapEvaluationHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s"`, mapEvaluationHelper, name, key, defaultValue))
+	c.VarAssignment(valueHelper, c.varEvaluationString("_mv", true), false)
+	c.VarAssignment(okHelper, c.varEvaluationString("_mok", true), false)
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(okHelper, false), nil
+}
+
+func (c *converter) MapLen(name string, valueUsed bool) (string, error) {
+	return c.SliceLen(fmt.Sprintf("%s_k", name), valueUsed)
+}
+
+func (c *converter) MapKeys(name string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Copy the keys slice to make sure it's not modified if the map is modified.
+	c.sliceCopyHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s_k"`, sliceCopyHelper, slice, name))
+
+	return slice, nil
+}
+
+func (c *converter) StringSubscript(value string, startIndex string, endIndex string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.stringSubscriptHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s"`, stringSubscriptHelper, value, startIndex, endIndex))
+	c.VarAssignment(helper, c.varEvaluationString("_ret", true), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) StringLen(value string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.VarAssignment(helper, value, false)
+	c.VarAssignment(helper, fmt.Sprintf("${#%s}", c.varName(helper, false)), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) FloatToInt(value string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, value, false)
+
+	// Remove the decimal places to truncate the value like Go does (arithmetic expansion turns -0 into 0).
+	c.VarAssignment(helper, fmt.Sprintf("$((${%s%%.*}))", c.varName(helper, false)), false)
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Atoi(value string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	errHelper := c.nextHelperVar()
+
+	c.atoiHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s"`, atoiHelper, value))
+	c.VarAssignment(valueHelper, c.varEvaluationString("_atv", true), false)
+	c.VarAssignment(errHelper, c.varEvaluationString("_ate", true), false)
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(errHelper, false), nil
+}
+
+func (c *converter) Format(value string, valueType parser.ValueType, verb parser.FormatVerb, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	if valueType.IsBool() {
+		value = c.boolString(value)
+	}
+	if verb.Verb() == 'q' {
+		c.quoteHelperRequired = true
+		c.addLine(fmt.Sprintf(`%s "%s"`, quoteHelper, value))
+		value = c.varEvaluationString("_ret", true)
+	}
+	// Command substitution removes trailing newlines, therefore an underscore is appended and removed again.
+	c.VarAssignment(helper, fmt.Sprintf(`$(printf '%s_' "%s")`, transpiler.PrintfFormat(verb), value), false)
+	c.VarAssignment(helper, fmt.Sprintf("${%s%%_}", c.varName(helper, false)), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Group(value string, valueUsed bool) (string, error) {
+	return fmt.Sprintf("(%s)", value), nil
+}
+
+func (c *converter) FuncCall(name string, args []string, returnTypes []parser.ValueType, valueUsed bool) ([]string, error) {
+	argsCopy := []string{}
+
+	for _, arg := range args {
+		argsCopy = append(argsCopy, fmt.Sprintf(`"%s"`, arg))
+	}
+	returnValues := []string{}
+	c.addLine(strings.TrimSpace(fmt.Sprintf("%s %s", funcName(name), strings.Join(argsCopy, " "))))
+
+	if valueUsed {
+		for i := range returnTypes {
+			helper := c.nextHelperVar()
+
+			c.VarDefinition(helper, c.varEvaluationString(fmt.Sprintf("_rv%d", i), true), false)
+			eval, _ := c.VarEvaluation(helper, valueUsed, false)
+			returnValues = append(returnValues, eval)
+		}
+	}
+
+	// Make sure return values contain as many values as expected.
+	for len(returnValues) < len(returnTypes) {
+		returnValues = append(returnValues, "")
+	}
+	return returnValues, nil
+}
+
+func (c *converter) AppCall(calls []transpiler.AppCall, valueUsed bool) ([]string, error) {
+	callStrings := []string{}
+
+	for _, call := range calls {
+		argsCopy := []string{}
+
+		args := call.Args()
+		spreadSlice := ""
+
+		// The spread slice is added to the positional parameters below.
+		if call.Spread() {
+			lastArgsIndex := len(args) - 1
+			spreadSlice = args[lastArgsIndex]
+			args = args[:lastArgsIndex]
+		}
+
+		// Quote all arguments to make sure they are passed as they are.
+		for _, arg := range args {
+			argsCopy = append(argsCopy, fmt.Sprintf(`"%s"`, arg))
+		}
+		if call.Spread() {
+			argsCopy = append(argsCopy, `"$@"`)
+		}
+		space := ""
+
+		if len(argsCopy) > 0 {
+			space = " "
+		}
+		callString := fmt.Sprintf(`"%s"%s%s`, escapeString(call.Name()), space, strings.Join(argsCopy, " "))
+
+		// POSIX shells don't support arrays, therefore the slice values are collected as positional parameters
+		// in a subshell to pass them as separate arguments.
+		if call.Spread() {
+			callString = fmt.Sprintf(
+				`(set --; _tsh__i=0; eval "_tsh__l=\${%[1]s_len:-0}"; while [ "${_tsh__i}" -lt "${_tsh__l}" ]; do eval "set -- \"\$@\" \"\${%[1]s_${_tsh__i}}\""; _tsh__i=$((_tsh__i+1)); done; %[2]s)`,
+				spreadSlice,
+				callString,
+			)
+		}
+		callStrings = append(callStrings, callString)
+	}
+	callString := strings.Join(callStrings, " | ")
+
+	if valueUsed {
+		fileHelper := c.nextHelperVar()
+		stdoutHelper := c.nextHelperVar()
+		codeHelper := c.nextHelperVar()
+		stderrHelper := c.nextHelperVar()
+		file := c.varEvaluationString(fileHelper, false)
+
+		// Stderr is redirected to a temporary file to keep it separated from stdout. The file name is
+		// built from the helper name, the directory makes sure no other user can access it.
+		c.tempDirRequired = true
+		c.VarDefinition(fileHelper, fmt.Sprintf("%s/%s", c.varEvaluationString(tempDirVar, true), fileHelper), false)
+		c.VarDefinition(stdoutHelper, fmt.Sprintf(`$({ %s; } 2>"%s")`, callString, file), false)
+		c.VarDefinition(codeHelper, "$?", false)
+		c.VarDefinition(stderrHelper, fmt.Sprintf(`$(cat "%s")`, file), false)
+		c.addLine(fmt.Sprintf(`rm -f "%s"`, file))
+
+		return []string{
+			c.varEvaluationString(stdoutHelper, false),
+			c.varEvaluationString(stderrHelper, false),
+			c.varEvaluationString(codeHelper, false),
+		}, nil
+	}
+	c.addLine(callString)
+	return []string{"", "", "0"}, nil
+}
+
+func (c *converter) Input(prompt string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	// POSIX read doesn't support a prompt.
+	if len(prompt) > 0 {
+		c.addLine(fmt.Sprintf(`printf '%%s' "%s"`, prompt))
+	}
+	c.addLine(fmt.Sprintf("read -r %s", c.localVarName(helper)))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Args(valueUsed bool) (string, error) {
+	c.argsRequired = true
+	return c.varName(argsSlice, true), nil
+}
+
+func (c *converter) EnvGet(name string, valueUsed bool) (string, error) {
+	return c.envGet(name, "_ret", valueUsed)
+}
+
+func (c *converter) EnvExists(name string, valueUsed bool) (string, error) {
+	return c.envGet(name, "_ok", valueUsed)
+}
+
+func (c *converter) Environ(valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.environHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s"`, environHelper, slice))
+
+	return slice, nil
+}
+
+func (c *converter) Copy(destination string, source string, valueUsed bool, global bool) (string, error) {
+	destination = c.varEvaluationString(destination, global)
+
+	c.sliceCopyHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s"`, sliceCopyHelper, destination, source))
+
+	helper := c.nextHelperVar()
+	c.addLine(c.sliceLenString(helper, destination))
+
+	return c.varEvaluationString(helper, false), nil
+}
+
+func (c *converter) Exists(path string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.addLine(c.ifAssignmentString(
+		helper,
+		fmt.Sprintf(`[ -e "%s" ]`, path),
+		transpiler.BoolToString(true),
+		transpiler.BoolToString(false),
+	))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) ReadFile(path string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, fmt.Sprintf(`$(cat "%s")`, path), false)
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+// envGet calls the environment get helper and returns the provided result variable (_ret or _ok).
+func (c *converter) envGet(name string, resultVar string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.envGetHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s"`, envGetHelper, name))
+	c.VarAssignment(helper, c.varEvaluationString(resultVar, true), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+// printStrings converts the print values to strings. Bools are printed as true/false.
+func (c *converter) printStrings(values []transpiler.PrintValue) []string {
+	strs := []string{}
+
+	for _, value := range values {
+		s := value.Value()
+
+		if value.ValueType().IsBool() {
+			s = c.boolString(s)
+		}
+		strs = append(strs, s)
+	}
+	return strs
+}
+
+// boolString converts a bool value to true/false and returns the evaluation of the helper variable.
+func (c *converter) boolString(value string) string {
+	helper := c.nextHelperVar()
+
+	c.addLine(fmt.Sprintf(`if [ "%s" = "%s" ]; then %s; else %s; fi`,
+		value,
+		transpiler.BoolToString(true),
+		c.varAssignmentString(helper, "true", false),
+		c.varAssignmentString(helper, "false", false),
+	))
+	return c.varEvaluationString(helper, false)
+}
+
+func (c *converter) mustCurrentForVar() string {
+	return fmt.Sprintf("_fv%d", c.forCounter)
+}
+
+// varName returns the name of the shell variable. All variables are prefixed to keep them apart from
+// environment variables which share the same namespace in POSIX sh.
+func (c *converter) varName(name string, global bool) string {
+	if c.inFunction() && !global {
+		name = fmt.Sprintf("f%d_%s", c.funcCounter, name)
+	}
+	return fmt.Sprintf("%s%s", transpiler.VarPrefix, name)
+}
+
+func (c *converter) localVarName(name string) string {
+	name = c.varName(name, false)
+
+	// Keep track of function variables to restore them when the function returns.
+	if c.inFunction() {
+		currFunc := &c.funcs[len(c.funcs)-1]
+
+		if !slices.Contains(currFunc.locals, name) {
+			currFunc.locals = append(currFunc.locals, name)
+		}
+	}
+	return name
+}
+
+func (c *converter) varAssignmentString(name string, value string, global bool) string {
+	if global {
+		name = c.varName(name, global)
+	} else {
+		name = c.localVarName(name)
+	}
+	return fmt.Sprintf(`%s="%s"`, name, value)
+}
+
+func (c *converter) varEvaluationString(name string, global bool) string {
+	return fmt.Sprintf("${%s}", c.varName(name, global))
+}
+
+func (c *converter) ifAssignmentString(name string, condition string, trueValue string, falseValue string) string {
+	return fmt.Sprintf("if %s; then %s; else %s; fi",
+		condition,
+		c.varAssignmentString(name, trueValue, false),
+		c.varAssignmentString(name, falseValue, false),
+	)
+}
+
+func (c *converter) sliceLenString(helper string, name string) string {
+	return fmt.Sprintf(`eval "%s=\${%s_len:-0}"`, c.localVarName(helper), name)
+}
+
+func (c *converter) nextDynamicVar() string {
+	c.addLine(fmt.Sprintf("%s=$((%s+1))", c.varName("_dvc", true), c.varEvaluationString("_dvc:-0", true))) // Dynamic variable counter.
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, fmt.Sprintf("%s%s", c.varName("_dv", true), c.varEvaluationString("_dvc", true)), false)
+
+	return helper
+}
+
+func (c *converter) addRestoreLine() {
+	currFunc := &c.funcs[len(c.funcs)-1]
+	currFunc.returnLines = append(currFunc.returnLines, len(c.code))
+}
+
+// appendDestination returns the slice to which values are appended. Unless the values are appended in
+// place, it's a copy of the passed slice.
+func (c *converter) appendDestination(name string, length string, inPlace bool, valueUsed bool) (string, error) {
+	if inPlace {
+		return name, nil
+	}
+	return c.SliceRange(name, "0", length, valueUsed)
+}
+
+func (c *converter) inFunction() bool {
+	return len(c.funcs) > 0
+}
+
+func (c *converter) addHelper(helperType string, functionName string, code ...string) {
+	c.addStartLine(fmt.Sprintf("# global %s helper", helperType))
+	c.addStartLine(fmt.Sprintf("%s() {", functionName))
+
+	for _, line := range code {
+		c.addStartLine(line)
+	}
+	c.addStartLine("}")
+}
+
+func (c *converter) addStartLine(line string) {
+	c.startCode = append(c.startCode, line)
+}
+
+func (c *converter) addLine(line string) {
+	c.code = append(c.code, line)
+}
+
+// floatHelperCommand returns an awk command which applies the operator ($2) to the left ($1) and the
+// right ($3) value. Arithmetic results are rounded to transpiler.FloatPrecision decimal places.
+func floatHelperCommand() string {
+	return fmt.Sprintf(`awk -v l="${1}" -v o="${2}" -v r="${3}" 'BEGIN { l += 0; r += 0; if (o == "+") v = l + r; else if (o == "-") v = l - r; else if (o == "*") v = l * r; else if (o == "/") v = l / r; else { c = (o == "==" && l == r) || (o == "!=" && l != r) || (o == "<" && l < r) || (o == "<=" && l <= r) || (o == ">" && l > r) || (o == ">=" && l >= r); print (c ? %s : %s); exit } s = sprintf("%%.%df", v); sub(/0+$/, "", s); sub(/\.$/, "", s); if (s == "-0") s = "0"; print s }'`,
+		transpiler.BoolToString(true),
+		transpiler.BoolToString(false),
+		transpiler.FloatPrecision,
+	)
+}
+
+// envNameCheckString returns a statement which leaves the helper if the first argument is not a valid variable name
+// or belongs to the script's variables.
+func envNameCheckString() string {
+	return fmt.Sprintf(`case "${1}" in ""|[0-9]*|*[!a-zA-Z0-9_]*|%s*) return ;; esac`, transpiler.VarPrefix)
+}
+
+func funcName(name string) string {
+	return fmt.Sprintf("f_%s", name) // Prefix functions because POSIX names must not start with a digit (e.g. import hashes).
+}
+
+func escapeString(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"$", `\$`,
+		"`", "\\`",
+	).Replace(value)
+}
+
+func (c *converter) nextHelperVar() string {
+	helperVar := fmt.Sprintf("_h%d", c.varCounter)
+	c.varCounter++
+
+	return helperVar
+}
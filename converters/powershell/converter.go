@@ -0,0 +1,1015 @@
+package powershell
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/monstermichl/typeshell/parser"
+	"github.com/monstermichl/typeshell/transpiler"
+)
+
+type helperName = string
+
+const (
+	appCallHelper         helperName = "_ach" // App call
+	fileReadHelper        helperName = "_frh" // File read
+	fileWriteHelper       helperName = "_fwh" // File write
+	sliceAssignmentHelper helperName = "_sah" // Slice assignment
+	sliceEvaluationHelper helperName = "_sgh" // Slice evaluation
+	sliceCopyHelper       helperName = "_sch" // Slice copy
+	sliceRangeHelper      helperName = "_srh" // Slice range
+	stringSubscriptHelper helperName = "_ssh" // String subscript
+	formatPadHelper       helperName = "_fmp" // Format padding
+	quoteHelper           helperName = "_qh"  // Quote
+	atoiHelper            helperName = "_aih" // String to integer
+)
+
+// Slices and maps are stored in a global hashtable. Variables only hold the key of the slice or map
+// within the hashtable. This way they can be embedded into strings like any other value.
+const dynamicVars = "$script:_dv"
+const dynamicVarsCounter = "$script:_dvc"
+const argsSlice = "_args" // Key of the slice which holds the script arguments.
+
+type forInfo struct {
+	variable string
+}
+
+type converter struct {
+	interpreter                   string
+	logicalOperations             []string // Stores the result variables of the currently evaluated logical operations.
+	startCode                     []string
+	code                          []string
+	varCounter                    int
+	forCounter                    int
+	fors                          []forInfo
+	appCallHelperRequired         bool
+	fileReadHelperRequired        bool
+	fileWriteHelperRequired       bool
+	sliceAssignmentHelperRequired bool
+	sliceEvaluationHelperRequired bool
+	sliceCopyHelperRequired       bool
+	sliceRangeHelperRequired      bool
+	stringSubscriptHelperRequired bool
+	formatPadHelperRequired       bool
+	quoteHelperRequired           bool
+	atoiHelperRequired            bool
+	argsRequired                  bool
+}
+
+func New() *converter {
+	return &converter{
+		interpreter: "/usr/bin/env pwsh",
+		code:        []string{},
+	}
+}
+
+// StringToString escapes all characters which have a special meaning within double quotes. Therefore,
+// all values must be embedded in double quotes.
+func (c *converter) StringToString(value string) string {
+	return escapeString(value)
+}
+
+func (c *converter) Dump() (string, error) {
+	allCode := []string{}
+
+	allCode = append(allCode, c.startCode...)
+	allCode = append(allCode, c.code...)
+	allCode = append(allCode, "") // Add a terminating newline.
+
+	return strings.Join(allCode, "\n"), nil
+}
+
+func (c *converter) Extension() string {
+	return "ps1"
+}
+
+func (c *converter) ProgramStart() error {
+	c.addStartLine(fmt.Sprintf("#!%s", c.interpreter))
+	c.addStartLine("[Console]::OutputEncoding = New-Object System.Text.UTF8Encoding $false") // Write (and read program output) as UTF-8 without BOM.
+	c.addStartLine(fmt.Sprintf("%s = @{}", dynamicVars))
+	c.addStartLine(fmt.Sprintf("%s = 0", dynamicVarsCounter))
+	return nil
+}
+
+func (c *converter) ProgramEnd() error {
+	if c.sliceAssignmentHelperRequired {
+		// $n: Slice name
+		// $i: Assignment index
+		// $v: Assignment value
+		// $d: Default value
+		c.addHelper("slice assignment", sliceAssignmentHelper,
+			"param($n, $i, $v, $d)",
+			fmt.Sprintf("$l = %s[$n]", dynamicVars),
+			"$i = [int]$i",
+			"while ($l.Count -le $i) { $l.Add($d) }",
+			"$l[$i] = $v",
+		)
+	}
+
+	if c.sliceEvaluationHelperRequired {
+		// $n: Slice name
+		// $i: Index
+		// $d: Default value
+		c.addHelper("slice evaluation", sliceEvaluationHelper,
+			"param($n, $i, $d)",
+			fmt.Sprintf("$l = %s[$n]", dynamicVars),
+			"$i = [int]$i",
+			"if ($i -ge 0 -and $i -lt $l.Count) { return $l[$i] }",
+			"return $d",
+		)
+	}
+
+	if c.sliceCopyHelperRequired {
+		// $d: Destination slice name
+		// $s: Source slice name
+		c.addHelper("slice copy", sliceCopyHelper,
+			"param($d, $s)",
+			fmt.Sprintf("$dl = %s[$d]", dynamicVars),
+			fmt.Sprintf("$sl = %s[$s]", dynamicVars),
+			"for ($i = 0; $i -lt $sl.Count; $i++) {",
+			"if ($i -lt $dl.Count) { $dl[$i] = $sl[$i] } else { $dl.Add($sl[$i]) }",
+			"}",
+		)
+	}
+
+	if c.sliceRangeHelperRequired {
+		// Indices are clamped to the slice bounds. The values are appended to the destination slice.
+		//
+		// $d: Destination slice name
+		// $s: Source slice name
+		// $b: Start index
+		// $e: End index (excluded)
+		c.addHelper("slice range", sliceRangeHelper,
+			"param($d, $s, $b, $e)",
+			fmt.Sprintf("$sl = %s[$s]", dynamicVars),
+			"$b = [Math]::Max([int]$b, 0)",
+			"$e = [Math]::Min([int]$e, $sl.Count)",
+			fmt.Sprintf("if ($b -lt $e) { %s[$d].AddRange($sl.GetRange($b, $e - $b)) }", dynamicVars),
+		)
+	}
+
+	if c.stringSubscriptHelperRequired {
+		// $s: String
+		// $b: Start index
+		// $e: End index
+		c.addHelper("substring", stringSubscriptHelper,
+			"param($s, $b, $e)",
+			"$b = [int]$b",
+			"$l = [int]$e - $b + 1",
+			`if ($b -lt 0 -or $b -ge $s.Length -or $l -le 0) { return "" }`,
+			"if ($b + $l -gt $s.Length) { $l = $s.Length - $b }",
+			"return $s.Substring($b, $l)",
+		)
+	}
+
+	if c.formatPadHelperRequired {
+		// $s: String
+		// $w: Width
+		// $a: Alignment (l = left, r = right, z = right with leading zeros)
+		c.addHelper("format padding", formatPadHelper,
+			"param($s, $w, $a)",
+			"$w = [int]$w",
+			`if ($a -eq "l") { return $s.PadRight($w) }`,
+			`if ($a -eq "z" -and $s.StartsWith("-")) { return "-" + $s.Substring(1).PadLeft($w - 1, '0') }`, // Like in Go, the sign stays in front of the zeros.
+			`if ($a -eq "z") { return $s.PadLeft($w, '0') }`,
+			"return $s.PadLeft($w)",
+		)
+	}
+
+	if c.quoteHelperRequired {
+		// $s: String
+		c.addHelper("quote", quoteHelper,
+			"param($s)",
+			"return '\"' + ($s -replace '\\\\', '\\\\' -replace '\"', '\\\"' -replace \"`n\", '\\n' -replace \"`r\", '\\r' -replace \"`t\", '\\t') + '\"'",
+		)
+	}
+
+	if c.atoiHelperRequired {
+		// \z is used instead of $ because $ also matches before a trailing newline.
+		//
+		// $s: String
+		c.addHelper("atoi", atoiHelper,
+			"param($s)",
+			`$script:_atv = "0"`,
+			`$script:_ate = ""`,
+			"$n = [long]0",
+			"if ($s -notmatch '^[+-]?[0-9]+\\z') { $script:_ate = \"strconv.Atoi: parsing `\"$s`\": invalid syntax\" }",
+			"elseif (![long]::TryParse($s, [ref]$n)) { $script:_ate = \"strconv.Atoi: parsing `\"$s`\": value out of range\" }",
+			`else { $script:_atv = "$n" }`,
+		)
+	}
+
+	if c.appCallHelperRequired {
+		// Stdout and stderr are separated by their type. Native stderr output is converted to error records.
+		//
+		// $c: Script block which contains the call chain
+		c.addHelper("app call", appCallHelper,
+			"param($c)",
+			"$global:LASTEXITCODE = 0",
+			"$r = & $c 2>&1",
+			"$s = $?",
+			"$script:_ao = ($r | Where-Object { $_ -isnot [System.Management.Automation.ErrorRecord] } | ForEach-Object { \"$_\" }) -join \"`n\"",
+			"$script:_ae = ($r | Where-Object { $_ -is [System.Management.Automation.ErrorRecord] } | ForEach-Object { \"$_\" }) -join \"`n\"",
+			"$script:_ac = $global:LASTEXITCODE",
+			"if (!$s -and $script:_ac -eq 0) { $script:_ac = 1 }", // Calls which are not native programs (e.g. not existing ones) don't set LASTEXITCODE.
+		)
+	}
+
+	if c.fileReadHelperRequired {
+		// $p: Path
+		c.addHelper("file read", fileReadHelper,
+			"param($p)",
+			"$p = $ExecutionContext.SessionState.Path.GetUnresolvedProviderPathFromPSPath($p)", // .NET methods don't know about the current PowerShell location.
+			"return ([System.IO.File]::ReadAllText($p) -replace \"`r`n\", \"`n\").TrimEnd(\"`n\")",
+		)
+	}
+
+	if c.fileWriteHelperRequired {
+		// $p: Path
+		// $c: Content
+		// $a: Append
+		c.addHelper("file write", fileWriteHelper,
+			"param($p, $c, $a)",
+			"$p = $ExecutionContext.SessionState.Path.GetUnresolvedProviderPathFromPSPath($p)",
+			fmt.Sprintf("if ($a -eq \"%s\") { [System.IO.File]::AppendAllText($p, \"$c`n\") } else { [System.IO.File]::WriteAllText($p, \"$c`n\") }", transpiler.BoolToString(true)),
+		)
+	}
+
+	// The arguments are stored at the top level because $args holds the function arguments inside functions.
+	if c.argsRequired {
+		c.addStartLine("# global arguments")
+		c.addStartLine(fmt.Sprintf(`%s["%s"] = [System.Collections.Generic.List[string]]@($args)`, dynamicVars, argsSlice))
+	}
+	return nil
+}
+
+func (c *converter) VarDefinition(name string, value string, global bool) error {
+	return c.VarAssignment(name, value, global)
+}
+
+func (c *converter) VarAssignment(name string, value string, global bool) error {
+	c.addLine(c.varAssignmentString(name, value, global))
+	return nil
+}
+
+func (c *converter) SliceAssignment(name string, index string, value string, defaultValue string, global bool) error {
+	c.sliceAssignmentHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s" "%s"`, sliceAssignmentHelper, c.varEvaluationString(name, global), index, value, defaultValue))
+	return nil
+}
+
+func (c *converter) MapAssignment(name string, key string, value string, global bool) error {
+	c.addLine(fmt.Sprintf(`%s["%s"]["%s"] = "%s"`, dynamicVars, c.varEvaluationString(name, global), key, value))
+	return nil
+}
+
+func (c *converter) MapDelete(name string, key string) error {
+	c.addLine(fmt.Sprintf(`[void]%s["%s"].Remove("%s")`, dynamicVars, name, key))
+	return nil
+}
+
+func (c *converter) FuncStart(name string, params []string, returnTypes []parser.ValueType) error {
+	c.addLine(fmt.Sprintf("function %s {", funcName(name)))
+
+	// Functions have their own scope, therefore all variables are local by default (required for recursion).
+	for i, param := range params {
+		c.VarAssignment(param, fmt.Sprintf("$($args[%d])", i), false)
+	}
+	return nil
+}
+
+func (c *converter) FuncEnd() error {
+	c.addLine("}")
+	return nil
+}
+
+func (c *converter) Return(values []transpiler.ReturnValue) error {
+	for i, value := range values {
+		c.VarDefinition(fmt.Sprintf("_rv%d", i), value.Value(), true)
+	}
+	c.addLine("return")
+	return nil
+}
+
+func (c *converter) IfStart(condition string) error {
+	c.addLine(fmt.Sprintf(`if ("%s" -eq "%s") {`, condition, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) IfEnd() error {
+	c.addLine("}")
+	return nil
+}
+
+func (c *converter) ElseStart() error {
+	c.addLine("} else {")
+	return nil
+}
+
+func (c *converter) ElseEnd() error {
+	return nil
+}
+
+func (c *converter) ForStart() error {
+	c.fors = append(c.fors, forInfo{
+		variable: fmt.Sprintf("_fv%d", c.forCounter),
+	})
+	c.forCounter++
+
+	c.VarAssignment(c.mustCurrentForVar(), "", false)
+	c.addLine("while ($true) {")
+	return nil
+}
+
+func (c *converter) ForIncrementStart() error {
+	c.addLine(fmt.Sprintf(`if ("%s" -ne "") {`, c.varEvaluationString(c.mustCurrentForVar(), false)))
+	return nil
+}
+
+func (c *converter) ForIncrementEnd() error {
+	c.addLine("}")
+	c.VarAssignment(c.mustCurrentForVar(), "1", false)
+	return nil
+}
+
+func (c *converter) ForCondition(condition string) error {
+	c.addLine(fmt.Sprintf(`if ("%s" -ne "%s") { break }`, condition, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) ForEnd() error {
+	c.addLine("}")
+	c.fors = slices.Delete(c.fors, len(c.fors)-1, len(c.fors))
+	return nil
+}
+
+func (c *converter) Break() error {
+	c.addLine("break")
+	return nil
+}
+
+func (c *converter) Continue() error {
+	c.addLine("continue")
+	return nil
+}
+
+func (c *converter) Print(values []transpiler.PrintValue, stderr bool, newline bool) error {
+	stream := "Out"
+	method := "Write"
+
+	if stderr {
+		stream = "Error"
+	}
+	if newline {
+		method = "WriteLine"
+	}
+	strs := []string{}
+
+	// Bools are printed as true/false.
+	for _, value := range values {
+		s := value.Value()
+
+		if value.ValueType().IsBool() {
+			s = c.boolString(s)
+		}
+		strs = append(strs, s)
+	}
+
+	// The console is used directly instead of Write-Output to make sure the output isn't captured if
+	// it's written within a function.
+	c.addLine(fmt.Sprintf(`[Console]::%s.%s("%s")`, stream, method, strings.Join(strs, " ")))
+	return nil
+}
+
+func (c *converter) Panic(value string) error {
+	c.addLine(fmt.Sprintf(`[Console]::Error.WriteLine("%s")`, value))
+	return c.Exit("1")
+}
+
+func (c *converter) Exit(code string) error {
+	c.addLine(fmt.Sprintf(`exit [int]"%s"`, code))
+	return nil
+}
+
+func (c *converter) WriteFile(path string, content string, append string) error {
+	c.fileWriteHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s"`, fileWriteHelper, path, content, append))
+	return nil
+}
+
+func (c *converter) EnvSet(name string, value string) error {
+	c.addLine(fmt.Sprintf(`[Environment]::SetEnvironmentVariable("%s", "%s")`, name, value))
+	return nil
+}
+
+func (c *converter) EnvUnset(name string) error {
+	c.addLine(fmt.Sprintf(`[Environment]::SetEnvironmentVariable("%s", $null)`, name))
+	return nil
+}
+
+func (c *converter) Nop() error {
+	c.addLine("# No operation")
+	return nil
+}
+
+func (c *converter) Marker(marker string) error {
+	c.addLine(marker)
+	return nil
+}
+
+func (c *converter) UnaryOperation(expr string, operator parser.UnaryOperator, valueType parser.ValueType, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	switch operator {
+	case parser.UNARY_OPERATOR_NEGATE:
+		c.addLine(c.ifAssignmentString(
+			helper,
+			fmt.Sprintf(`"%s" -eq "%s"`, expr, transpiler.BoolToString(true)),
+			transpiler.BoolToString(false),
+			transpiler.BoolToString(true),
+		))
+	default:
+		return "", fmt.Errorf(`unknown unary operator "%s"`, operator)
+	}
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) BinaryOperation(left string, operator parser.BinaryOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	notAllowedError := func() (string, error) {
+		return "", fmt.Errorf("binary operation %s is not allowed on type %s", operator, valueType.String())
+	}
+
+	if valueType.IsSlice() {
+		return notAllowedError()
+	}
+
+	switch valueType.DataType() {
+	case parser.DATA_TYPE_INTEGER:
+		operation := fmt.Sprintf(`[long]"%s" %s [long]"%s"`, left, operator, right)
+
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION,
+			parser.BINARY_OPERATOR_MODULO,
+			parser.BINARY_OPERATOR_ADDITION,
+			parser.BINARY_OPERATOR_SUBTRACTION:
+			// These operations are fine.
+		case parser.BINARY_OPERATOR_DIVISION:
+			operation = fmt.Sprintf(`([long]"%s" - [long]"%s" %% [long]"%s") / [long]"%s"`, left, left, right, right) // Remove the remainder first because PowerShell returns a double if the division has a remainder.
+		default:
+			return notAllowedError()
+		}
+		c.VarAssignment(helper, fmt.Sprintf("$(%s)", operation), false)
+	case parser.DATA_TYPE_FLOAT:
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION,
+			parser.BINARY_OPERATOR_DIVISION,
+			parser.BINARY_OPERATOR_ADDITION,
+			parser.BINARY_OPERATOR_SUBTRACTION:
+			// These operations are fine.
+		default:
+			return notAllowedError()
+		}
+
+		// Round the result like the other converters do and format it independent of the current culture. Zero
+		// is added to make sure negative zero is not formatted as "-0".
+		c.VarAssignment(helper, fmt.Sprintf(`$(([math]::Round([double]"%s" %s [double]"%s", %d, [MidpointRounding]::AwayFromZero) + 0).ToString("0.%s", [cultureinfo]::InvariantCulture))`,
+			left,
+			operator,
+			right,
+			transpiler.FloatPrecision,
+			strings.Repeat("#", transpiler.FloatPrecision),
+		), false)
+	case parser.DATA_TYPE_STRING:
+		switch operator {
+		case parser.BINARY_OPERATOR_ADDITION:
+			c.VarAssignment(helper, fmt.Sprintf("%s%s", left, right), false)
+		default:
+			return notAllowedError()
+		}
+	default:
+		return notAllowedError()
+	}
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Comparison(left string, operator parser.CompareOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
+	var operatorString string
+	cast := ""
+
+	if !valueType.IsSlice() {
+		switch valueType.DataType() {
+		case parser.DATA_TYPE_BOOLEAN:
+			switch operator {
+			case parser.COMPARE_OPERATOR_EQUAL:
+				operatorString = "-eq"
+			case parser.COMPARE_OPERATOR_NOT_EQUAL:
+				operatorString = "-ne"
+			}
+		case parser.DATA_TYPE_INTEGER:
+			switch operator {
+			case parser.COMPARE_OPERATOR_EQUAL:
+				operatorString = "-eq"
+			case parser.COMPARE_OPERATOR_NOT_EQUAL:
+				operatorString = "-ne"
+			case parser.COMPARE_OPERATOR_GREATER:
+				operatorString = "-gt"
+			case parser.COMPARE_OPERATOR_GREATER_OR_EQUAL:
+				operatorString = "-ge"
+			case parser.COMPARE_OPERATOR_LESS:
+				operatorString = "-lt"
+			case parser.COMPARE_OPERATOR_LESS_OR_EQUAL:
+				operatorString = "-le"
+			}
+			cast = "[long]" // Integers must be casted to not be compared as strings.
+		case parser.DATA_TYPE_FLOAT:
+			switch operator {
+			case parser.COMPARE_OPERATOR_EQUAL:
+				operatorString = "-eq"
+			case parser.COMPARE_OPERATOR_NOT_EQUAL:
+				operatorString = "-ne"
+			case parser.COMPARE_OPERATOR_GREATER:
+				operatorString = "-gt"
+			case parser.COMPARE_OPERATOR_GREATER_OR_EQUAL:
+				operatorString = "-ge"
+			case parser.COMPARE_OPERATOR_LESS:
+				operatorString = "-lt"
+			case parser.COMPARE_OPERATOR_LESS_OR_EQUAL:
+				operatorString = "-le"
+			}
+			cast = "[double]"
+		case parser.DATA_TYPE_STRING:
+			switch operator {
+			case parser.COMPARE_OPERATOR_EQUAL:
+				operatorString = "-ceq" // PowerShell compares case-insensitive by default.
+			case parser.COMPARE_OPERATOR_NOT_EQUAL:
+				operatorString = "-cne"
+			}
+		}
+	}
+
+	if len(operatorString) == 0 {
+		return "", fmt.Errorf("comparison %s is not allowed on type %s", operator, valueType.String())
+	}
+	helper := c.nextHelperVar()
+
+	c.addLine(c.ifAssignmentString(
+		helper,
+		fmt.Sprintf(`%s"%s" %s %s"%s"`, cast, left, operatorString, cast, right),
+		transpiler.BoolToString(true),
+		transpiler.BoolToString(false),
+	))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) LogicalOperationStart(left string, operator parser.LogicalOperator) error {
+	var operatorString string
+
+	// The right side only needs to be evaluated if the left side is true for "&&" or false for "||".
+	switch operator {
+	case parser.LOGICAL_OPERATOR_AND:
+		operatorString = "-eq"
+	case parser.LOGICAL_OPERATOR_OR:
+		operatorString = "-ne"
+	default:
+		return fmt.Errorf(`unknown logical operator "%s"`, operator)
+	}
+	helper := c.nextHelperVar()
+	c.logicalOperations = append(c.logicalOperations, helper)
+
+	c.VarAssignment(helper, left, false)
+	c.addLine(fmt.Sprintf(`if ("%s" %s "%s") {`, c.varEvaluationString(helper, false), operatorString, transpiler.BoolToString(true)))
+	return nil
+}
+
+func (c *converter) LogicalOperationEnd(right string, valueUsed bool) (string, error) {
+	lastIndex := len(c.logicalOperations) - 1
+	helper := c.logicalOperations[lastIndex]
+	c.logicalOperations = slices.Delete(c.logicalOperations, lastIndex, lastIndex+1)
+
+	c.VarAssignment(helper, right, false)
+	c.addLine("}")
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) VarEvaluation(name string, valueUsed bool, global bool) (string, error) {
+	return c.varEvaluationString(name, global), nil
+}
+
+func (c *converter) SliceInstantiation(values []string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	elements := []string{}
+
+	for _, value := range values {
+		elements = append(elements, fmt.Sprintf(`"%s"`, value))
+	}
+	c.addDynamicVar(helper, fmt.Sprintf("[System.Collections.Generic.List[string]]@(%s)", strings.Join(elements, ", ")))
+
+	return c.varEvaluationString(helper, false), nil
+}
+
+func (c *converter) SliceEvaluation(name string, index string, defaultValue string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.sliceEvaluationHelperRequired = true
+	c.VarAssignment(helper, fmt.Sprintf(`$(%s "%s" "%s" "%s")`, sliceEvaluationHelper, name, index, defaultValue), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) SliceLen(name string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, c.countString(name), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) SliceRange(name string, startIndex string, endIndex string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "%s" "%s"`, sliceRangeHelper, slice, name, startIndex, endIndex))
+
+	return slice, nil
+}
+
+func (c *converter) Append(name string, values []string, inPlace bool, valueUsed bool) (string, error) {
+	slice, err := c.appendDestination(name, inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, value := range values {
+		c.addLine(fmt.Sprintf(`%s["%s"].Add("%s")`, dynamicVars, slice, value))
+	}
+	return slice, nil
+}
+
+func (c *converter) AppendSlice(name string, source string, inPlace bool, valueUsed bool) (string, error) {
+	slice, err := c.appendDestination(name, inPlace, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.sliceRangeHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s" "0" "%s"`, sliceRangeHelper, slice, source, c.countString(source)))
+
+	return slice, nil
+}
+
+func (c *converter) MapInstantiation(keys []string, values []string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.addDynamicVar(helper, "[System.Collections.Generic.Dictionary[string,string]]::new()") // Unlike hashtables, dictionaries are case-sensitive.
+
+	// Init map values.
+	for i, key := range keys {
+		c.MapAssignment(helper, key, values[i], false)
+	}
+	return c.varEvaluationString(helper, false), nil
+}
+
+func (c *converter) MapEvaluation(name string, key string, defaultValue string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	okHelper := c.nextHelperVar()
+
+	c.addLine(fmt.Sprintf(`if (%s["%s"].ContainsKey("%s")) {`, dynamicVars, name, key))
+	c.VarAssignment(valueHelper, fmt.Sprintf(`$(%s["%s"]["%s"])`, dynamicVars, name, key), false)
+	c.VarAssignment(okHelper, transpiler.BoolToString(true), false)
+	c.addLine("} else {")
+	c.VarAssignment(valueHelper, defaultValue, false)
+	c.VarAssignment(okHelper, transpiler.BoolToString(false), false)
+	c.addLine("}")
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(okHelper, false), nil
+}
+
+func (c *converter) MapLen(name string, valueUsed bool) (string, error) {
+	return c.SliceLen(name, valueUsed) // Lists and dictionaries both provide the Count property.
+}
+
+func (c *converter) MapKeys(name string, valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.addLine(fmt.Sprintf(`%s["%s"].AddRange(%s["%s"].Keys)`, dynamicVars, slice, dynamicVars, name))
+	return slice, nil
+}
+
+func (c *converter) StringSubscript(value string, startIndex string, endIndex string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.stringSubscriptHelperRequired = true
+	c.VarAssignment(helper, fmt.Sprintf(`$(%s "%s" "%s" "%s")`, stringSubscriptHelper, value, startIndex, endIndex), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) StringLen(value string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, fmt.Sprintf(`$("%s".Length)`, value), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) FloatToInt(value string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, fmt.Sprintf(`$([long][math]::Truncate([double]"%s"))`, value), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Atoi(value string, valueUsed bool) (string, string, error) {
+	valueHelper := c.nextHelperVar()
+	errHelper := c.nextHelperVar()
+
+	c.atoiHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s"`, atoiHelper, value))
+	c.VarAssignment(valueHelper, "${script:_atv}", false)
+	c.VarAssignment(errHelper, "${script:_ate}", false)
+
+	return c.varEvaluationString(valueHelper, false), c.varEvaluationString(errHelper, false), nil
+}
+
+func (c *converter) Format(value string, valueType parser.ValueType, verb parser.FormatVerb, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	if valueType.IsBool() {
+		value = c.boolString(value)
+	}
+	if verb.Verb() == 'q' {
+		c.quoteHelperRequired = true
+		value = fmt.Sprintf(`$(%s "%s")`, quoteHelper, value)
+	}
+	if verb.Width() > 0 {
+		alignment := "r"
+
+		if verb.LeftAlign() {
+			alignment = "l"
+		} else if verb.ZeroPad() {
+			alignment = "z"
+		}
+		c.formatPadHelperRequired = true
+		value = fmt.Sprintf(`$(%s "%s" "%d" "%s")`, formatPadHelper, value, verb.Width(), alignment)
+	}
+	c.VarAssignment(helper, value, false)
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Group(value string, valueUsed bool) (string, error) {
+	return value, nil // Values are always embedded into strings, therefore grouping is not required.
+}
+
+func (c *converter) FuncCall(name string, args []string, returnTypes []parser.ValueType, valueUsed bool) ([]string, error) {
+	argsCopy := []string{}
+
+	for _, arg := range args {
+		argsCopy = append(argsCopy, fmt.Sprintf(`"%s"`, arg))
+	}
+	returnValues := []string{}
+	c.addLine(strings.TrimSpace(fmt.Sprintf("%s %s", funcName(name), strings.Join(argsCopy, " "))))
+
+	if valueUsed {
+		for i := range returnTypes {
+			helper := c.nextHelperVar()
+
+			c.VarDefinition(helper, c.varEvaluationString(fmt.Sprintf("_rv%d", i), true), false)
+			eval, _ := c.VarEvaluation(helper, valueUsed, false)
+			returnValues = append(returnValues, eval)
+		}
+	}
+
+	// Make sure return values contain as many values as expected.
+	for len(returnValues) < len(returnTypes) {
+		returnValues = append(returnValues, "")
+	}
+	return returnValues, nil
+}
+
+func (c *converter) AppCall(calls []transpiler.AppCall, valueUsed bool) ([]string, error) {
+	callStrings := []string{}
+
+	for _, call := range calls {
+		argsCopy := []string{}
+
+		args := call.Args()
+
+		// Quote all arguments to make sure they are passed as they are.
+		for j, arg := range args {
+			if call.Spread() && j == len(args)-1 {
+				// PowerShell passes array elements to native programs as separate arguments.
+				argsCopy = append(argsCopy, fmt.Sprintf(`@(%s["%s"])`, dynamicVars, arg))
+			} else {
+				argsCopy = append(argsCopy, fmt.Sprintf(`"%s"`, arg))
+			}
+		}
+		space := ""
+
+		if len(argsCopy) > 0 {
+			space = " "
+		}
+		callStrings = append(callStrings, fmt.Sprintf(`& "%s"%s%s`, escapeString(call.Name()), space, strings.Join(argsCopy, " ")))
+	}
+	callString := strings.Join(callStrings, " | ")
+
+	if valueUsed {
+		stdoutHelper := c.nextHelperVar()
+		stderrHelper := c.nextHelperVar()
+		codeHelper := c.nextHelperVar()
+
+		c.appCallHelperRequired = true
+		c.addLine(fmt.Sprintf("%s { %s }", appCallHelper, callString))
+		c.VarAssignment(stdoutHelper, "${script:_ao}", false)
+		c.VarAssignment(stderrHelper, "${script:_ae}", false)
+		c.VarAssignment(codeHelper, "${script:_ac}", false)
+
+		return []string{
+			c.varEvaluationString(stdoutHelper, false),
+			c.varEvaluationString(stderrHelper, false),
+			c.varEvaluationString(codeHelper, false),
+		}, nil
+	}
+	c.addLine(callString)
+	return []string{"", "", "0"}, nil
+}
+
+func (c *converter) Input(prompt string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	if len(prompt) > 0 {
+		c.addLine(fmt.Sprintf(`Write-Host -NoNewline "%s"`, prompt))
+	}
+	c.VarAssignment(helper, "$([Console]::ReadLine())", false)
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Args(valueUsed bool) (string, error) {
+	c.argsRequired = true
+	return argsSlice, nil
+}
+
+func (c *converter) EnvGet(name string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, fmt.Sprintf(`$([Environment]::GetEnvironmentVariable("%s"))`, name), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) EnvExists(name string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.addLine(c.ifAssignmentString(
+		helper,
+		fmt.Sprintf(`$null -ne [Environment]::GetEnvironmentVariable("%s")`, name),
+		transpiler.BoolToString(true),
+		transpiler.BoolToString(false),
+	))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) Environ(valueUsed bool) (string, error) {
+	slice, err := c.SliceInstantiation([]string{}, valueUsed)
+
+	if err != nil {
+		return "", err
+	}
+	c.addLine(fmt.Sprintf(`%s["%s"].AddRange([string[]]@(Get-ChildItem env: | ForEach-Object { "$($_.Name)=$($_.Value)" }))`, dynamicVars, slice))
+	return slice, nil
+}
+
+func (c *converter) Copy(destination string, source string, valueUsed bool, global bool) (string, error) {
+	destination = c.varEvaluationString(destination, global)
+
+	c.sliceCopyHelperRequired = true
+	c.addLine(fmt.Sprintf(`%s "%s" "%s"`, sliceCopyHelper, destination, source))
+
+	helper := c.nextHelperVar()
+	c.VarAssignment(helper, c.countString(destination), false)
+
+	return c.varEvaluationString(helper, false), nil
+}
+
+func (c *converter) Exists(path string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.addLine(c.ifAssignmentString(
+		helper,
+		fmt.Sprintf(`Test-Path -LiteralPath "%s"`, path),
+		transpiler.BoolToString(true),
+		transpiler.BoolToString(false),
+	))
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) ReadFile(path string, valueUsed bool) (string, error) {
+	helper := c.nextHelperVar()
+
+	c.fileReadHelperRequired = true
+	c.VarAssignment(helper, fmt.Sprintf(`$(%s "%s")`, fileReadHelper, path), false)
+
+	return c.VarEvaluation(helper, valueUsed, false)
+}
+
+func (c *converter) mustCurrentForVar() string {
+	return c.fors[len(c.fors)-1].variable
+}
+
+func (c *converter) varName(name string, global bool) string {
+	name = fmt.Sprintf("v_%s", name) // Prefix variables to make sure they don't collide with automatic variables (e.g. $Host).
+
+	if global {
+		name = fmt.Sprintf("script:%s", name)
+	}
+	return name
+}
+
+func (c *converter) varAssignmentString(name string, value string, global bool) string {
+	return fmt.Sprintf(`$%s = "%s"`, c.varName(name, global), value)
+}
+
+func (c *converter) varEvaluationString(name string, global bool) string {
+	return fmt.Sprintf("${%s}", c.varName(name, global))
+}
+
+func (c *converter) ifAssignmentString(name string, condition string, trueValue string, falseValue string) string {
+	return fmt.Sprintf("if (%s) { %s } else { %s }",
+		condition,
+		c.varAssignmentString(name, trueValue, false),
+		c.varAssignmentString(name, falseValue, false),
+	)
+}
+
+// boolString converts a bool value to true/false and returns the evaluation of the helper variable.
+func (c *converter) boolString(value string) string {
+	helper := c.nextHelperVar()
+
+	c.addLine(c.ifAssignmentString(helper, fmt.Sprintf(`"%s" -eq "%s"`, value, transpiler.BoolToString(true)), "true", "false"))
+	return c.varEvaluationString(helper, false)
+}
+
+func (c *converter) countString(name string) string {
+	return fmt.Sprintf(`$(%s["%s"].Count)`, dynamicVars, name)
+}
+
+// appendDestination returns the slice to which values are appended. Unless the values are appended in
+// place, it's a copy of the passed slice.
+func (c *converter) appendDestination(name string, inPlace bool, valueUsed bool) (string, error) {
+	if inPlace {
+		return name, nil
+	}
+	return c.SliceRange(name, "0", c.countString(name), valueUsed)
+}
+
+func (c *converter) addDynamicVar(name string, value string) {
+	c.addLine(fmt.Sprintf("%s++", dynamicVarsCounter)) // Dynamic variable counter.
+	c.VarAssignment(name, fmt.Sprintf("_dv$(%s)", dynamicVarsCounter), false)
+	c.addLine(fmt.Sprintf(`%s["%s"] = %s`, dynamicVars, c.varEvaluationString(name, false), value))
+}
+
+func (c *converter) addHelper(helperType string, functionName string, code ...string) {
+	c.addStartLine(fmt.Sprintf("# global %s helper", helperType))
+	c.addStartLine(fmt.Sprintf("function %s {", functionName))
+
+	for _, line := range code {
+		c.addStartLine(line)
+	}
+	c.addStartLine("}")
+}
+
+func (c *converter) addStartLine(line string) {
+	c.startCode = append(c.startCode, line)
+}
+
+func (c *converter) addLine(line string) {
+	c.code = append(c.code, line)
+}
+
+func (c *converter) nextHelperVar() string {
+	helperVar := fmt.Sprintf("_h%d", c.varCounter)
+	c.varCounter++
+
+	return helperVar
+}
+
+func funcName(name string) string {
+	return fmt.Sprintf("f_%s", name) // Prefix functions because aliases take precedence over functions (e.g. sort).
+}
+
+func escapeString(value string) string {
+	return strings.NewReplacer(
+		"`", "``",
+		`"`, "`\"",
+		"“", "`“", // PowerShell also treats typographic quotes as quotes.
+		"”", "`”",
+		"„", "`„",
+		"$", "`$",
+		"\r", "`r",
+		"\n", "`n",
+	).Replace(value)
+}
@@ -0,0 +1,163 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContext = 3 // Amount of unchanged lines around changes.
+
+type edit struct {
+	kind byte // ' ' (unchanged), '-' (removed) or '+' (added).
+	text string
+}
+
+// Diff returns a unified diff (like gofmt -d) between the original and the formatted source. If both are equal,
+// an empty string is returned.
+func Diff(path string, original string, formatted string) string {
+	if original == formatted {
+		return ""
+	}
+	edits := diffEdits(diffLines(original), diffLines(formatted))
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf("diff %[1]s.orig %[1]s\n--- %[1]s.orig\n+++ %[1]s\n", path))
+
+	// Keep track of the line numbers in front of each edit.
+	oldLines := make([]int, len(edits)+1)
+	newLines := make([]int, len(edits)+1)
+
+	for i, e := range edits {
+		oldLines[i+1] = oldLines[i]
+		newLines[i+1] = newLines[i]
+
+		if e.kind != '+' {
+			oldLines[i+1]++
+		}
+		if e.kind != '-' {
+			newLines[i+1]++
+		}
+	}
+
+	for i := 0; i < len(edits); {
+		// Find next change.
+		for i < len(edits) && edits[i].kind == ' ' {
+			i++
+		}
+		if i == len(edits) {
+			break
+		}
+		start := max(0, i-diffContext)
+		end := i
+
+		// Merge changes which are close to each other into one hunk.
+		for {
+			for end < len(edits) && edits[end].kind != ' ' {
+				end++
+			}
+			next := end
+
+			for next < len(edits) && edits[next].kind == ' ' {
+				next++
+			}
+			if next == len(edits) || next-end > 2*diffContext {
+				break
+			}
+			end = next
+		}
+		end = min(len(edits), end+diffContext)
+
+		builder.WriteString(fmt.Sprintf(
+			"@@ -%s +%s @@\n",
+			hunkRange(oldLines[start], oldLines[end]-oldLines[start]),
+			hunkRange(newLines[start], newLines[end]-newLines[start]),
+		))
+
+		for _, e := range edits[start:end] {
+			builder.WriteByte(e.kind)
+			builder.WriteString(e.text)
+
+			if !strings.HasSuffix(e.text, "\n") {
+				builder.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+	return builder.String()
+}
+
+// diffLines splits the text into lines and keeps the line breaks to detect a missing final line break.
+func diffLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffEdits calculates the edits to get from the old to the new lines based on their longest common subsequence.
+func diffEdits(oldLines []string, newLines []string) []edit {
+	prefix := 0
+
+	// Skip common lines at the beginning and at the end to keep the table small.
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix && oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+	edits := []edit{}
+
+	for _, l := range oldLines[:prefix] {
+		edits = append(edits, edit{' ', l})
+	}
+	a := oldLines[prefix : len(oldLines)-suffix]
+	b := newLines[prefix : len(newLines)-suffix]
+	table := make([][]int, len(a)+1)
+
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	i, j := 0, 0
+
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, edit{' ', a[i]})
+			i++
+			j++
+		case j < len(b) && (i == len(a) || table[i][j+1] > table[i+1][j]):
+			edits = append(edits, edit{'+', b[j]})
+			j++
+		default:
+			edits = append(edits, edit{'-', a[i]})
+			i++
+		}
+	}
+
+	for _, l := range oldLines[len(oldLines)-suffix:] {
+		edits = append(edits, edit{' ', l})
+	}
+	return edits
+}
+
+// hunkRange returns the range of a hunk header (e.g. 3,4). Like in GNU diff, an empty range starts at the line before.
+func hunkRange(start int, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
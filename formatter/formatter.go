@@ -0,0 +1,459 @@
+package formatter
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/monstermichl/typeshell/lexer"
+)
+
+// bracket keeps track of an opened bracket to know how its content must be formatted.
+type bracket struct {
+	tokenType lexer.TokenType
+	isStruct  bool // Opened by struct {.
+	isImport  bool // Opened by import (.
+	isBlock   bool // Opened by a block header (e.g. if x {).
+}
+
+// line is a formatted line. Its text is split into cells which get aligned with the cells
+// of the surrounding lines (e.g. struct field types or trailing comments).
+type line struct {
+	indent int
+	cells  []string
+}
+
+func (l line) multiline() bool {
+	return slices.ContainsFunc(l.cells, func(cell string) bool {
+		return strings.Contains(cell, "\n")
+	})
+}
+
+func (l line) commentOnly() bool {
+	return len(l.cells) == 1 && (strings.HasPrefix(l.cells[0], "//") || strings.HasPrefix(l.cells[0], "/*"))
+}
+
+type formatter struct {
+	brackets []bracket
+	indents  []int // Bracket depths at which the indentation has been increased.
+}
+
+// Format formats TypeShell source code in a canonical style (tab indentation, spaces around operators,
+// aligned struct fields, key-value pairs, import blocks and trailing comments). Comments are preserved.
+func Format(source string) (string, error) {
+	tokens, err := lexer.TokenizeTrivia(source)
+
+	if err != nil {
+		return "", err
+	}
+	f := formatter{}
+	lines := []line{}
+	blank := false
+
+	for _, lineTokens := range splitLines(tokens) {
+		// Keep a single blank line at most and skip blank lines at the beginning.
+		if len(lineTokens) == 0 {
+			blank = len(lines) > 0
+			continue
+		}
+		if blank {
+			lines = append(lines, line{})
+			blank = false
+		}
+
+		// Blocks are split into several lines, therefore a source line can result in multiple lines.
+		for len(lineTokens) > 0 {
+			var formatted line
+			formatted, lineTokens = f.formatLine(lineTokens)
+			lines = append(lines, formatted)
+		}
+	}
+	alignCells(lines)
+
+	builder := strings.Builder{}
+
+	for _, l := range lines {
+		if len(l.cells) > 0 {
+			builder.WriteString(strings.Repeat("\t", l.indent))
+			builder.WriteString(strings.TrimRight(strings.Join(l.cells, ""), " \t"))
+		}
+		builder.WriteString("\n")
+	}
+	formatted := builder.String()
+
+	// Make sure formatting didn't change anything except whitespace.
+	if !sameTokens(source, formatted) {
+		return "", errors.New("formatting changed the meaning of the source")
+	}
+	return formatted, nil
+}
+
+// splitLines splits the tokens into lines without space tokens.
+func splitLines(tokens []lexer.Token) [][]lexer.Token {
+	lines := [][]lexer.Token{}
+	current := []lexer.Token{}
+
+	for _, token := range tokens {
+		switch token.Type() {
+		case lexer.SPACE:
+			// Spaces are added by the formatter itself.
+		case lexer.NEWLINE, lexer.EOF:
+			lines = append(lines, current)
+			current = []lexer.Token{}
+		default:
+			current = append(current, token)
+		}
+	}
+	return lines
+}
+
+// formatLine formats the tokens of a line. The content of a block and its closing bracket must be on separate
+// lines, therefore the tokens which have to be moved to the next line are returned.
+func (f *formatter) formatLine(tokens []lexer.Token) (line, []lexer.Token) {
+	depth := len(f.brackets)
+	leadingClosers := 0
+
+	// Closing brackets at the beginning of a line belong to the outer indentation.
+	for _, token := range tokens {
+		if !isClosingBracket(token.Type()) {
+			break
+		}
+		leadingClosers++
+	}
+	indent := 0
+
+	for _, indentDepth := range f.indents {
+		if indentDepth < depth-leadingClosers {
+			indent++
+		}
+	}
+
+	// Like in Go, cases are on the same level as the switch.
+	if firstType := tokens[0].Type(); (firstType == lexer.CASE || firstType == lexer.DEFAULT) && indent > 0 {
+		indent--
+	}
+	var enclosing bracket
+
+	if depth > 0 {
+		enclosing = f.brackets[depth-1]
+	}
+	splitIndex := f.splitIndex(tokens, enclosing)
+	cells := []string{""}
+	minDepth := depth
+	headerDepth := -1 // Bracket depth of an if-, for-, switch-, else-, func- or struct-header.
+
+	rest := []lexer.Token{}
+
+	if splitIndex == 0 {
+		cells = append(cells, "")
+	}
+
+	for i, token := range tokens {
+		tokenType := token.Type()
+		value := token.Value()
+
+		if i > 0 && f.lineBreakRequired(tokens[i-1], token) {
+			rest = tokens[i:]
+			break
+		}
+
+		if tokenType == lexer.COMMENT && strings.HasPrefix(value, "//") {
+			value = strings.TrimRight(value, " \t")
+		}
+		block := tokenType == lexer.OPENING_CURLY_BRACKET && len(f.brackets) == headerDepth && blockBracket(tokens, i)
+
+		if i > 0 {
+			var next lexer.Token
+
+			if i+1 < len(tokens) {
+				next = tokens[i+1]
+			}
+			trailingComment := tokenType == lexer.COMMENT && i == len(tokens)-1 && strings.HasPrefix(value, "//")
+
+			if (i == splitIndex && splitIndex > 0) || trailingComment {
+				cells = append(cells, "")
+			} else if f.spaceRequired(tokens[i-1], token, next, block) {
+				cells[len(cells)-1] += " "
+			}
+		}
+		cells[len(cells)-1] += value
+
+		switch {
+		case slices.Contains([]lexer.TokenType{lexer.IF, lexer.FOR, lexer.SWITCH, lexer.ELSE, lexer.FUNCTION_DEFINITION, lexer.STRUCT}, tokenType):
+			headerDepth = len(f.brackets)
+		case isOpeningBracket(tokenType):
+			var prevType lexer.TokenType
+
+			if i > 0 {
+				prevType = tokens[i-1].Type()
+			}
+			f.brackets = append(f.brackets, bracket{
+				tokenType: tokenType,
+				isStruct:  tokenType == lexer.OPENING_CURLY_BRACKET && prevType == lexer.STRUCT,
+				isImport:  tokenType == lexer.OPENING_ROUND_BRACKET && prevType == lexer.IMPORT,
+				isBlock:   block,
+			})
+		case isClosingBracket(tokenType) && len(f.brackets) > 0:
+			f.brackets = f.brackets[:len(f.brackets)-1]
+			minDepth = min(minDepth, len(f.brackets))
+
+			// Remove indentations which have been closed.
+			f.indents = slices.DeleteFunc(f.indents, func(indentDepth int) bool {
+				return indentDepth >= len(f.brackets)
+			})
+		}
+	}
+
+	// If brackets remain open, the following lines are indented once (even if multiple brackets have been opened).
+	if len(f.brackets) > minDepth {
+		f.indents = append(f.indents, minDepth)
+	}
+	return line{
+		indent: indent,
+		cells:  cells,
+	}, rest
+}
+
+// lineBreakRequired checks if the current token must be moved to the next line. This is the case for the first
+// statement after the opening bracket of a block and the closing bracket of a block (e.g. if x { return 0 }).
+// Structs are not considered as blocks (e.g. type p struct { a int }).
+func (f *formatter) lineBreakRequired(prev lexer.Token, cur lexer.Token) bool {
+	if len(f.brackets) == 0 {
+		return false
+	}
+	enclosing := f.brackets[len(f.brackets)-1]
+
+	if !enclosing.isBlock || enclosing.isStruct {
+		return false
+	}
+	return cur.Type() == lexer.CLOSING_CURLY_BRACKET || (prev.Type() == lexer.OPENING_CURLY_BRACKET && cur.Type() != lexer.COMMENT)
+}
+
+// splitIndex returns the index of the token which starts a new (aligned) cell or -1 if the line is not aligned.
+func (f *formatter) splitIndex(tokens []lexer.Token, enclosing bracket) int {
+	codeTokens := tokens
+
+	if last := tokens[len(tokens)-1]; last.Type() == lexer.COMMENT && len(tokens) > 1 {
+		codeTokens = tokens[:len(tokens)-1]
+	}
+	if len(codeTokens) < 2 {
+		// Import paths without alias are aligned with the aliased ones.
+		if enclosing.isImport && codeTokens[0].Type() == lexer.STRING_LITERAL {
+			return 0
+		}
+		return -1
+	}
+	firstType := codeTokens[0].Type()
+	secondType := codeTokens[1].Type()
+
+	switch {
+	case enclosing.isImport && firstType == lexer.IDENTIFIER:
+		// Align import paths.
+		return 1
+	case enclosing.isStruct && firstType == lexer.IDENTIFIER:
+		// Align field types.
+		return 1
+	case enclosing.tokenType == lexer.OPENING_CURLY_BRACKET && secondType == lexer.COLON && len(codeTokens) > 2 && slices.Contains([]lexer.TokenType{
+		lexer.IDENTIFIER,
+		lexer.STRING_LITERAL,
+		lexer.NUMBER_LITERAL,
+		lexer.BOOL_LITERAL,
+	}, firstType):
+		// Align values of key-value pairs.
+		return 2
+	}
+	return -1
+}
+
+// spaceRequired decides if a space is required between the previous and the current token.
+func (f *formatter) spaceRequired(prev lexer.Token, cur lexer.Token, next lexer.Token, block bool) bool {
+	prevType := prev.Type()
+	nextType := next.Type()
+	inSquareBrackets := len(f.brackets) > 0 && f.brackets[len(f.brackets)-1].tokenType == lexer.OPENING_SQUARE_BRACKET
+	inBlock := len(f.brackets) > 0 && f.brackets[len(f.brackets)-1].isBlock
+	space := true
+
+	switch cur.Type() {
+	case lexer.CLOSING_CURLY_BRACKET:
+		// Structs which are closed on the same line are separated (e.g. struct { a int }), empty ones are not.
+		space = inBlock && prevType != lexer.OPENING_CURLY_BRACKET
+	case lexer.CLOSING_ROUND_BRACKET, lexer.CLOSING_SQUARE_BRACKET, lexer.COMMA, lexer.COLON, lexer.DOT, lexer.INCREMENT_OPERATOR, lexer.DECREMENT_OPERATOR:
+		space = false
+	case lexer.SEMICOLON:
+		// For-loops without init statement (e.g. for ; i < 5; i++).
+		space = prevType == lexer.FOR
+	case lexer.BINARY_OPERATOR:
+		// Subscripts are kept compact (e.g. s[i+1]) if the operator doesn't merge with the next token (e.g. -1).
+		space = !inSquareBrackets || !separable(cur, next)
+	case lexer.ELLIPSIS:
+		// Spread arguments (e.g. f(s...)) are attached to the value, variadic parameter types are not.
+		space = nextType != lexer.CLOSING_ROUND_BRACKET && nextType != lexer.COMMA
+	case lexer.OPENING_ROUND_BRACKET:
+		// Calls (e.g. f(), print(), @"app"() or int()).
+		space = prevType != lexer.IDENTIFIER && prevType != lexer.STRING_LITERAL && prevType != lexer.DATA_TYPE && !isBuiltin(prevType)
+	case lexer.OPENING_SQUARE_BRACKET:
+		if nextType == lexer.CLOSING_SQUARE_BRACKET {
+			// Slice type (e.g. s []int or [][]int).
+			space = prevType != lexer.CLOSING_SQUARE_BRACKET
+		} else {
+			// Subscripts (e.g. s[0] or f()[0]) and map types.
+			space = !slices.Contains([]lexer.TokenType{
+				lexer.IDENTIFIER,
+				lexer.STRING_LITERAL,
+				lexer.CLOSING_SQUARE_BRACKET,
+				lexer.CLOSING_ROUND_BRACKET,
+				lexer.CLOSING_CURLY_BRACKET,
+				lexer.MAP,
+			}, prevType)
+		}
+	case lexer.OPENING_CURLY_BRACKET:
+		// Composite literals (e.g. []int{} or person{}) are attached to their type.
+		space = block || prevType == lexer.STRUCT || !slices.Contains([]lexer.TokenType{
+			lexer.IDENTIFIER,
+			lexer.DATA_TYPE,
+			lexer.CLOSING_SQUARE_BRACKET,
+		}, prevType)
+	case lexer.IDENTIFIER, lexer.DATA_TYPE, lexer.MAP:
+		// Element types of slices and maps (e.g. []int or map[string]int).
+		space = prevType != lexer.CLOSING_SQUARE_BRACKET
+	}
+
+	switch prevType {
+	case lexer.OPENING_CURLY_BRACKET:
+		space = inBlock && cur.Type() != lexer.CLOSING_CURLY_BRACKET
+	case lexer.OPENING_ROUND_BRACKET, lexer.OPENING_SQUARE_BRACKET, lexer.DOT, lexer.AT, lexer.UNARY_OPERATOR, lexer.ELLIPSIS:
+		space = false
+	case lexer.COLON:
+		// Slice ranges (e.g. s[1:2]) don't use spaces.
+		space = !inSquareBrackets
+	case lexer.BINARY_OPERATOR:
+		space = !inSquareBrackets || !separable(prev, cur)
+	}
+
+	// Make sure tokens don't merge if they are not separated (e.g. - and -1).
+	if !space && !separable(prev, cur) {
+		space = true
+	}
+	return space
+}
+
+// blockBracket checks if the curly bracket at the index opens a block (e.g. if x {) and not a composite
+// literal (e.g. for _, v := range []int{1} {). A block bracket either ends the line or its closing bracket
+// on the same line is followed by the end of the line, a comment or else.
+func blockBracket(tokens []lexer.Token, index int) bool {
+	depth := 0
+
+	for i := index + 1; i < len(tokens); i++ {
+		tokenType := tokens[i].Type()
+
+		if i == index+1 && tokenType == lexer.COMMENT {
+			return true
+		} else if isOpeningBracket(tokenType) {
+			depth++
+		} else if isClosingBracket(tokenType) && depth > 0 {
+			depth--
+		} else if isClosingBracket(tokenType) {
+			return i == len(tokens)-1 || slices.Contains([]lexer.TokenType{lexer.COMMENT, lexer.ELSE}, tokens[i+1].Type())
+		}
+	}
+	return index == len(tokens)-1
+}
+
+// separable checks if two tokens are still recognized as the same tokens if they are not separated by a space.
+func separable(prev lexer.Token, cur lexer.Token) bool {
+	tokens, err := lexer.TokenizeTrivia(prev.Value() + cur.Value())
+
+	if err != nil || len(tokens) != 3 { // Two tokens plus EOF.
+		return false
+	}
+	return tokens[0].Type() == prev.Type() && tokens[1].Type() == cur.Type() && tokens[0].Value() == prev.Value()
+}
+
+// alignCells pads the cells of consecutive lines with the same indentation to align the following cells.
+func alignCells(lines []line) {
+	maxCells := 0
+
+	for _, l := range lines {
+		maxCells = max(maxCells, len(l.cells))
+	}
+
+	for column := 0; column < maxCells-1; column++ {
+		for i := 0; i < len(lines); {
+			l := lines[i]
+
+			if len(l.cells) <= column+1 || l.multiline() {
+				i++
+				continue
+			}
+			block := []int{}
+			width := 0
+
+			// Collect the lines of the block. Comment lines in between don't interrupt the block.
+			for j := i; j < len(lines); j++ {
+				current := lines[j]
+
+				if current.indent != l.indent || current.multiline() {
+					break
+				} else if len(current.cells) > column+1 {
+					block = append(block, j)
+					width = max(width, utf8.RuneCountInString(current.cells[column]))
+				} else if !current.commentOnly() {
+					break
+				}
+				i = j + 1
+			}
+
+			// Empty columns (e.g. import block without aliases) are not padded.
+			if width == 0 {
+				continue
+			}
+
+			for _, j := range block {
+				cell := lines[j].cells[column]
+				lines[j].cells[column] = cell + strings.Repeat(" ", width-utf8.RuneCountInString(cell)+1)
+			}
+		}
+	}
+}
+
+// sameTokens checks if both sources consist of the same tokens (ignoring whitespace).
+func sameTokens(source1 string, source2 string) bool {
+	tokens1, err1 := significantTokens(source1)
+	tokens2, err2 := significantTokens(source2)
+
+	if err1 != nil || err2 != nil || len(tokens1) != len(tokens2) {
+		return false
+	}
+
+	for i, token := range tokens1 {
+		if token.Type() != tokens2[i].Type() || strings.TrimRight(token.Value(), " \t") != strings.TrimRight(tokens2[i].Value(), " \t") {
+			return false
+		}
+	}
+	return true
+}
+
+func significantTokens(source string) ([]lexer.Token, error) {
+	tokens, err := lexer.TokenizeTrivia(source)
+
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(tokens, func(token lexer.Token) bool {
+		return token.Type() == lexer.SPACE || token.Type() == lexer.NEWLINE
+	}), nil
+}
+
+func isOpeningBracket(tokenType lexer.TokenType) bool {
+	return slices.Contains([]lexer.TokenType{lexer.OPENING_ROUND_BRACKET, lexer.OPENING_SQUARE_BRACKET, lexer.OPENING_CURLY_BRACKET}, tokenType)
+}
+
+func isClosingBracket(tokenType lexer.TokenType) bool {
+	return slices.Contains([]lexer.TokenType{lexer.CLOSING_ROUND_BRACKET, lexer.CLOSING_SQUARE_BRACKET, lexer.CLOSING_CURLY_BRACKET}, tokenType)
+}
+
+// isBuiltin checks if the token is a builtin function (they are declared consecutively from LEN to DELETE).
+func isBuiltin(tokenType lexer.TokenType) bool {
+	return tokenType >= lexer.LEN && tokenType <= lexer.DELETE
+}
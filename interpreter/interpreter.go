@@ -0,0 +1,1428 @@
+package interpreter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/monstermichl/typeshell/parser"
+	"github.com/monstermichl/typeshell/transpiler"
+)
+
+type flowType int8
+
+// quoteReplacer escapes the characters which are escaped by the converters for %q.
+var quoteReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+const (
+	FLOW_NONE flowType = iota
+	FLOW_BREAK
+	FLOW_CONTINUE
+	FLOW_RETURN
+)
+
+// ExitError is returned if the program has been terminated with an exit code (e.g. by panic or exit).
+type ExitError struct {
+	code int
+}
+
+func (e ExitError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+func (e ExitError) Code() int {
+	return e.code
+}
+
+type frame struct {
+	variables    map[string]value
+	returnValues []value
+}
+
+type interpreter struct {
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+	globals   map[string]value
+	functions map[string]parser.FunctionDefinition
+	frames    []*frame
+	args      *sliceValue     // Like in the converted scripts, all args calls return the same slice.
+	position  parser.Position // Position of the statement which is currently being evaluated.
+}
+
+// New creates an interpreter which runs TypeShell code directly instead of converting it to a script.
+// Values behave like in the converted scripts (e.g. bools are printed as 1 and 0).
+func New(stdin io.Reader, stdout io.Writer, stderr io.Writer) interpreter {
+	return interpreter{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+	}
+}
+
+// Run runs the file. The args are provided to the program like script arguments.
+func (i *interpreter) Run(path string, args ...string) error {
+	p := parser.New()
+	ast, err := p.Parse(path)
+
+	if err != nil {
+		return err
+	}
+	return i.runProgram(ast, args)
+}
+
+// RunSource runs the provided source. The path is used to resolve relative imports and to report errors.
+func (i *interpreter) RunSource(path string, source string, args ...string) error {
+	p := parser.New()
+	ast, err := p.ParseSource(path, source)
+
+	if err != nil {
+		return err
+	}
+	return i.runProgram(ast, args)
+}
+
+func (i *interpreter) runProgram(ast parser.Program, args []string) error {
+	i.globals = map[string]value{}
+	i.functions = map[string]parser.FunctionDefinition{}
+	i.frames = []*frame{}
+	i.args = &sliceValue{values: []value{}}
+
+	for _, arg := range args {
+		i.args.values = append(i.args.values, arg)
+	}
+
+	_, err := i.evaluateStatements(ast)
+
+	// Exiting with code 0 is a regular termination (like in the transpiled scripts).
+	if exitErr, ok := err.(ExitError); ok && exitErr.Code() == 0 {
+		err = nil
+	}
+	return err
+}
+
+func (i *interpreter) currentFrame() *frame {
+	return i.frames[len(i.frames)-1]
+}
+
+func (i *interpreter) variables(global bool) map[string]value {
+	if global || len(i.frames) == 0 {
+		return i.globals
+	}
+	return i.currentFrame().variables
+}
+
+func (i *interpreter) lookupVariable(variable parser.Variable) (value, error) {
+	v, exists := i.variables(variable.Global())[variable.Name()]
+
+	// Variables which haven't been assigned yet hold their default value (e.g. in skipped branches).
+	if !exists {
+		return defaultValue(variable.ValueType())
+	}
+	return v, nil
+}
+
+func (i *interpreter) assignVariable(variable parser.Variable, v value) {
+	i.variables(variable.Global())[variable.Name()] = copyValue(v)
+}
+
+func (i *interpreter) assignVariables(variables []parser.Variable, values []value) error {
+	variablesLen := len(variables)
+	valuesLen := len(values)
+
+	if variablesLen != valuesLen {
+		return fmt.Errorf("require %d values but got %d", variablesLen, valuesLen)
+	}
+
+	for j, variable := range variables {
+		i.assignVariable(variable, values[j])
+	}
+	return nil
+}
+
+func (i *interpreter) evaluateStatements(block parser.Block) (flowType, error) {
+	positions := block.Positions()
+
+	for j, statement := range block.Body() {
+		i.position = positions[j]
+		flow, err := i.evaluate(statement)
+
+		if err != nil || flow != FLOW_NONE {
+			return flow, err
+		}
+	}
+	return FLOW_NONE, nil
+}
+
+func (i *interpreter) evaluateVarDefinition(variables []parser.Variable, values []parser.Expression) error {
+	for j, variable := range variables {
+		v, err := i.evaluateExpression(values[j])
+
+		if err != nil {
+			return err
+		}
+		i.assignVariable(variable, v)
+	}
+	return nil
+}
+
+func (i *interpreter) evaluateCallAssignment(variables []parser.Variable, call parser.Call) error {
+	values, err := i.evaluateCall(call, true)
+
+	if err != nil {
+		return err
+	}
+	return i.assignVariables(variables, values)
+}
+
+// sliceElement returns a pointer to the element at the provided index. If the index doesn't
+// exist, it and its intermediate indices are created (like in Bash and Batch).
+func (i *interpreter) sliceElement(slice *sliceValue, index int, elementType parser.ValueType) (*value, error) {
+	if index < 0 {
+		return nil, fmt.Errorf("index %d out of range", index)
+	}
+
+	for len(slice.values) <= index {
+		v, err := defaultValue(elementType)
+
+		if err != nil {
+			return nil, err
+		}
+		slice.values = append(slice.values, v)
+	}
+	return &slice.values[index], nil
+}
+
+func (i *interpreter) evaluateSliceAssignment(assignment parser.SliceAssignment) error {
+	var sliceTemp value
+	var err error
+
+	// Nested slices are evaluated directly because they're references anyway.
+	if slice := assignment.Slice(); slice != nil {
+		sliceTemp, err = i.evaluateExpression(slice)
+	} else {
+		sliceTemp, err = i.lookupVariable(assignment.Variable)
+	}
+
+	if err != nil {
+		return err
+	}
+	return i.assignSliceElement(sliceTemp.(*sliceValue), assignment.Index(), assignment.Value(), assignment.ValueType().ElementType())
+}
+
+func (i *interpreter) assignSliceElement(slice *sliceValue, indexExpr parser.Expression, valueExpr parser.Expression, elementType parser.ValueType) error {
+	index, err := i.evaluateExpression(indexExpr)
+
+	if err != nil {
+		return err
+	}
+	v, err := i.evaluateExpression(valueExpr)
+
+	if err != nil {
+		return err
+	}
+	element, err := i.sliceElement(slice, index.(int), elementType)
+
+	if err != nil {
+		return err
+	}
+	*element = copyValue(v)
+	return nil
+}
+
+func (i *interpreter) assignMapValue(m *mapValue, keyExpr parser.Expression, valueExpr parser.Expression) error {
+	key, err := i.evaluateExpression(keyExpr)
+
+	if err != nil {
+		return err
+	}
+	v, err := i.evaluateExpression(valueExpr)
+
+	if err != nil {
+		return err
+	}
+	m.set(key, copyValue(v))
+	return nil
+}
+
+func (i *interpreter) evaluateMapAssignment(assignment parser.MapAssignment) error {
+	m, err := i.lookupVariable(assignment.Variable)
+
+	if err != nil {
+		return err
+	}
+	return i.assignMapValue(m.(*mapValue), assignment.Key(), assignment.Value())
+}
+
+func (i *interpreter) evaluateDelete(del parser.Delete) error {
+	m, err := i.evaluateExpression(del.Value())
+
+	if err != nil {
+		return err
+	}
+	key, err := i.evaluateExpression(del.Key())
+
+	if err != nil {
+		return err
+	}
+	m.(*mapValue).delete(key)
+	return nil
+}
+
+// structField returns a pointer to the value of the field path within the provided struct.
+func structField(v *value, valueType parser.ValueType, fields []string) (*value, parser.ValueType, error) {
+	for _, fieldName := range fields {
+		s := (*v).(structValue)
+		found := false
+
+		for j, field := range valueType.Fields() {
+			if field.Name() == fieldName {
+				v = &s[j]
+				valueType = field.ValueType()
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, valueType, fmt.Errorf("%s has no field %s", valueType.String(), fieldName)
+		}
+	}
+	return v, valueType, nil
+}
+
+func (i *interpreter) evaluateStructAssignment(assignment parser.StructAssignment) error {
+	root, err := i.lookupVariable(assignment.Variable)
+
+	if err != nil {
+		return err
+	}
+	variables := i.variables(assignment.Global())
+	variables[assignment.Name()] = root // Make sure the variable exists before it's modified in place.
+
+	field, fieldValueType, err := structField(&root, assignment.ValueType(), assignment.Fields())
+
+	if err != nil {
+		return err
+	}
+	index := assignment.Index()
+
+	// If no index is provided, the field can be assigned directly.
+	if index == nil {
+		v, err := i.evaluateExpression(assignment.Value())
+
+		if err != nil {
+			return err
+		}
+		*field = copyValue(v)
+		return nil
+	}
+
+	// If the field is a map, the index is the key (e.g. h.Ports["http"] = 80).
+	if fieldValueType.IsMap() {
+		return i.assignMapValue((*field).(*mapValue), index, assignment.Value())
+	}
+	slice := (*field).(*sliceValue)
+	elementFields := assignment.ElementFields()
+
+	if len(elementFields) == 0 {
+		return i.assignSliceElement(slice, index, assignment.Value(), fieldValueType.ElementType())
+	}
+	indexValue, err := i.evaluateExpression(index)
+
+	if err != nil {
+		return err
+	}
+	element, err := i.sliceElement(slice, indexValue.(int), fieldValueType.ElementType())
+
+	if err != nil {
+		return err
+	}
+	elementField, _, err := structField(element, fieldValueType.ElementType(), elementFields)
+
+	if err != nil {
+		return err
+	}
+	v, err := i.evaluateExpression(assignment.Value())
+
+	if err != nil {
+		return err
+	}
+	*elementField = copyValue(v)
+	return nil
+}
+
+func (i *interpreter) evaluateIf(ifStatement parser.If) (flowType, error) {
+	init := ifStatement.Init()
+
+	if init != nil {
+		flow, err := i.evaluate(init)
+
+		if err != nil {
+			return flow, err
+		}
+	}
+	branches := append([]parser.IfBranch{ifStatement.IfBranch()}, ifStatement.ElseIfBranches()...)
+
+	// Conditions are only evaluated if all previous conditions were false.
+	for _, branch := range branches {
+		condition, err := i.evaluateExpression(branch.Condition())
+
+		if err != nil {
+			return FLOW_NONE, err
+		}
+
+		if condition.(bool) {
+			return i.evaluateStatements(branch)
+		}
+	}
+	return i.evaluateStatements(ifStatement.Else())
+}
+
+func (i *interpreter) evaluateFor(forStatement parser.For) (flowType, error) {
+	init := forStatement.Init()
+
+	if init != nil {
+		_, err := i.evaluate(init)
+
+		if err != nil {
+			return FLOW_NONE, err
+		}
+	}
+
+	for {
+		condition, err := i.evaluateExpression(forStatement.Condition())
+
+		if err != nil {
+			return FLOW_NONE, err
+		}
+
+		if !condition.(bool) {
+			break
+		}
+		flow, err := i.evaluateStatements(forStatement)
+
+		if err != nil {
+			return flow, err
+		} else if flow == FLOW_BREAK {
+			break
+		} else if flow == FLOW_RETURN {
+			return flow, nil
+		}
+		increment := forStatement.Increment()
+
+		if increment != nil {
+			_, err = i.evaluate(increment)
+
+			if err != nil {
+				return FLOW_NONE, err
+			}
+		}
+	}
+	return FLOW_NONE, nil
+}
+
+func (i *interpreter) evaluateReturn(returnStatement parser.Return) (flowType, error) {
+	values := []value{}
+
+	for _, expr := range returnStatement.Values() {
+		v, err := i.evaluateExpression(expr)
+
+		if err != nil {
+			return FLOW_NONE, err
+		}
+		values = append(values, v)
+	}
+	i.currentFrame().returnValues = values
+	return FLOW_RETURN, nil
+}
+
+func (i *interpreter) evaluatePrint(print parser.Print) error {
+	values := []string{}
+
+	for _, expr := range print.Expressions() {
+		var exprValues []value
+		var err error
+
+		// Calls print all of their return values.
+		if call, ok := expr.(parser.Call); ok {
+			exprValues, err = i.evaluateCall(call, true)
+		} else {
+			var v value
+			v, err = i.evaluateExpression(expr)
+			exprValues = []value{v}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range exprValues {
+			// Bools are printed as true/false.
+			if b, ok := v.(bool); ok {
+				values = append(values, strconv.FormatBool(b))
+			} else {
+				values = append(values, valueToString(v))
+			}
+		}
+	}
+	writer := i.stdout
+	s := strings.Join(values, " ")
+
+	if print.Stderr() {
+		writer = i.stderr
+	}
+	if print.Newline() {
+		s += "\n"
+	}
+	_, err := fmt.Fprint(writer, s)
+	return err
+}
+
+func (i *interpreter) evaluateSprintf(sprintf parser.Sprintf) (value, error) {
+	s := ""
+
+	for _, part := range sprintf.Parts() {
+		if part.IsText() {
+			s += part.Text()
+			continue
+		}
+		v, err := i.evaluateExpression(part.Value())
+
+		if err != nil {
+			return nil, err
+		}
+		verb := part.Verb()
+
+		// Pad with the same printf format the converters use.
+		if n, ok := v.(int); ok && verb.Verb() == 'd' {
+			s += fmt.Sprintf(transpiler.PrintfFormat(verb), n)
+			continue
+		}
+		str := valueToString(v)
+
+		if b, ok := v.(bool); ok {
+			str = strconv.FormatBool(b)
+		}
+		if verb.Verb() == 'q' {
+			str = fmt.Sprintf(`"%s"`, quoteReplacer.Replace(str))
+		}
+		s += fmt.Sprintf(transpiler.PrintfFormat(verb), str)
+	}
+	return s, nil
+}
+
+func (i *interpreter) evaluatePanic(panic parser.Panic) error {
+	v, err := i.evaluateExpression(panic.Expression())
+
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(i.stderr, "panic: %s at %s\n", valueToString(v), transpiler.PositionToString(i.position))
+	return ExitError{code: 1}
+}
+
+func (i *interpreter) evaluateSetEnv(setEnv parser.SetEnv) error {
+	key, err := i.evaluateExpression(setEnv.Key())
+
+	if err != nil {
+		return err
+	}
+	value, err := i.evaluateExpression(setEnv.Value())
+
+	if err != nil {
+		return err
+	}
+
+	// Invalid names are ignored like in the transpiled scripts.
+	os.Setenv(key.(string), value.(string))
+	return nil
+}
+
+// evaluateWrite writes the data to the provided file. If writing fails, the error is returned as
+// error value (empty if the write succeeded) instead of aborting the program.
+func (i *interpreter) evaluateWrite(write parser.Write) (value, error) {
+	path, err := i.evaluateExpression(write.Path())
+
+	if err != nil {
+		return nil, err
+	}
+	data, err := i.evaluateExpression(write.Data())
+
+	if err != nil {
+		return nil, err
+	}
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	appendExpr := write.Append()
+
+	if appendExpr != nil {
+		appendValue, err := i.evaluateExpression(appendExpr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if appendValue.(bool) {
+			flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+		}
+	}
+	file, err := os.OpenFile(path.(string), flags, 0644)
+
+	if err != nil {
+		return err.Error(), nil
+	}
+	defer file.Close()
+
+	// Like the converters, a newline is added to the content.
+	if _, err = fmt.Fprintln(file, data.(string)); err != nil {
+		return err.Error(), nil
+	}
+	return "", nil
+}
+
+func (i *interpreter) evaluate(statement parser.Statement) (flowType, error) {
+	statementType := statement.StatementType()
+
+	switch statementType {
+	case parser.STATEMENT_TYPE_PROGRAM:
+		return i.evaluateStatements(statement.(parser.Program))
+	case parser.STATEMENT_TYPE_VAR_DEFINITION:
+		definition := statement.(parser.VariableDefinition)
+		return FLOW_NONE, i.evaluateVarDefinition(definition.Variables(), definition.Values())
+	case parser.STATEMENT_TYPE_VAR_DEFINITION_CALL_ASSIGNMENT:
+		definition := statement.(parser.VariableDefinitionCallAssignment)
+		return FLOW_NONE, i.evaluateCallAssignment(definition.Variables(), definition.Call())
+	case parser.STATEMENT_TYPE_VAR_ASSIGNMENT:
+		assignment := statement.(parser.VariableAssignment)
+		return FLOW_NONE, i.evaluateVarDefinition(assignment.Variables(), assignment.Values())
+	case parser.STATEMENT_TYPE_VAR_ASSIGNMENT_CALL_ASSIGNMENT:
+		assignment := statement.(parser.VariableAssignmentCallAssignment)
+		return FLOW_NONE, i.evaluateCallAssignment(assignment.Variables(), assignment.Call())
+	case parser.STATEMENT_TYPE_SLICE_ASSIGNMENT:
+		return FLOW_NONE, i.evaluateSliceAssignment(statement.(parser.SliceAssignment))
+	case parser.STATEMENT_TYPE_STRUCT_DEFINITION:
+		return FLOW_NONE, nil // Structs only exist at parse time.
+	case parser.STATEMENT_TYPE_STRUCT_ASSIGNMENT:
+		return FLOW_NONE, i.evaluateStructAssignment(statement.(parser.StructAssignment))
+	case parser.STATEMENT_TYPE_MAP_ASSIGNMENT:
+		return FLOW_NONE, i.evaluateMapAssignment(statement.(parser.MapAssignment))
+	case parser.STATEMENT_TYPE_DELETE:
+		return FLOW_NONE, i.evaluateDelete(statement.(parser.Delete))
+	case parser.STATEMENT_TYPE_FUNCTION_DEFINITION:
+		definition := statement.(parser.FunctionDefinition)
+		i.functions[definition.Name()] = definition
+		return FLOW_NONE, nil
+	case parser.STATEMENT_TYPE_RETURN:
+		return i.evaluateReturn(statement.(parser.Return))
+	case parser.STATEMENT_TYPE_IF:
+		return i.evaluateIf(statement.(parser.If))
+	case parser.STATEMENT_TYPE_FOR:
+		return i.evaluateFor(statement.(parser.For))
+	case parser.STATEMENT_TYPE_BREAK:
+		return FLOW_BREAK, nil
+	case parser.STATEMENT_TYPE_CONTINUE:
+		return FLOW_CONTINUE, nil
+	case parser.STATEMENT_TYPE_PRINT:
+		return FLOW_NONE, i.evaluatePrint(statement.(parser.Print))
+	case parser.STATEMENT_TYPE_PANIC:
+		return FLOW_NONE, i.evaluatePanic(statement.(parser.Panic))
+	case parser.STATEMENT_TYPE_EXIT:
+		code, err := i.evaluateExpression(statement.(parser.Exit).Code())
+
+		if err != nil {
+			return FLOW_NONE, err
+		}
+		return FLOW_NONE, ExitError{code: code.(int)}
+	case parser.STATEMENT_TYPE_WRITE:
+		writeErr, err := i.evaluateWrite(statement.(parser.Write))
+
+		if err != nil {
+			return FLOW_NONE, err
+		}
+
+		// Like in the transpiled scripts, a failed write is reported but doesn't stop the program.
+		if writeErr != "" {
+			fmt.Fprintln(i.stderr, writeErr)
+		}
+		return FLOW_NONE, nil
+	case parser.STATEMENT_TYPE_SETENV:
+		return FLOW_NONE, i.evaluateSetEnv(statement.(parser.SetEnv))
+	case parser.STATEMENT_TYPE_UNSETENV:
+		key, err := i.evaluateExpression(statement.(parser.UnsetEnv).Key())
+
+		if err != nil {
+			return FLOW_NONE, err
+		}
+		os.Unsetenv(key.(string))
+		return FLOW_NONE, nil
+	case parser.STATEMENT_TYPE_APP_CALL, parser.STATEMENT_TYPE_FUNCTION_CALL:
+		_, err := i.evaluateCall(statement.(parser.Call), false)
+		return FLOW_NONE, err
+	default:
+		expression, ok := statement.(parser.Expression)
+
+		if !ok {
+			return FLOW_NONE, fmt.Errorf("statement is not an expression (%v)", statement)
+		}
+		_, err := i.evaluateExpression(expression)
+		return FLOW_NONE, err
+	}
+}
+
+func (i *interpreter) evaluateCall(call parser.Call, valueUsed bool) ([]value, error) {
+	switch c := call.(type) {
+	case parser.FunctionCall:
+		return i.evaluateFunctionCall(c)
+	case parser.AppCall:
+		return i.evaluateAppCall(c, valueUsed)
+	case parser.MapLookup:
+		v, ok, err := i.evaluateMapValue(c.MapEvaluation)
+
+		if err != nil {
+			return nil, err
+		}
+		return []value{v, ok}, nil
+	case parser.Atoi:
+		v, err := i.evaluateExpression(c.Value())
+
+		if err != nil {
+			return nil, err
+		}
+		n, atoiErr := atoi(v.(string))
+		return []value{n, atoiErr}, nil
+	}
+	return nil, fmt.Errorf("unknown call type %s", call.StatementType())
+}
+
+func (i *interpreter) evaluateFunctionCall(call parser.FunctionCall) ([]value, error) {
+	name := call.Name()
+	definition, exists := i.functions[name]
+
+	if !exists {
+		return nil, fmt.Errorf(`function "%s" has not been defined`, name)
+	}
+	f := &frame{variables: map[string]value{}}
+
+	// Arguments are evaluated in the caller's frame.
+	for j, param := range definition.Params() {
+		v, err := i.evaluateExpression(call.Args()[j])
+
+		if err != nil {
+			return nil, err
+		}
+		f.variables[param.Name()] = copyValue(v)
+	}
+	i.frames = append(i.frames, f)
+	_, err := i.evaluateStatements(definition)
+	i.frames = i.frames[:len(i.frames)-1]
+
+	if err != nil {
+		return nil, err
+	}
+	returnValues := f.returnValues
+
+	// Make sure the function returns as many values as expected.
+	for _, returnType := range call.ReturnTypes()[len(returnValues):] {
+		v, err := defaultValue(returnType)
+
+		if err != nil {
+			return nil, err
+		}
+		returnValues = append(returnValues, v)
+	}
+	return returnValues, nil
+}
+
+// evaluateAppCall runs the program chain. If the value is used, stdout and stderr are captured
+// and trailing newlines are removed (like Bash does for command substitutions).
+func (i *interpreter) evaluateAppCall(call parser.AppCall, valueUsed bool) ([]value, error) {
+	cmds := []*exec.Cmd{}
+	nextCall := &call
+
+	for nextCall != nil {
+		args := []string{}
+		callArgs := nextCall.Args()
+		lastArgsIndex := len(callArgs) - 1
+
+		for j, arg := range callArgs {
+			v, err := i.evaluateExpression(arg)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// Pass the values of a spread slice as separate arguments.
+			if nextCall.Spread() && j == lastArgsIndex {
+				for _, element := range v.(*sliceValue).values {
+					args = append(args, valueToString(element))
+				}
+			} else {
+				args = append(args, valueToString(v))
+			}
+		}
+		cmds = append(cmds, exec.Command(nextCall.Name(), args...))
+		nextCall = nextCall.Next()
+	}
+	var stdout, stderr bytes.Buffer
+	var stdoutWriter, stderrWriter io.Writer = i.stdout, i.stderr
+
+	if valueUsed {
+		stdoutWriter = &stdout
+		stderrWriter = &stderr
+	}
+	code, err := runCmds(cmds, i.stdin, stdoutWriter, stderrWriter)
+
+	if err != nil {
+		return nil, err
+	}
+	return []value{
+		strings.TrimRight(stdout.String(), "\r\n"),
+		strings.TrimRight(stderr.String(), "\r\n"),
+		code,
+	}, nil
+}
+
+// runCmds pipes the output of each command into the next one and returns the exit code of the last one.
+func runCmds(cmds []*exec.Cmd, stdin io.Reader, stdout io.Writer, stderr io.Writer) (int, error) {
+	pipeFiles := []*os.File{}
+	lastIndex := len(cmds) - 1
+	waitStderr := func() error { return nil }
+
+	// All commands share the same stderr. If it's not a file, exec would copy the output of each
+	// command in a separate goroutine. Therefore, a pipe is used which is read by a single goroutine.
+	// This keeps the output in order and makes sure the writer is never written concurrently.
+	if _, isFile := stderr.(*os.File); !isFile {
+		reader, writer, err := os.Pipe()
+
+		if err != nil {
+			return 0, err
+		}
+		done := make(chan error, 1)
+
+		go func(w io.Writer) {
+			_, err := io.Copy(w, reader)
+			reader.Close()
+			done <- err
+		}(stderr)
+
+		waitStderr = func() error { return <-done }
+		stderr = writer
+		pipeFiles = append(pipeFiles, writer)
+	}
+
+	cmds[0].Stdin = stdin
+	cmds[lastIndex].Stdout = stdout
+
+	for j, cmd := range cmds {
+		cmd.Stderr = stderr
+
+		if j < lastIndex {
+			reader, writer, err := os.Pipe()
+
+			if err != nil {
+				return 0, err
+			}
+			cmd.Stdout = writer
+			cmds[j+1].Stdin = reader
+			pipeFiles = append(pipeFiles, reader, writer)
+		}
+	}
+	started := make([]bool, len(cmds))
+
+	for j, cmd := range cmds {
+		err := cmd.Start()
+
+		// Like in a shell, a program which can't be started doesn't stop the whole chain.
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", cmd.Path, err)
+		}
+		started[j] = err == nil
+	}
+
+	// The commands hold their own copies of the pipe ends, therefore close the ones of this process.
+	for _, file := range pipeFiles {
+		file.Close()
+	}
+	code := 0
+
+	for j, cmd := range cmds {
+		if !started[j] {
+			code = 127 // Command not found.
+			continue
+		}
+		err := cmd.Wait()
+		code = 0
+
+		if err != nil {
+			var exitErr *exec.ExitError
+
+			if !errors.As(err, &exitErr) {
+				return 0, err
+			}
+			code = exitErr.ExitCode()
+		}
+	}
+	return code, waitStderr()
+}
+
+func (i *interpreter) evaluateMapValue(evaluation parser.MapEvaluation) (value, bool, error) {
+	m, err := i.evaluateExpression(evaluation.Value())
+
+	if err != nil {
+		return nil, false, err
+	}
+	key, err := i.evaluateExpression(evaluation.Key())
+
+	if err != nil {
+		return nil, false, err
+	}
+	v, exists := m.(*mapValue).values[key]
+
+	if !exists {
+		v, err = defaultValue(evaluation.ValueType())
+	}
+	return v, exists, err
+}
+
+// divisionByZero panics like the transpiled scripts do if an integer or float is divided by zero.
+func (i *interpreter) divisionByZero() error {
+	fmt.Fprintf(i.stderr, "panic: division by zero at %s\n", transpiler.PositionToString(i.position))
+	return ExitError{code: 1}
+}
+
+func (i *interpreter) evaluateBinaryOperation(operation parser.BinaryOperation) (value, error) {
+	left, err := i.evaluateExpression(operation.Left())
+
+	if err != nil {
+		return nil, err
+	}
+	right, err := i.evaluateExpression(operation.Right())
+
+	if err != nil {
+		return nil, err
+	}
+	operator := operation.Operator()
+
+	switch l := left.(type) {
+	case int:
+		r := right.(int)
+
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION:
+			return l * r, nil
+		case parser.BINARY_OPERATOR_DIVISION, parser.BINARY_OPERATOR_MODULO:
+			if r == 0 {
+				return nil, i.divisionByZero()
+			} else if operator == parser.BINARY_OPERATOR_DIVISION {
+				return l / r, nil
+			}
+			return l % r, nil
+		case parser.BINARY_OPERATOR_ADDITION:
+			return l + r, nil
+		case parser.BINARY_OPERATOR_SUBTRACTION:
+			return l - r, nil
+		}
+	case float64:
+		r := right.(float64)
+
+		switch operator {
+		case parser.BINARY_OPERATOR_MULTIPLICATION:
+			return roundFloat(l * r), nil
+		case parser.BINARY_OPERATOR_DIVISION:
+			if r == 0 {
+				return nil, i.divisionByZero()
+			}
+			return roundFloat(l / r), nil
+		case parser.BINARY_OPERATOR_ADDITION:
+			return roundFloat(l + r), nil
+		case parser.BINARY_OPERATOR_SUBTRACTION:
+			return roundFloat(l - r), nil
+		}
+	case string:
+		if operator == parser.BINARY_OPERATOR_ADDITION {
+			return l + right.(string), nil
+		}
+	}
+	return nil, fmt.Errorf("binary operation %s is not allowed on type %s", operator, operation.ValueType().String())
+}
+
+func (i *interpreter) evaluateComparison(comparison parser.Comparison) (value, error) {
+	left, err := i.evaluateExpression(comparison.Left())
+
+	if err != nil {
+		return nil, err
+	}
+	right, err := i.evaluateExpression(comparison.Right())
+
+	if err != nil {
+		return nil, err
+	}
+	operator := comparison.Operator()
+
+	switch operator {
+	case parser.COMPARE_OPERATOR_EQUAL:
+		return left == right, nil
+	case parser.COMPARE_OPERATOR_NOT_EQUAL:
+		return left != right, nil
+	}
+	var result, ok bool
+
+	switch l := left.(type) {
+	case int:
+		result, ok = compareOrdered(l, right.(int), operator)
+	case float64:
+		result, ok = compareOrdered(l, right.(float64), operator)
+	}
+
+	if ok {
+		return result, nil
+	}
+	return nil, fmt.Errorf("comparison %s is not allowed on type %s", operator, comparison.Left().ValueType().String())
+}
+
+func compareOrdered[T int | float64](l T, r T, operator parser.CompareOperator) (bool, bool) {
+	switch operator {
+	case parser.COMPARE_OPERATOR_GREATER:
+		return l > r, true
+	case parser.COMPARE_OPERATOR_GREATER_OR_EQUAL:
+		return l >= r, true
+	case parser.COMPARE_OPERATOR_LESS:
+		return l < r, true
+	case parser.COMPARE_OPERATOR_LESS_OR_EQUAL:
+		return l <= r, true
+	}
+	return false, false
+}
+
+// evaluateLogicalOperation evaluates the right side only if it's required to get the result (short-circuit evaluation).
+func (i *interpreter) evaluateLogicalOperation(operation parser.LogicalOperation) (value, error) {
+	left, err := i.evaluateExpression(operation.Left())
+
+	if err != nil {
+		return nil, err
+	}
+	operator := operation.Operator()
+
+	switch operator {
+	case parser.LOGICAL_OPERATOR_AND:
+		if !left.(bool) {
+			return false, nil
+		}
+	case parser.LOGICAL_OPERATOR_OR:
+		if left.(bool) {
+			return true, nil
+		}
+	default:
+		return nil, fmt.Errorf(`unknown logical operator "%s"`, operator)
+	}
+	return i.evaluateExpression(operation.Right())
+}
+
+func (i *interpreter) evaluateSliceEvaluation(evaluation parser.SliceEvaluation) (value, error) {
+	slice, err := i.evaluateExpression(evaluation.Value())
+
+	if err != nil {
+		return nil, err
+	}
+	index, err := i.evaluateExpression(evaluation.Index())
+
+	if err != nil {
+		return nil, err
+	}
+	values := slice.(*sliceValue).values
+	indexInt := index.(int)
+
+	// Like in the converters, non-existing indices evaluate to the default value.
+	if indexInt < 0 || indexInt >= len(values) {
+		return defaultValue(evaluation.ValueType())
+	}
+	return values[indexInt], nil
+}
+
+// evaluateSliceRange creates a new slice like the converters do (indices are clamped to the slice bounds).
+func (i *interpreter) evaluateSliceRange(sliceRange parser.SliceRange) (value, error) {
+	slice, err := i.evaluateExpression(sliceRange.Value())
+
+	if err != nil {
+		return nil, err
+	}
+	start, err := i.evaluateExpression(sliceRange.StartIndex())
+
+	if err != nil {
+		return nil, err
+	}
+	end, err := i.evaluateExpression(sliceRange.EndIndex())
+
+	if err != nil {
+		return nil, err
+	}
+	values := slice.(*sliceValue).values
+	startInt := max(start.(int), 0)
+	endInt := min(end.(int), len(values))
+	result := &sliceValue{values: []value{}}
+
+	for j := startInt; j < endInt; j++ {
+		result.values = append(result.values, copyValue(values[j]))
+	}
+	return result, nil
+}
+
+// evaluateStringSubscript evaluates the subscript like Bash does (e.g. a negative start index counts from the end).
+func (i *interpreter) evaluateStringSubscript(subscript parser.StringSubscript) (value, error) {
+	s, err := i.evaluateExpression(subscript.Value())
+
+	if err != nil {
+		return nil, err
+	}
+	start, err := i.evaluateExpression(subscript.StartIndex())
+
+	if err != nil {
+		return nil, err
+	}
+	end, err := i.evaluateExpression(subscript.EndIndex())
+
+	if err != nil {
+		return nil, err
+	}
+	str := s.(string)
+	strLen := len(str)
+	startInt := start.(int)
+	length := end.(int) - startInt + 1
+
+	if startInt < 0 {
+		startInt = max(strLen+startInt, 0)
+	}
+	startInt = min(startInt, strLen)
+	endInt := min(startInt+max(length, 0), strLen)
+
+	return str[startInt:endInt], nil
+}
+
+func (i *interpreter) evaluateLen(l parser.Len) (value, error) {
+	v, err := i.evaluateExpression(l.Expression())
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := v.(type) {
+	case string:
+		return len(t), nil
+	case *sliceValue:
+		return len(t.values), nil
+	case *mapValue:
+		return len(t.keys), nil
+	}
+	return nil, fmt.Errorf("len is not allowed on type %s", l.Expression().ValueType().String())
+}
+
+func (i *interpreter) evaluateInput(input parser.Input) (value, error) {
+	prompt := input.Prompt()
+
+	if prompt != nil {
+		v, err := i.evaluateExpression(prompt)
+
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprint(i.stdout, valueToString(v))
+	}
+	line := []byte{}
+	b := make([]byte, 1)
+
+	// Read byte by byte to make sure no input is consumed which is meant for subsequent reads or programs.
+	for {
+		n, err := i.stdin.Read(b)
+
+		if n > 0 {
+			if b[0] == '\n' {
+				break
+			}
+			line = append(line, b[0])
+		}
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	return strings.TrimSuffix(string(line), "\r"), nil
+}
+
+// evaluateAppend creates a new slice like the converters do. Only if the result is assigned to the
+// appended slice variable, the values are appended in place.
+func (i *interpreter) evaluateAppend(a parser.Append) (value, error) {
+	slice, err := i.evaluateExpression(a.Value())
+
+	if err != nil {
+		return nil, err
+	}
+	values := []value{}
+
+	for _, expr := range a.Values() {
+		v, err := i.evaluateExpression(expr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Spread slices provide their values.
+		if a.Spread() {
+			values = append(values, v.(*sliceValue).values...)
+		} else {
+			values = append(values, v)
+		}
+	}
+	result := slice.(*sliceValue)
+
+	if !a.InPlace() {
+		result = &sliceValue{values: []value{}}
+
+		for _, v := range slice.(*sliceValue).values {
+			result.values = append(result.values, copyValue(v))
+		}
+	}
+
+	for _, v := range values {
+		result.values = append(result.values, copyValue(v))
+	}
+	return result, nil
+}
+
+func (i *interpreter) evaluateCopy(c parser.Copy) (value, error) {
+	source, err := i.evaluateExpression(c.Source())
+
+	if err != nil {
+		return nil, err
+	}
+	destination, err := i.lookupVariable(c.Destination())
+
+	if err != nil {
+		return nil, err
+	}
+	destinationSlice := destination.(*sliceValue)
+
+	// Like in the converters, all source values are copied and the destination is extended if required.
+	for j, v := range source.(*sliceValue).values {
+		element, err := i.sliceElement(destinationSlice, j, c.Destination().ValueType().ElementType())
+
+		if err != nil {
+			return nil, err
+		}
+		*element = copyValue(v)
+	}
+	i.variables(c.Destination().Global())[c.Destination().Name()] = destinationSlice
+
+	return len(destinationSlice.values), nil
+}
+
+func (i *interpreter) evaluateConversion(conversion parser.Conversion) (value, error) {
+	v, err := i.evaluateExpression(conversion.Value())
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := v.(type) {
+	case int:
+		if conversion.ValueType().IsFloat() {
+			return float64(t), nil
+		}
+		return t, nil
+	case float64:
+		if conversion.ValueType().IsInt() {
+			return int(t), nil // Like in Go, the decimal places are truncated.
+		}
+		return t, nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to %s", conversion.Value().ValueType().String(), conversion.ValueType().String())
+}
+
+func (i *interpreter) evaluateExpression(expression parser.Expression) (value, error) {
+	expressionType := expression.StatementType()
+
+	switch expressionType {
+	case parser.STATEMENT_TYPE_BOOL_LITERAL:
+		return expression.(parser.BooleanLiteral).Value(), nil
+	case parser.STATEMENT_TYPE_INT_LITERAL:
+		return expression.(parser.IntegerLiteral).Value(), nil
+	case parser.STATEMENT_TYPE_FLOAT_LITERAL:
+		return roundFloat(expression.(parser.FloatLiteral).Value()), nil
+	case parser.STATEMENT_TYPE_STRING_LITERAL:
+		return expression.(parser.StringLiteral).Value(), nil
+	case parser.STATEMENT_TYPE_UNARY_OPERATION:
+		operation := expression.(parser.UnaryOperation)
+		v, err := i.evaluateExpression(operation.Expression())
+
+		if err != nil {
+			return nil, err
+		} else if operation.Operator() != parser.UNARY_OPERATOR_NEGATE {
+			return nil, fmt.Errorf(`unknown unary operator "%s"`, operation.Operator())
+		}
+		return !v.(bool), nil
+	case parser.STATEMENT_TYPE_BINARY_OPERATION:
+		return i.evaluateBinaryOperation(expression.(parser.BinaryOperation))
+	case parser.STATEMENT_TYPE_COMPARISON:
+		return i.evaluateComparison(expression.(parser.Comparison))
+	case parser.STATEMENT_TYPE_LOGICAL_OPERATION:
+		return i.evaluateLogicalOperation(expression.(parser.LogicalOperation))
+	case parser.STATEMENT_TYPE_VAR_EVALUATION:
+		return i.lookupVariable(expression.(parser.VariableEvaluation).Variable)
+	case parser.STATEMENT_TYPE_SLICE_EVALUATION:
+		return i.evaluateSliceEvaluation(expression.(parser.SliceEvaluation))
+	case parser.STATEMENT_TYPE_STRING_SUBSCRIPT:
+		return i.evaluateStringSubscript(expression.(parser.StringSubscript))
+	case parser.STATEMENT_TYPE_SLICE_RANGE:
+		return i.evaluateSliceRange(expression.(parser.SliceRange))
+	case parser.STATEMENT_TYPE_GROUP:
+		return i.evaluateExpression(expression.(parser.Group).Child())
+	case parser.STATEMENT_TYPE_FUNCTION_CALL, parser.STATEMENT_TYPE_APP_CALL:
+		values, err := i.evaluateCall(expression.(parser.Call), true)
+
+		if err != nil {
+			return nil, err
+		} else if len(values) == 0 {
+			return nil, fmt.Errorf("%s doesn't return a value", expression.(parser.Call).Name())
+		}
+		return values[0], nil
+	case parser.STATEMENT_TYPE_SLICE_INSTANTIATION:
+		slice := &sliceValue{values: []value{}}
+
+		for _, expr := range expression.(parser.SliceInstantiation).Values() {
+			v, err := i.evaluateExpression(expr)
+
+			if err != nil {
+				return nil, err
+			}
+			slice.values = append(slice.values, copyValue(v))
+		}
+		return slice, nil
+	case parser.STATEMENT_TYPE_STRUCT_INSTANTIATION:
+		s := structValue{}
+
+		for _, expr := range expression.(parser.StructInstantiation).Values() {
+			v, err := i.evaluateExpression(expr)
+
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, copyValue(v))
+		}
+		return s, nil
+	case parser.STATEMENT_TYPE_STRUCT_EVALUATION:
+		evaluation := expression.(parser.StructEvaluation)
+		v, err := i.evaluateExpression(evaluation.Value())
+
+		if err != nil {
+			return nil, err
+		}
+		field, _, err := structField(&v, evaluation.Value().ValueType(), []string{evaluation.Field()})
+
+		if err != nil {
+			return nil, err
+		}
+		return *field, nil
+	case parser.STATEMENT_TYPE_MAP_INSTANTIATION:
+		instantiation := expression.(parser.MapInstantiation)
+		m := newMapValue()
+
+		for j, keyExpr := range instantiation.Keys() {
+			err := i.assignMapValue(m, keyExpr, instantiation.Values()[j])
+
+			if err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case parser.STATEMENT_TYPE_MAP_EVALUATION:
+		v, _, err := i.evaluateMapValue(expression.(parser.MapEvaluation))
+		return v, err
+	case parser.STATEMENT_TYPE_MAP_KEYS:
+		m, err := i.evaluateExpression(expression.(parser.MapKeys).Value())
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Copy the keys to make sure they're not modified if the map is modified.
+		return &sliceValue{values: append([]value{}, m.(*mapValue).keys...)}, nil
+	case parser.STATEMENT_TYPE_INPUT:
+		return i.evaluateInput(expression.(parser.Input))
+	case parser.STATEMENT_TYPE_ARGS:
+		return i.args, nil
+	case parser.STATEMENT_TYPE_GETENV:
+		key, err := i.evaluateExpression(expression.(parser.GetEnv).Key())
+
+		if err != nil {
+			return nil, err
+		}
+		return os.Getenv(key.(string)), nil
+	case parser.STATEMENT_TYPE_HASENV:
+		key, err := i.evaluateExpression(expression.(parser.HasEnv).Key())
+
+		if err != nil {
+			return nil, err
+		}
+		_, exists := os.LookupEnv(key.(string))
+		return exists, nil
+	case parser.STATEMENT_TYPE_ENVIRON:
+		slice := &sliceValue{values: []value{}}
+
+		for _, entry := range os.Environ() {
+			slice.values = append(slice.values, entry)
+		}
+		return slice, nil
+	case parser.STATEMENT_TYPE_COPY:
+		return i.evaluateCopy(expression.(parser.Copy))
+	case parser.STATEMENT_TYPE_APPEND:
+		return i.evaluateAppend(expression.(parser.Append))
+	case parser.STATEMENT_TYPE_EXISTS:
+		path, err := i.evaluateExpression(expression.(parser.Exists).Path())
+
+		if err != nil {
+			return nil, err
+		}
+		_, err = os.Stat(path.(string))
+		return err == nil, nil
+	case parser.STATEMENT_TYPE_ITOA:
+		v, err := i.evaluateExpression(expression.(parser.Itoa).Value())
+
+		if err != nil {
+			return nil, err
+		}
+		return valueToString(v), nil
+	case parser.STATEMENT_TYPE_FTOA:
+		v, err := i.evaluateExpression(expression.(parser.Ftoa).Value())
+
+		if err != nil {
+			return nil, err
+		}
+		return valueToString(v), nil
+	case parser.STATEMENT_TYPE_SPRINTF:
+		return i.evaluateSprintf(expression.(parser.Sprintf))
+	case parser.STATEMENT_TYPE_CONVERSION:
+		return i.evaluateConversion(expression.(parser.Conversion))
+	case parser.STATEMENT_TYPE_LEN:
+		return i.evaluateLen(expression.(parser.Len))
+	case parser.STATEMENT_TYPE_READ:
+		path, err := i.evaluateExpression(expression.(parser.Read).Path())
+
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(path.(string))
+
+		// Like in the converters, a non-existing file results in an empty string.
+		if err != nil {
+			return "", nil
+		}
+		return strings.TrimRight(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n"), nil
+	}
+	return nil, fmt.Errorf("unknown expression type %s", expressionType)
+}
@@ -0,0 +1,169 @@
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/monstermichl/typeshell/parser"
+	"github.com/monstermichl/typeshell/transpiler"
+)
+
+// value holds a bool, an int, a float64, a string, a *sliceValue, a *mapValue or a structValue.
+type value = any
+
+// sliceValue is a reference type like slices in Bash and Batch (e.g. assigning a slice to
+// another variable doesn't copy the values).
+type sliceValue struct {
+	values []value
+}
+
+// mapValue is a reference type which keeps the insertion order of its keys.
+type mapValue struct {
+	keys   []value
+	values map[value]value
+}
+
+// structValue holds the field values in the order of the struct definition. Structs are
+// values, therefore they are copied whenever they are stored.
+type structValue []value
+
+func newMapValue() *mapValue {
+	return &mapValue{
+		keys:   []value{},
+		values: map[value]value{},
+	}
+}
+
+func (m *mapValue) set(key value, v value) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = v
+}
+
+func (m *mapValue) delete(key value) {
+	if _, exists := m.values[key]; !exists {
+		return
+	}
+	delete(m.values, key)
+
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// copyValue copies struct values (including nested structs). All other values are either
+// immutable or references and are therefore returned as they are.
+func copyValue(v value) value {
+	s, ok := v.(structValue)
+
+	if !ok {
+		return v
+	}
+	c := make(structValue, len(s))
+
+	for i, field := range s {
+		c[i] = copyValue(field)
+	}
+	return c
+}
+
+func defaultValue(valueType parser.ValueType) (value, error) {
+	// Slices and maps are references, therefore a new one is created.
+	if valueType.IsSlice() {
+		return &sliceValue{values: []value{}}, nil
+	} else if valueType.IsMap() {
+		return newMapValue(), nil
+	} else if valueType.IsStruct() {
+		s := structValue{}
+
+		for _, field := range valueType.Fields() {
+			fieldValue, err := defaultValue(field.ValueType())
+
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, fieldValue)
+		}
+		return s, nil
+	}
+
+	switch valueType.DataType() {
+	case parser.DATA_TYPE_BOOLEAN:
+		return false, nil
+	case parser.DATA_TYPE_INTEGER:
+		return 0, nil
+	case parser.DATA_TYPE_FLOAT:
+		return 0.0, nil
+	case parser.DATA_TYPE_STRING:
+		return "", nil
+	}
+	return nil, fmt.Errorf(`no default value defined for %s`, valueType.String())
+}
+
+// roundFloat rounds the value to the precision the converters use to get the same results.
+func roundFloat(f float64) float64 {
+	f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'f', transpiler.FloatPrecision, 64), 64)
+	return f
+}
+
+// valueToString converts a value to the same representation the converters produce.
+func valueToString(v value) string {
+	switch t := v.(type) {
+	case bool:
+		return transpiler.BoolToString(t)
+	case int:
+		return transpiler.IntToString(t)
+	case float64:
+		return transpiler.FloatToString(t)
+	case string:
+		return t
+	case structValue:
+		fields := []string{}
+
+		// Structs are printed like in Go (e.g. {a 1 true}).
+		for _, field := range t {
+			if b, ok := field.(bool); ok {
+				fields = append(fields, strconv.FormatBool(b))
+				continue
+			}
+			fields = append(fields, valueToString(field))
+		}
+		return fmt.Sprintf("{%s}", strings.Join(fields, " "))
+	case *sliceValue:
+		values := []string{}
+
+		for _, element := range t.values {
+			values = append(values, valueToString(element))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(values, " "))
+	case *mapValue:
+		entries := []string{}
+
+		for _, key := range t.keys {
+			entries = append(entries, fmt.Sprintf("%s:%s", valueToString(key), valueToString(t.values[key])))
+		}
+		return fmt.Sprintf("map[%s]", strings.Join(entries, " "))
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// atoi converts a string to an integer. Errors are returned as error value (empty if the conversion succeeded).
+func atoi(s string) (int, string) {
+	n, err := strconv.Atoi(s)
+
+	if err == nil {
+		return n, ""
+	}
+	reason := "invalid syntax"
+
+	if errors.Is(err, strconv.ErrRange) {
+		reason = "value out of range"
+	}
+	return 0, fmt.Sprintf(`strconv.Atoi: parsing "%s": %s`, s, reason)
+}
@@ -50,6 +50,7 @@ const (
 	COLON
 	SEMICOLON
 	DOT
+	ELLIPSIS
 	SPACE
 	NEWLINE
 
@@ -60,6 +61,9 @@ const (
 	IMPORT
 	VAR_DEFINITION
 	FUNCTION_DEFINITION
+	TYPE_DEFINITION
+	STRUCT
+	MAP
 	RETURN
 	IF
 	ELSE
@@ -74,13 +78,28 @@ const (
 	// Builtin functions.
 	LEN
 	PRINT
+	PRINTN
+	EPRINT
+	EPRINTN
 	INPUT
+	ARGS
+	GETENV
+	HASENV
+	SETENV
+	UNSETENV
+	ENVIRON
 	COPY
+	APPEND
 	ITOA
+	ATOI
+	FTOA
+	SPRINTF
 	EXISTS
 	READ
 	WRITE
 	PANIC
+	EXIT
+	DELETE
 
 	// App operators.
 	AT
@@ -93,6 +112,7 @@ const (
 const (
 	DATA_TYPE_BOOLEAN VarType = "bool"
 	DATA_TYPE_INTEGER VarType = "int"
+	DATA_TYPE_FLOAT   VarType = "float64"
 	DATA_TYPE_STRING  VarType = "string"
 	DATA_TYPE_ERROR   VarType = "error"
 )
@@ -102,6 +122,7 @@ type Token struct {
 	value     string
 	row       int
 	column    int
+	length    int // Number of source bytes the token spans (e.g. string literals including quotes).
 }
 
 func (t Token) Type() TokenType {
@@ -120,6 +141,10 @@ func (t Token) Column() int {
 	return t.column
 }
 
+func (t Token) Length() int {
+	return t.length
+}
+
 type tokenMapping struct {
 	value     string
 	tokenType TokenType
@@ -167,6 +192,7 @@ var nonAlphabeticTokens = []tokenMapping{
 	{",", COMMA},
 	{":", COLON},
 	{";", SEMICOLON},
+	{"...", ELLIPSIS},
 	{".", DOT},
 	{" ", SPACE},
 	{"\t", SPACE},
@@ -182,6 +208,9 @@ var keywords = map[string]TokenType{
 	"import":   IMPORT,
 	"var":      VAR_DEFINITION,
 	"func":     FUNCTION_DEFINITION,
+	"type":     TYPE_DEFINITION,
+	"struct":   STRUCT,
+	"map":      MAP,
 	"return":   RETURN,
 	"if":       IF,
 	"else":     ELSE,
@@ -195,23 +224,70 @@ var keywords = map[string]TokenType{
 	"nil":      NIL_LITERAL,
 
 	// Builtin functions.
-	"len":    LEN,
-	"print":  PRINT,
-	"input":  INPUT,
-	"copy":   COPY,
-	"itoa":   ITOA,
-	"exists": EXISTS,
-	"read":   READ,
-	"write":  WRITE,
-	"panic":  PANIC,
+	"len":      LEN,
+	"print":    PRINT,
+	"printn":   PRINTN,
+	"eprint":   EPRINT,
+	"eprintn":  EPRINTN,
+	"input":    INPUT,
+	"args":     ARGS,
+	"getenv":   GETENV,
+	"hasenv":   HASENV,
+	"setenv":   SETENV,
+	"unsetenv": UNSETENV,
+	"environ":  ENVIRON,
+	"copy":     COPY,
+	"append":   APPEND,
+	"itoa":     ITOA,
+	"atoi":     ATOI,
+	"ftoa":     FTOA,
+	"sprintf":  SPRINTF,
+	"exists":   EXISTS,
+	"read":     READ,
+	"write":    WRITE,
+	"panic":    PANIC,
+	"exit":     EXIT,
+	"delete":   DELETE,
 
 	// Types.
 	DATA_TYPE_BOOLEAN: DATA_TYPE,
 	DATA_TYPE_INTEGER: DATA_TYPE,
+	DATA_TYPE_FLOAT:   DATA_TYPE,
 	DATA_TYPE_STRING:  DATA_TYPE,
 	DATA_TYPE_ERROR:   DATA_TYPE,
 }
 
+// Error describes an error at a specific position of the source.
+type Error struct {
+	message string
+	row     int
+	column  int
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("%s at row %d, column %d", e.message, e.row, e.column)
+}
+
+func (e Error) Message() string {
+	return e.message
+}
+
+func (e Error) Row() int {
+	return e.row
+}
+
+func (e Error) Column() int {
+	return e.column
+}
+
+func newError(message string, row int, column int) Error {
+	return Error{
+		message: message,
+		row:     row,
+		column:  column,
+	}
+}
+
 func newToken(value string, tokenType TokenType, row int, column int) Token {
 	return Token{
 		value:     value,
@@ -224,13 +300,26 @@ func newToken(value string, tokenType TokenType, row int, column int) Token {
 func char(s string, position int) string {
 	c := ""
 
+	// Slice the string instead of converting the byte to keep multi-byte characters (UTF-8) intact.
 	if position < len(s) {
-		c = string(s[position])
+		c = s[position : position+1]
 	}
 	return c
 }
 
+// Tokenize splits the source into tokens. Spaces and comments are dropped.
 func Tokenize(source string) ([]Token, error) {
+	return tokenize(source, false)
+}
+
+// TokenizeTrivia works like Tokenize but keeps space and comment tokens (trivia). Each token holds the
+// text as written in the source (e.g. comments include their delimiters and string literals their quotes)
+// so concatenating all token values results in the original source (with \r\n replaced by \n).
+func TokenizeTrivia(source string) ([]Token, error) {
+	return tokenize(source, true)
+}
+
+func tokenize(source string, trivia bool) ([]Token, error) {
 	var err error = nil
 	tokens := []Token{}
 	i := 0
@@ -244,6 +333,7 @@ func Tokenize(source string) ([]Token, error) {
 	for i < sourceLength {
 		var token Token
 		c0 := char(source, i)
+		tokenStart := i
 		ogI := i
 		ogRow := row
 		ogColumn := column
@@ -262,7 +352,7 @@ func Tokenize(source string) ([]Token, error) {
 					parsed, err := strconv.Unquote(fmt.Sprintf(`"%s"`, match))
 
 					if err != nil {
-						return nil, fmt.Errorf(`invalid escape sequence "%s"`, match)
+						return nil, newError(fmt.Sprintf(`invalid escape sequence "%s"`, match), row, column+(i-ogI))
 					}
 					str += parsed
 					i += len(match)
@@ -281,10 +371,10 @@ func Tokenize(source string) ([]Token, error) {
 			}
 
 			if token.tokenType == UNKNOWN {
-				err = fmt.Errorf("string at row %d, column %d has not been terminated", ogRow, ogColumn)
+				err = newError("string has not been terminated", ogRow, ogColumn)
 				break
 			}
-		} else if matches := regexp.MustCompile(`(?s)^\/\*(.*)\*\/`).FindStringSubmatch(source[i:]); matches != nil {
+		} else if matches := regexp.MustCompile(`(?s)^\/\*(.*?)\*\/`).FindStringSubmatch(source[i:]); matches != nil {
 			// Multiline comment.
 			token = newToken(matches[1], COMMENT, ogRow, ogColumn)
 			match := matches[0]
@@ -352,10 +442,15 @@ func Tokenize(source string) ([]Token, error) {
 			column = ogColumn + (i - ogI)
 		}
 
+		token.length = i - tokenStart
+
 		// If still no token has been found, exit with error.
 		if token.tokenType == UNKNOWN {
-			err = fmt.Errorf(`unknown token "%s" at position %d`, c0, i)
+			err = newError(fmt.Sprintf(`unknown token "%s"`, c0), ogRow, ogColumn)
 			break
+		} else if trivia {
+			token.value = source[tokenStart:i]
+			tokens = append(tokens, token)
 		} else if slices.Contains([]TokenType{SPACE, COMMENT}, token.tokenType) {
 			// Ignore spaces and comments for now.
 		} else {
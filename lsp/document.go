@@ -0,0 +1,209 @@
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/monstermichl/typeshell/parser"
+)
+
+// document holds an opened source file and the information the parser collected about it.
+type document struct {
+	path       string
+	lines      []string
+	err        error
+	symbols    []parser.Symbol
+	references []parser.Reference
+}
+
+func newDocument(uri string, text string) document {
+	path := uriToPath(uri)
+	p := parser.New()
+	_, err := p.ParseSource(path, text)
+
+	// Symbols and references are available even if parsing failed (up to the error).
+	return document{
+		path:       path,
+		lines:      strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n"), // The lexer also replaces \r\n.
+		err:        err,
+		symbols:    p.Symbols(),
+		references: p.References(),
+	}
+}
+
+// diagnostics converts the parser errors into diagnostics. Errors without position (or from imported
+// files) are reported at the beginning of the document.
+func (d document) diagnostics() []diagnostic {
+	diagnostics := []diagnostic{}
+
+	for _, parserDiagnostic := range parser.ErrorDiagnostics(d.path, d.err) {
+		row := parserDiagnostic.Row()
+		column := parserDiagnostic.Column()
+		message := parserDiagnostic.Message()
+		r := textRange{}
+
+		if row > 0 && samePath(parserDiagnostic.Path(), d.path) {
+			r = textRange{
+				Start: d.position(row, column),
+				End:   d.position(parserDiagnostic.EndRow(), parserDiagnostic.EndColumn()),
+			}
+		} else if row > 0 {
+			message = parserDiagnostic.Error()
+		}
+		severity := diagnosticSeverityError
+
+		if parserDiagnostic.Severity() == parser.SEVERITY_WARNING {
+			severity = diagnosticSeverityWarning
+		}
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    r,
+			Severity: severity,
+			Code:     string(parserDiagnostic.Code()),
+			Source:   "tsh",
+			Message:  message,
+		})
+	}
+	return diagnostics
+}
+
+// symbolMatch describes a symbol which is defined or referenced at a specific position.
+type symbolMatch struct {
+	symbol     parser.Symbol
+	column     int  // 1-based column where the name starts.
+	length     int  // Length of the name.
+	definition bool // Specifies if the match is the definition itself.
+}
+
+// symbolAt returns the symbol which is defined or referenced at the 1-based row and column.
+func (d document) symbolAt(row int, column int) (symbolMatch, bool) {
+	covers := func(path string, r int, c int, length int) bool {
+		return samePath(path, d.path) && r == row && column >= c && column < c+max(length, 1)
+	}
+
+	for _, reference := range d.references {
+		if covers(reference.Path(), reference.Row(), reference.Column(), reference.Length()) {
+			return symbolMatch{reference.Symbol(), reference.Column(), reference.Length(), false}, true
+		}
+	}
+
+	for _, symbol := range d.symbols {
+		if covers(symbol.Path(), symbol.Row(), symbol.Column(), len(symbol.Name())) {
+			return symbolMatch{symbol, symbol.Column(), len(symbol.Name()), true}, true
+		}
+	}
+	return symbolMatch{}, false
+}
+
+// fileSymbols returns the symbols which are defined in the provided file.
+func (d document) fileSymbols(path string) []parser.Symbol {
+	symbols := []parser.Symbol{}
+
+	for _, symbol := range d.symbols {
+		if samePath(symbol.Path(), path) {
+			symbols = append(symbols, symbol)
+		}
+	}
+	return symbols
+}
+
+// linePrefix returns the text of the row up to the 1-based column.
+func (d document) linePrefix(row int, column int) string {
+	line := d.line(row)
+	return line[:min(max(column-1, 0), len(line))]
+}
+
+func (d document) line(row int) string {
+	if row < 1 || row > len(d.lines) {
+		return ""
+	}
+	return d.lines[row-1]
+}
+
+// position converts the 1-based row and byte column of the parser to the 0-based line and UTF-16 character of LSP.
+func (d document) position(row int, column int) position {
+	line := d.line(row)
+	offset := min(max(column-1, 0), len(line))
+
+	return position{
+		Line:      max(row-1, 0),
+		Character: len(utf16.Encode([]rune(line[:offset]))) + max(column-1-len(line), 0),
+	}
+}
+
+// rowColumn converts the 0-based line and UTF-16 character of LSP to the 1-based row and byte column of the parser.
+func (d document) rowColumn(pos position) (int, int) {
+	row := pos.Line + 1
+	line := d.line(row)
+	units := 0
+	offset := 0
+
+	for _, c := range line {
+		if units >= pos.Character {
+			break
+		}
+		units += len(utf16.Encode([]rune{c}))
+		offset += utf8.RuneLen(c)
+	}
+	return row, offset + 1
+}
+
+func (d document) textRange(row int, column int, length int) textRange {
+	return textRange{
+		Start: d.position(row, column),
+		End:   d.position(row, column+length),
+	}
+}
+
+// symbolLocation returns the location of the symbol's name. Symbols of other files are located by their
+// byte column since their content is not known.
+func (d document) symbolLocation(symbol parser.Symbol) location {
+	r := textRange{
+		Start: position{Line: symbol.Row() - 1, Character: symbol.Column() - 1},
+		End:   position{Line: symbol.Row() - 1, Character: symbol.Column() - 1 + len(symbol.Name())},
+	}
+
+	if samePath(symbol.Path(), d.path) {
+		r = d.textRange(symbol.Row(), symbol.Column(), len(symbol.Name()))
+	}
+	return location{
+		URI:   pathToURI(symbol.Path()),
+		Range: r,
+	}
+}
+
+func samePath(a string, b string) bool {
+	return filepath.Clean(a) == filepath.Clean(b)
+}
+
+// uriToPath converts a file URI (e.g. file:///home/user/test.tsh) to a file path.
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	path := u.Path
+
+	// Windows paths start with a drive letter (e.g. file:///C:/test.tsh).
+	if len(path) >= 3 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+	return filepath.FromSlash(path)
+}
+
+// pathToURI converts a file path to a file URI.
+func pathToURI(path string) string {
+	path = filepath.ToSlash(path)
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	u := url.URL{
+		Scheme: "file",
+		Path:   path,
+	}
+	return u.String()
+}
@@ -0,0 +1,68 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const contentLengthHeader = "Content-Length"
+
+// readMessage reads the content of the next message. Each message starts with a header (only Content-Length
+// is evaluated) which is separated from the content by an empty line.
+func readMessage(reader *bufio.Reader) ([]byte, error) {
+	length := -1
+
+	for {
+		line, err := reader.ReadString('\n')
+
+		if err != nil {
+			// Reaching the end between two messages is not an error.
+			if errors.Is(err, io.EOF) && length < 0 && len(line) == 0 {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		// An empty line ends the header.
+		if len(line) == 0 {
+			break
+		}
+		name, value, found := strings.Cut(line, ":")
+
+		if found && strings.EqualFold(strings.TrimSpace(name), contentLengthHeader) {
+			length, err = strconv.Atoi(strings.TrimSpace(value))
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %s", contentLengthHeader, value)
+			}
+		}
+	}
+
+	if length < 0 {
+		return nil, fmt.Errorf("missing %s", contentLengthHeader)
+	}
+	content := make([]byte, length)
+	_, err := io.ReadFull(reader, content)
+
+	if err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+// writeMessage writes the value as JSON including the header.
+func writeMessage(writer io.Writer, value any) error {
+	content, err := json.Marshal(value)
+
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(writer, "%s: %d\r\n\r\n%s", contentLengthHeader, len(content), content)
+	return err
+}
@@ -0,0 +1,299 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/monstermichl/typeshell/parser"
+)
+
+var selectorRegex = regexp.MustCompile(`([a-zA-Z_]\w*)\.(\w*)$`) // Matches an alias selection which is being typed (e.g. strings.Con).
+
+type server struct {
+	reader    *bufio.Reader
+	writer    io.Writer
+	documents map[string]document // Maps URIs to opened documents.
+	shutdown  bool
+}
+
+// New creates a language server which reads requests from in and writes responses to out.
+func New(in io.Reader, out io.Writer) server {
+	return server{
+		reader:    bufio.NewReader(in),
+		writer:    out,
+		documents: map[string]document{},
+	}
+}
+
+// Run serves requests until the client sends the exit notification or closes the connection.
+func (s *server) Run() error {
+	for {
+		content, err := readMessage(s.reader)
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		req := request{}
+		err = json.Unmarshal(content, &req)
+
+		if err != nil {
+			err = s.writeError(nil, errorCodeParseError, err.Error())
+		} else if req.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("exit has been requested before shutdown")
+			}
+			return nil
+		} else {
+			err = s.handle(req)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *server) handle(req request) error {
+	var result any
+	var err error
+	isRequest := req.ID != nil
+
+	switch req.Method {
+	case "initialize":
+		result = initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:       textDocumentSyncFull,
+				DefinitionProvider:     true,
+				HoverProvider:          true,
+				CompletionProvider:     completionOptions{TriggerCharacters: []string{"."}},
+				DocumentSymbolProvider: true,
+			},
+			ServerInfo: serverInfo{Name: "tsh"},
+		}
+	case "shutdown":
+		s.shutdown = true
+	case "textDocument/didOpen":
+		params := didOpenTextDocumentParams{}
+
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			return s.update(params.TextDocument.URI, params.TextDocument.Text)
+		}
+	case "textDocument/didChange":
+		params := didChangeTextDocumentParams{}
+
+		// Only full synchronization is supported, therefore the last change contains the whole document.
+		if err = json.Unmarshal(req.Params, &params); err == nil && len(params.ContentChanges) > 0 {
+			return s.update(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+		}
+	case "textDocument/didClose":
+		params := didCloseTextDocumentParams{}
+
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			delete(s.documents, params.TextDocument.URI)
+			return s.publishDiagnostics(params.TextDocument.URI, []diagnostic{})
+		}
+	case "textDocument/definition":
+		result, err = s.withPosition(req.Params, s.definition)
+	case "textDocument/hover":
+		result, err = s.withPosition(req.Params, s.hover)
+	case "textDocument/completion":
+		result, err = s.withPosition(req.Params, s.completion)
+	case "textDocument/documentSymbol":
+		params := documentSymbolParams{}
+
+		if err = json.Unmarshal(req.Params, &params); err == nil {
+			result = s.documentSymbols(s.documents[params.TextDocument.URI])
+		}
+	default:
+		// Unknown notifications (e.g. initialized) are ignored.
+		if isRequest {
+			return s.writeError(req.ID, errorCodeMethodNotFound, fmt.Sprintf("method %s is not supported", req.Method))
+		}
+	}
+
+	if !isRequest {
+		return nil
+	}
+	if err != nil {
+		return s.writeError(req.ID, errorCodeInvalidParams, err.Error())
+	}
+	return writeMessage(s.writer, response{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result:  result,
+	})
+}
+
+// update parses the document and publishes the resulting diagnostics.
+func (s *server) update(uri string, text string) error {
+	doc := newDocument(uri, text)
+	s.documents[uri] = doc
+
+	return s.publishDiagnostics(uri, doc.diagnostics())
+}
+
+func (s *server) publishDiagnostics(uri string, diagnostics []diagnostic) error {
+	return writeMessage(s.writer, notification{
+		JSONRPC: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: publishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics,
+		},
+	})
+}
+
+func (s *server) writeError(id *json.RawMessage, code int, message string) error {
+	return writeMessage(s.writer, errorResponse{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error: responseError{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+// withPosition decodes the position parameters and calls the callout with the document and the parser's
+// row and column. If the document has not been opened, null is returned.
+func (s *server) withPosition(rawParams json.RawMessage, callout func(doc document, row int, column int) any) (any, error) {
+	params := textDocumentPositionParams{}
+	err := json.Unmarshal(rawParams, &params)
+
+	if err != nil {
+		return nil, err
+	}
+	doc, exists := s.documents[params.TextDocument.URI]
+
+	if !exists {
+		return nil, nil
+	}
+	row, column := doc.rowColumn(params.Position)
+	return callout(doc, row, column), nil
+}
+
+func (s *server) definition(doc document, row int, column int) any {
+	match, found := doc.symbolAt(row, column)
+
+	if !found {
+		return nil
+	}
+	symbol := match.symbol
+
+	// If the cursor is already on the import alias, go to the imported file.
+	if match.definition && symbol.Kind() == parser.SYMBOL_KIND_IMPORT {
+		return location{URI: pathToURI(symbol.ImportPath())}
+	}
+	return doc.symbolLocation(symbol)
+}
+
+func (s *server) hover(doc document, row int, column int) any {
+	match, found := doc.symbolAt(row, column)
+
+	if !found {
+		return nil
+	}
+	return hover{
+		Contents: markupContent{
+			Kind:  markupKindMarkdown,
+			Value: fmt.Sprintf("```go\n%s\n```", match.symbol.Signature()),
+		},
+		Range: doc.textRange(row, match.column, match.length),
+	}
+}
+
+// completion completes the public functions and types of imported files after an import alias (e.g. strings.)
+// and the import aliases otherwise.
+func (s *server) completion(doc document, row int, column int) any {
+	items := []completionItem{}
+	imports := []parser.Symbol{}
+
+	for _, symbol := range doc.fileSymbols(doc.path) {
+		if symbol.Kind() == parser.SYMBOL_KIND_IMPORT {
+			imports = append(imports, symbol)
+		}
+	}
+	matches := selectorRegex.FindStringSubmatch(doc.linePrefix(row, column))
+
+	if matches == nil {
+		for _, symbol := range imports {
+			items = append(items, completionItem{
+				Label:  symbol.Name(),
+				Kind:   completionItemKindModule,
+				Detail: symbol.Signature(),
+			})
+		}
+		return items
+	}
+	alias := matches[1]
+	index := slices.IndexFunc(imports, func(symbol parser.Symbol) bool { return symbol.Name() == alias })
+
+	if index < 0 {
+		return items
+	}
+
+	for _, symbol := range doc.fileSymbols(imports[index].ImportPath()) {
+		if !symbol.Global() || !symbol.Public() || !strings.HasPrefix(symbol.Name(), matches[2]) {
+			continue
+		}
+		kind := completionItemKindFunction
+
+		switch symbol.Kind() {
+		case parser.SYMBOL_KIND_FUNCTION:
+			// Nothing to do.
+		case parser.SYMBOL_KIND_STRUCT:
+			kind = completionItemKindStruct
+		default:
+			continue
+		}
+		items = append(items, completionItem{
+			Label:  symbol.Name(),
+			Kind:   kind,
+			Detail: symbol.Signature(),
+		})
+	}
+	return items
+}
+
+// documentSymbols returns the imports, functions, types and global variables of the document.
+func (s *server) documentSymbols(doc document) []documentSymbol {
+	symbols := []documentSymbol{}
+
+	for _, symbol := range doc.fileSymbols(doc.path) {
+		var kind int
+
+		switch symbol.Kind() {
+		case parser.SYMBOL_KIND_IMPORT:
+			kind = symbolKindModule
+		case parser.SYMBOL_KIND_FUNCTION:
+			kind = symbolKindFunction
+		case parser.SYMBOL_KIND_STRUCT:
+			kind = symbolKindStruct
+		case parser.SYMBOL_KIND_VARIABLE:
+			if !symbol.Global() {
+				continue
+			}
+			kind = symbolKindVariable
+		default:
+			continue
+		}
+		r := doc.symbolLocation(symbol).Range
+
+		symbols = append(symbols, documentSymbol{
+			Name:           symbol.Name(),
+			Detail:         symbol.Signature(),
+			Kind:           kind,
+			Range:          r,
+			SelectionRange: r,
+		})
+	}
+	return symbols
+}
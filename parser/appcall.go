@@ -1,9 +1,10 @@
 package parser
 
 type AppCall struct {
-	name string
-	args []Expression
-	next *AppCall
+	name   string
+	args   []Expression
+	spread bool // If true, the values of the last argument (slice) are passed as separate arguments.
+	next   *AppCall
 }
 
 func (a AppCall) StatementType() StatementType {
@@ -22,6 +23,10 @@ func (a AppCall) Args() []Expression {
 	return a.args
 }
 
+func (a AppCall) Spread() bool {
+	return a.spread
+}
+
 func (a AppCall) Next() *AppCall {
 	return a.next
 }
@@ -0,0 +1,32 @@
+package parser
+
+type Append struct {
+	value   Expression
+	values  []Expression
+	spread  bool // If true, the values of the last argument (slice) are appended.
+	inPlace bool // If true, the result is assigned to the appended slice variable, therefore no copy is required.
+}
+
+func (a Append) StatementType() StatementType {
+	return STATEMENT_TYPE_APPEND
+}
+
+func (a Append) ValueType() ValueType {
+	return a.value.ValueType()
+}
+
+func (a Append) Value() Expression {
+	return a.value
+}
+
+func (a Append) Values() []Expression {
+	return a.values
+}
+
+func (a Append) Spread() bool {
+	return a.spread
+}
+
+func (a Append) InPlace() bool {
+	return a.inPlace
+}
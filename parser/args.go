@@ -0,0 +1,12 @@
+package parser
+
+// Args provides the arguments which have been passed to the script (without the script name).
+type Args struct{}
+
+func (a Args) StatementType() StatementType {
+	return STATEMENT_TYPE_ARGS
+}
+
+func (a Args) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_STRING, true)
+}
@@ -0,0 +1,31 @@
+package parser
+
+// Atoi converts a string to an integer. It implements the Call interface to be handled like a function
+// call which returns the integer and an error.
+type Atoi struct {
+	value Expression
+}
+
+func (e Atoi) StatementType() StatementType {
+	return STATEMENT_TYPE_ATOI
+}
+
+func (e Atoi) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_MULTIPLE, false)
+}
+
+func (e Atoi) Value() Expression {
+	return e.value
+}
+
+func (e Atoi) Name() string {
+	return ""
+}
+
+func (e Atoi) Args() []Expression {
+	return []Expression{e.value}
+}
+
+func (e Atoi) ReturnTypes() []ValueType {
+	return []ValueType{NewValueType(DATA_TYPE_INTEGER, false), NewValueType(DATA_TYPE_ERROR, false)}
+}
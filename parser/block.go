@@ -2,4 +2,5 @@ package parser
 
 type Block interface {
 	Body() []Statement
+	Positions() []Position // Holds the source position of each body statement.
 }
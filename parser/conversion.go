@@ -0,0 +1,19 @@
+package parser
+
+// Conversion converts a value to another type (e.g. float64(1) or int(1.5)).
+type Conversion struct {
+	value     Expression
+	valueType ValueType
+}
+
+func (e Conversion) StatementType() StatementType {
+	return STATEMENT_TYPE_CONVERSION
+}
+
+func (e Conversion) ValueType() ValueType {
+	return e.valueType
+}
+
+func (e Conversion) Value() Expression {
+	return e.value
+}
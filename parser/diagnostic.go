@@ -0,0 +1,130 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/monstermichl/typeshell/lexer"
+)
+
+type Severity string
+
+const (
+	SEVERITY_ERROR   Severity = "error"
+	SEVERITY_WARNING Severity = "warning"
+)
+
+type DiagnosticCode string
+
+const (
+	DIAGNOSTIC_CODE_LEXICAL  DiagnosticCode = "lexical"  // The source contains unknown characters or invalid literals.
+	DIAGNOSTIC_CODE_SYNTAX   DiagnosticCode = "syntax"   // An unexpected token has been found.
+	DIAGNOSTIC_CODE_SEMANTIC DiagnosticCode = "semantic" // The code is well-formed but invalid (e.g. type mismatches or undefined names).
+	DIAGNOSTIC_CODE_IMPORT   DiagnosticCode = "import"   // An import could not be resolved.
+	DIAGNOSTIC_CODE_UNKNOWN  DiagnosticCode = "unknown"  // The error doesn't provide further information (e.g. file system errors).
+)
+
+// Diagnostic describes a problem in a source file. The span starts at row and column and ends before
+// endRow and endColumn (all 1-based). Diagnostics which don't refer to a position have a row of 0.
+type Diagnostic struct {
+	message   string
+	path      string
+	row       int
+	column    int
+	endRow    int
+	endColumn int
+	severity  Severity
+	code      DiagnosticCode
+}
+
+// NewDiagnostic creates a diagnostic which spans length bytes of a single row.
+func NewDiagnostic(message string, path string, row int, column int, length int, severity Severity, code DiagnosticCode) Diagnostic {
+	return Diagnostic{
+		message:   message,
+		path:      path,
+		row:       row,
+		column:    column,
+		endRow:    row,
+		endColumn: column + max(length, 1),
+		severity:  severity,
+		code:      code,
+	}
+}
+
+// Error returns the diagnostic in the form path:row:column: message. Diagnostics without position
+// are returned in the form path: message.
+func (d Diagnostic) Error() string {
+	if d.row == 0 {
+		return fmt.Sprintf("%s: %s", d.path, d.message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", d.path, d.row, d.column, d.message)
+}
+
+func (d Diagnostic) Message() string {
+	return d.message
+}
+
+func (d Diagnostic) Path() string {
+	return d.path
+}
+
+func (d Diagnostic) Row() int {
+	return d.row
+}
+
+func (d Diagnostic) Column() int {
+	return d.column
+}
+
+func (d Diagnostic) EndRow() int {
+	return d.endRow
+}
+
+func (d Diagnostic) EndColumn() int {
+	return d.endColumn
+}
+
+func (d Diagnostic) Severity() Severity {
+	return d.severity
+}
+
+func (d Diagnostic) Code() DiagnosticCode {
+	return d.code
+}
+
+// Diagnostics is returned by the parser if one or more errors have been found.
+type Diagnostics []Diagnostic
+
+func (d Diagnostics) Error() string {
+	messages := []string{}
+
+	for _, diagnostic := range d {
+		messages = append(messages, diagnostic.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// ErrorDiagnostics converts an error into diagnostics. Errors without position (e.g. file system errors)
+// are reported for the provided file with a row of 0.
+func ErrorDiagnostics(path string, err error) Diagnostics {
+	var diagnostics Diagnostics
+	var diagnostic Diagnostic
+	var lexerErr lexer.Error
+
+	if err == nil {
+		return Diagnostics{}
+	} else if errors.As(err, &diagnostics) {
+		return diagnostics
+	} else if errors.As(err, &diagnostic) {
+		return Diagnostics{diagnostic}
+	} else if errors.As(err, &lexerErr) {
+		return Diagnostics{NewDiagnostic(lexerErr.Message(), path, lexerErr.Row(), lexerErr.Column(), 1, SEVERITY_ERROR, DIAGNOSTIC_CODE_LEXICAL)}
+	}
+	return Diagnostics{{
+		message:  err.Error(),
+		path:     path,
+		severity: SEVERITY_ERROR,
+		code:     DIAGNOSTIC_CODE_UNKNOWN,
+	}}
+}
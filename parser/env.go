@@ -0,0 +1,77 @@
+package parser
+
+// GetEnv returns the value of an environment variable or an empty string if it doesn't exist.
+type GetEnv struct {
+	key Expression
+}
+
+func (e GetEnv) StatementType() StatementType {
+	return STATEMENT_TYPE_GETENV
+}
+
+func (e GetEnv) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_STRING, false)
+}
+
+func (e GetEnv) Key() Expression {
+	return e.key
+}
+
+// HasEnv returns if an environment variable exists.
+type HasEnv struct {
+	key Expression
+}
+
+func (e HasEnv) StatementType() StatementType {
+	return STATEMENT_TYPE_HASENV
+}
+
+func (e HasEnv) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_BOOLEAN, false)
+}
+
+func (e HasEnv) Key() Expression {
+	return e.key
+}
+
+// SetEnv sets an environment variable which is passed to started programs.
+type SetEnv struct {
+	key   Expression
+	value Expression
+}
+
+func (e SetEnv) StatementType() StatementType {
+	return STATEMENT_TYPE_SETENV
+}
+
+func (e SetEnv) Key() Expression {
+	return e.key
+}
+
+func (e SetEnv) Value() Expression {
+	return e.value
+}
+
+// UnsetEnv removes an environment variable.
+type UnsetEnv struct {
+	key Expression
+}
+
+func (e UnsetEnv) StatementType() StatementType {
+	return STATEMENT_TYPE_UNSETENV
+}
+
+func (e UnsetEnv) Key() Expression {
+	return e.key
+}
+
+// Environ returns all environment variables in the form key=value.
+type Environ struct{}
+
+func (e Environ) StatementType() StatementType {
+	return STATEMENT_TYPE_ENVIRON
+}
+
+func (e Environ) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_STRING, true)
+}
@@ -0,0 +1,14 @@
+package parser
+
+// Exit terminates the program with the provided exit code.
+type Exit struct {
+	code Expression
+}
+
+func (e Exit) StatementType() StatementType {
+	return STATEMENT_TYPE_EXIT
+}
+
+func (e Exit) Code() Expression {
+	return e.code
+}
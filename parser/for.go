@@ -5,6 +5,7 @@ type For struct {
 	condition Expression
 	increment Statement
 	body      []Statement
+	positions []Position
 }
 
 func (f For) StatementType() StatementType {
@@ -26,3 +27,7 @@ func (f For) Increment() Statement {
 func (f For) Body() []Statement {
 	return f.body
 }
+
+func (f For) Positions() []Position {
+	return f.positions
+}
@@ -0,0 +1,17 @@
+package parser
+
+type Ftoa struct {
+	value Expression
+}
+
+func (e Ftoa) StatementType() StatementType {
+	return STATEMENT_TYPE_FTOA
+}
+
+func (e Ftoa) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_STRING, false)
+}
+
+func (e Ftoa) Value() Expression {
+	return e.value
+}
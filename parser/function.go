@@ -5,6 +5,8 @@ type FunctionDefinition struct {
 	returnTypes []ValueType
 	params      []Variable
 	body        []Statement
+	positions   []Position
+	variadic    bool
 	public      bool
 }
 
@@ -32,6 +34,14 @@ func (e FunctionDefinition) Body() []Statement {
 	return e.body
 }
 
+func (e FunctionDefinition) Positions() []Position {
+	return e.positions
+}
+
+func (e FunctionDefinition) Variadic() bool {
+	return e.variadic
+}
+
 func (e FunctionDefinition) Public() bool {
 	return e.public
 }
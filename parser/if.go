@@ -3,6 +3,7 @@ package parser
 type IfBranch struct {
 	condition Expression
 	body      []Statement
+	positions []Position
 }
 
 func (b IfBranch) Condition() Expression {
@@ -13,15 +14,25 @@ func (b IfBranch) Body() []Statement {
 	return b.body
 }
 
+func (b IfBranch) Positions() []Position {
+	return b.positions
+}
+
 type Else struct {
-	body []Statement
+	body      []Statement
+	positions []Position
 }
 
 func (e Else) Body() []Statement {
 	return e.body
 }
 
+func (e Else) Positions() []Position {
+	return e.positions
+}
+
 type If struct {
+	init         Statement // Optional statement which is evaluated before the first condition.
 	ifBranch     IfBranch
 	elifBranches []IfBranch
 	elseBranch   Else
@@ -31,6 +42,10 @@ func (i If) StatementType() StatementType {
 	return STATEMENT_TYPE_IF
 }
 
+func (i If) Init() Statement {
+	return i.init
+}
+
 func (i If) IfBranch() IfBranch {
 	return i.ifBranch
 }
@@ -32,6 +32,22 @@ func (l IntegerLiteral) Value() int {
 	return l.value
 }
 
+type FloatLiteral struct {
+	value float64
+}
+
+func (l FloatLiteral) StatementType() StatementType {
+	return STATEMENT_TYPE_FLOAT_LITERAL
+}
+
+func (l FloatLiteral) ValueType() ValueType {
+	return ValueType{dataType: DATA_TYPE_FLOAT}
+}
+
+func (l FloatLiteral) Value() float64 {
+	return l.value
+}
+
 type StringLiteral struct {
 	value string
 }
@@ -0,0 +1,123 @@
+package parser
+
+type MapInstantiation struct {
+	valueType ValueType
+	keys      []Expression
+	values    []Expression
+}
+
+func (m MapInstantiation) StatementType() StatementType {
+	return STATEMENT_TYPE_MAP_INSTANTIATION
+}
+
+func (m MapInstantiation) ValueType() ValueType {
+	return m.valueType
+}
+
+func (m MapInstantiation) Keys() []Expression {
+	return m.keys
+}
+
+func (m MapInstantiation) Values() []Expression {
+	return m.values
+}
+
+type MapEvaluation struct {
+	value     Expression
+	key       Expression
+	valueType ValueType
+}
+
+func (m MapEvaluation) StatementType() StatementType {
+	return STATEMENT_TYPE_MAP_EVALUATION
+}
+
+func (m MapEvaluation) Value() Expression {
+	return m.value
+}
+
+func (m MapEvaluation) Key() Expression {
+	return m.key
+}
+
+func (m MapEvaluation) ValueType() ValueType {
+	return m.valueType
+}
+
+// MapLookup is the comma-ok form of a map evaluation (e.g. v, ok := m[k]). It implements
+// the Call interface to be handled like a function call which returns multiple values.
+type MapLookup struct {
+	MapEvaluation
+}
+
+func (m MapLookup) StatementType() StatementType {
+	return STATEMENT_TYPE_MAP_LOOKUP
+}
+
+func (m MapLookup) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_MULTIPLE, false)
+}
+
+func (m MapLookup) Name() string {
+	return ""
+}
+
+func (m MapLookup) Args() []Expression {
+	return []Expression{m.key}
+}
+
+func (m MapLookup) ReturnTypes() []ValueType {
+	return []ValueType{m.valueType, NewValueType(DATA_TYPE_BOOLEAN, false)}
+}
+
+type MapAssignment struct {
+	Variable
+	key   Expression
+	value Expression
+}
+
+func (m MapAssignment) StatementType() StatementType {
+	return STATEMENT_TYPE_MAP_ASSIGNMENT
+}
+
+func (m MapAssignment) Key() Expression {
+	return m.key
+}
+
+func (m MapAssignment) Value() Expression {
+	return m.value
+}
+
+// MapKeys is used internally to iterate over a map. It returns a slice of all the map's keys.
+type MapKeys struct {
+	value Expression
+}
+
+func (m MapKeys) StatementType() StatementType {
+	return STATEMENT_TYPE_MAP_KEYS
+}
+
+func (m MapKeys) ValueType() ValueType {
+	return m.value.ValueType().MapKeyType().SliceType()
+}
+
+func (m MapKeys) Value() Expression {
+	return m.value
+}
+
+type Delete struct {
+	value Expression
+	key   Expression
+}
+
+func (d Delete) StatementType() StatementType {
+	return STATEMENT_TYPE_DELETE
+}
+
+func (d Delete) Value() Expression {
+	return d.value
+}
+
+func (d Delete) Key() Expression {
+	return d.key
+}
@@ -18,6 +18,7 @@ import (
 var typeMapping = map[lexer.VarType]DataType{
 	lexer.DATA_TYPE_BOOLEAN: DATA_TYPE_BOOLEAN,
 	lexer.DATA_TYPE_INTEGER: DATA_TYPE_INTEGER,
+	lexer.DATA_TYPE_FLOAT:   DATA_TYPE_FLOAT,
 	lexer.DATA_TYPE_STRING:  DATA_TYPE_STRING,
 	lexer.DATA_TYPE_ERROR:   DATA_TYPE_STRING, // error is internally just a string to make heandling easier.
 }
@@ -32,6 +33,12 @@ const (
 	SCOPE_SWITCH   scope = "switch"
 )
 
+const mainFunction = "main" // Entry point of the program (optional).
+const maxErrors = 10        // Parsing stops after this number of errors (like in Go).
+
+// errParsingStopped signals that parsing has been stopped and all errors have already been recorded.
+var errParsingStopped = errors.New("parsing has been stopped")
+
 func scopesToString(scopes []scope) []string {
 	strings := make([]string, len(scopes))
 
@@ -45,6 +52,8 @@ type context struct {
 	imports    map[string]string             // Maps import aliases to file hashes.
 	variables  map[string]Variable           // Stores the variable name to variable relation.
 	functions  map[string]FunctionDefinition // Stores the function name to function relation.
+	structs    map[string]ValueType          // Stores the struct name to struct type relation.
+	symbols    map[string]Symbol             // Stores the symbols of local variables (see symbolKey).
 	scopeStack []scope                       // Stores the current scopes.
 }
 
@@ -53,6 +62,8 @@ func newContext() context {
 		imports:   map[string]string{},
 		variables: map[string]Variable{},
 		functions: map[string]FunctionDefinition{},
+		structs:   map[string]ValueType{},
+		symbols:   map[string]Symbol{},
 	}
 }
 
@@ -121,6 +132,18 @@ func (c context) addFunctions(prefix string, global bool, functions ...FunctionD
 	return nil
 }
 
+func (c context) addStructs(prefix string, global bool, structs ...StructDefinition) error {
+	for _, definedStruct := range structs {
+		prefixedName, err := c.buildPrefixedName(definedStruct.Name(), prefix, global, false)
+
+		if err != nil {
+			return err
+		}
+		c.structs[prefixedName] = definedStruct.ValueType()
+	}
+	return nil
+}
+
 func (c context) findImport(alias string) (string, bool) {
 	hash, exists := c.imports[alias]
 	return hash, exists
@@ -146,11 +169,23 @@ func (c context) findFunction(name string, prefix string) (FunctionDefinition, b
 	return function, exists
 }
 
+func (c context) findStruct(name string, prefix string) (ValueType, bool) {
+	prefixedName, err := c.buildPrefixedName(name, prefix, true, true)
+
+	if err != nil {
+		return ValueType{}, false
+	}
+	valueType, exists := c.structs[prefixedName]
+	return valueType, exists
+}
+
 func (c context) clone() context {
 	return context{
 		imports:    maps.Clone(c.imports),
 		variables:  maps.Clone(c.variables),
 		functions:  maps.Clone(c.functions),
+		structs:    maps.Clone(c.structs),
+		symbols:    maps.Clone(c.symbols),
 		scopeStack: slices.Clone(c.scopeStack),
 	}
 }
@@ -158,6 +193,7 @@ func (c context) clone() context {
 type evaluatedImport struct {
 	alias string
 	path  string
+	token lexer.Token // Alias token or path token if no alias has been provided.
 }
 
 type evaluatedValues struct {
@@ -183,24 +219,50 @@ func (ev evaluatedValues) isMultiReturnCall() (bool, Call) {
 type blockCallback func(statements []Statement, last bool) error
 
 type Parser struct {
-	tokens    []lexer.Token
-	index     int
-	path      string
-	prefix    string
-	currFunc  string
-	usedFuncs map[string][]string // Stores which function (key) calls which functions (values).
+	tokens        []lexer.Token
+	index         int
+	path          string
+	prefix        string
+	currFunc      string
+	symbols       *symbolTable        // Stores the defined symbols and their references (shared with imports).
+	usedFuncs     map[string][]string // Stores which function (key) calls which functions (values).
+	diagnostics   Diagnostics         // Stores the errors which have been found so far.
+	errorResults  map[string][]int    // Stores which results of a function (key) are errors (values).
+	findings      Diagnostics         // Stores the vet findings which have been collected while parsing.
+	rangeCounter  int                 // Used to create unique helper variables for map iterations.
+	switchCounter int                 // Used to create unique helper variables for switch expressions.
+	sliceCounter  int                 // Used to create unique helper variables for nested slice assignments.
 }
 
 func New() Parser {
 	return Parser{
-		usedFuncs: map[string][]string{},
+		symbols:      newSymbolTable(),
+		usedFuncs:    map[string][]string{},
+		errorResults: map[string][]int{},
 	}
 }
 
+// Symbols returns the symbols which have been defined while parsing (including the ones of imported files).
+// If parsing failed, the symbols which have been found until parsing stopped are returned.
+func (p Parser) Symbols() []Symbol {
+	return p.symbols.symbols
+}
+
+// References returns the symbol usages which have been found while parsing (including the ones of imported files).
+// If parsing failed, the references which have been found until parsing stopped are returned.
+func (p Parser) References() []Reference {
+	return p.symbols.references
+}
+
 func (p *Parser) Parse(path string) (Program, error) {
 	return p.parse(path, false)
 }
 
+// ParseSource parses the provided source. The path is used to resolve relative imports and to report errors.
+func (p *Parser) ParseSource(path string, source string) (Program, error) {
+	return p.parseSource(path, source, false)
+}
+
 func (p *Parser) parse(path string, imported bool) (Program, error) {
 	// If path is relative, make it absolute.
 	if !filepath.IsAbs(path) {
@@ -221,27 +283,41 @@ func (p *Parser) parse(path string, imported bool) (Program, error) {
 	if err != nil {
 		return Program{}, err
 	}
-	tokens, err := lexer.Tokenize(string(source))
+	return p.parseSource(path, string(source), imported)
+}
+
+func (p *Parser) parseSource(path string, source string, imported bool) (Program, error) {
+	tokens, err := lexer.Tokenize(source)
 
 	if err != nil {
-		return Program{}, err
+		// Add the path to lexer errors to make sure all positional errors look the same.
+		return Program{}, ErrorDiagnostics(path, err)
 	}
 	p.index = 0
 	p.tokens = tokens
 	p.path = path
 	p.prefix = ""
+	p.diagnostics = Diagnostics{}
+	p.findings = Diagnostics{}
 
 	// If it's an imported file, use source hash as prefix.
 	if imported {
 		h := sha256.New()
-		h.Write(source)
+		h.Write([]byte(source))
 
 		p.prefix = fmt.Sprintf("%x", h.Sum(nil))[0:7] // Only use the 7 first characters (inspired by Git).
 	}
 	program, err := p.evaluateProgram()
 
 	if err != nil {
-		return Program{}, err
+		p.addError(err, p.peek())
+	}
+	if len(p.diagnostics) > 0 {
+		// Imported programs are returned anyway to let the importing file continue with the parsed parts.
+		if imported {
+			return program, p.diagnostics
+		}
+		return Program{}, p.diagnostics
 	}
 
 	// If this is the original program, removed unused stuff.
@@ -258,6 +334,8 @@ func allowedBinaryOperators(t ValueType) []BinaryOperator {
 		switch t.DataType() {
 		case DATA_TYPE_INTEGER:
 			operators = []BinaryOperator{BINARY_OPERATOR_MULTIPLICATION, BINARY_OPERATOR_DIVISION, BINARY_OPERATOR_MODULO, BINARY_OPERATOR_ADDITION, BINARY_OPERATOR_SUBTRACTION}
+		case DATA_TYPE_FLOAT:
+			operators = []BinaryOperator{BINARY_OPERATOR_MULTIPLICATION, BINARY_OPERATOR_DIVISION, BINARY_OPERATOR_ADDITION, BINARY_OPERATOR_SUBTRACTION}
 		case DATA_TYPE_STRING:
 			operators = []BinaryOperator{BINARY_OPERATOR_ADDITION}
 		default:
@@ -274,7 +352,7 @@ func allowedCompareOperators(t ValueType) []CompareOperator {
 		switch t.DataType() {
 		case DATA_TYPE_BOOLEAN:
 			operators = []CompareOperator{COMPARE_OPERATOR_EQUAL, COMPARE_OPERATOR_NOT_EQUAL}
-		case DATA_TYPE_INTEGER:
+		case DATA_TYPE_INTEGER, DATA_TYPE_FLOAT:
 			operators = []CompareOperator{COMPARE_OPERATOR_EQUAL, COMPARE_OPERATOR_NOT_EQUAL, COMPARE_OPERATOR_LESS, COMPARE_OPERATOR_LESS_OR_EQUAL, COMPARE_OPERATOR_GREATER, COMPARE_OPERATOR_GREATER_OR_EQUAL}
 		case DATA_TYPE_STRING:
 			operators = []CompareOperator{COMPARE_OPERATOR_EQUAL, COMPARE_OPERATOR_NOT_EQUAL, COMPARE_OPERATOR_LESS, COMPARE_OPERATOR_LESS_OR_EQUAL, COMPARE_OPERATOR_GREATER, COMPARE_OPERATOR_GREATER_OR_EQUAL}
@@ -288,17 +366,34 @@ func allowedCompareOperators(t ValueType) []CompareOperator {
 func defaultVarValue(valueType ValueType) (Expression, error) {
 	dataType := valueType.DataType()
 
-	if !valueType.IsSlice() {
+	if valueType.IsStruct() {
+		values := []Expression{}
+
+		// Initialize all fields with their default values.
+		for _, field := range valueType.Fields() {
+			value, err := defaultVarValue(field.ValueType())
+
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return StructInstantiation{valueType: valueType, values: values}, nil
+	} else if valueType.IsMap() {
+		return MapInstantiation{valueType: valueType}, nil
+	} else if !valueType.IsSlice() {
 		switch dataType {
 		case DATA_TYPE_BOOLEAN:
 			return BooleanLiteral{}, nil
 		case DATA_TYPE_INTEGER:
 			return IntegerLiteral{}, nil
+		case DATA_TYPE_FLOAT:
+			return FloatLiteral{}, nil
 		case DATA_TYPE_STRING:
 			return StringLiteral{}, nil
 		}
 	} else {
-		return SliceInstantiation{dataType: dataType}, nil
+		return SliceInstantiation{valueType: valueType}, nil
 	}
 	return nil, fmt.Errorf("no default value found for type %s", valueType.String())
 }
@@ -343,11 +438,62 @@ func buildPrefixedName(prefix string, funcName string) string {
 }
 
 func (p *Parser) atError(what string, token lexer.Token) error {
-	return fmt.Errorf("%s at row %d, column %d: %s", what, token.Row(), token.Column(), p.path)
+	return p.tokenDiagnostic(what, token, DIAGNOSTIC_CODE_SEMANTIC)
+}
+
+func (p *Parser) tokenDiagnostic(message string, token lexer.Token, code DiagnosticCode) Diagnostic {
+	return NewDiagnostic(message, p.path, token.Row(), token.Column(), token.Length(), SEVERITY_ERROR, code)
+}
+
+// addError records the error. Errors without position are reported at the token. If too many errors
+// have been recorded, false is returned to signal that parsing shall be stopped.
+func (p *Parser) addError(err error, token lexer.Token) bool {
+	var diagnostics Diagnostics
+	var diagnostic Diagnostic
+
+	if errors.Is(err, errParsingStopped) {
+		return false
+	} else if errors.As(err, &diagnostics) {
+		p.diagnostics = append(p.diagnostics, diagnostics...)
+	} else if errors.As(err, &diagnostic) {
+		p.diagnostics = append(p.diagnostics, diagnostic)
+	} else {
+		p.diagnostics = append(p.diagnostics, p.tokenDiagnostic(err.Error(), token, DIAGNOSTIC_CODE_SEMANTIC))
+	}
+
+	// Imported files can add several errors at once, therefore make sure the limit is not exceeded.
+	if len(p.diagnostics) > maxErrors {
+		p.diagnostics = p.diagnostics[:maxErrors]
+	}
+	return len(p.diagnostics) < maxErrors
+}
+
+// position returns the source position of the token.
+func (p *Parser) position(token lexer.Token) Position {
+	return Position{
+		path:   p.path,
+		row:    token.Row(),
+		column: token.Column(),
+	}
+}
+
+// repeatPosition returns the token's position count times (e.g. for statements which are generated from a single token).
+func (p *Parser) repeatPosition(token lexer.Token, count int) []Position {
+	positions := []Position{}
+
+	for i := 0; i < count; i++ {
+		positions = append(positions, p.position(token))
+	}
+	return positions
 }
 
 func (p *Parser) expectedError(what string, token lexer.Token) error {
-	return p.atError(fmt.Sprintf("expected %s", what), token)
+	return p.tokenDiagnostic(fmt.Sprintf("expected %s", what), token, DIAGNOSTIC_CODE_SYNTAX)
+}
+
+// mismatchError reports a well-formed construct which has the wrong type.
+func (p *Parser) mismatchError(what string, token lexer.Token) error {
+	return p.tokenDiagnostic(fmt.Sprintf("expected %s", what), token, DIAGNOSTIC_CODE_SEMANTIC)
 }
 
 func (p *Parser) expectedKeywordError(keyword string, token lexer.Token) error {
@@ -422,6 +568,12 @@ func (p *Parser) eat() lexer.Token {
 	return token
 }
 
+func (p *Parser) skipNewlines() {
+	for p.peek().Type() == lexer.NEWLINE {
+		p.eat()
+	}
+}
+
 func (p *Parser) isShortVarInit() bool {
 	_, err := p.findAllowed(lexer.SHORT_INIT_OPERATOR, lexer.IDENTIFIER, lexer.COMMA)
 
@@ -440,7 +592,10 @@ func (p *Parser) checkNewVariableNameToken(token lexer.Token, ctx context) error
 }
 
 func (p *Parser) getUsedFuncs(startFunc string) []string {
-	usedFuncs := []string{}
+	return p.collectUsedFuncs(startFunc, []string{})
+}
+
+func (p *Parser) collectUsedFuncs(startFunc string, usedFuncs []string) []string {
 	startFunc = strings.TrimSpace(startFunc)
 
 	if usedFuncsTemp, exists := p.usedFuncs[startFunc]; exists {
@@ -449,36 +604,33 @@ func (p *Parser) getUsedFuncs(startFunc string) []string {
 		}
 
 		for _, usedFuncTemp := range usedFuncsTemp {
-			if !slices.Contains(usedFuncs, usedFuncTemp) {
-				usedFuncs = append(usedFuncs, usedFuncTemp)
-			}
-			usedSubFuncs := p.getUsedFuncs(usedFuncTemp)
-
-			for _, usedSubFunc := range usedSubFuncs {
-				if !slices.Contains(usedFuncs, usedSubFunc) {
-					usedFuncs = append(usedFuncs, usedSubFunc)
-				}
+			// Skip functions which have already been collected to avoid endless loops on recursive calls.
+			if slices.Contains(usedFuncs, usedFuncTemp) {
+				continue
 			}
+			usedFuncs = append(usedFuncs, usedFuncTemp)
+			usedFuncs = p.collectUsedFuncs(usedFuncTemp, usedFuncs)
 		}
 	}
 	return usedFuncs
 }
 
 func (p *Parser) cleanProgram(program Program) (Program, error) {
-	statements := program.Body()
+	statements := []Statement{}
+	positions := []Position{}
 	usedFuncs := p.getUsedFuncs("")
 
 	// Remove all functions that are not being used.
-	statements = slices.DeleteFunc(statements, func(stmt Statement) bool {
-		switch stmt.StatementType() {
-		case STATEMENT_TYPE_FUNCTION_DEFINITION:
-			function := stmt.(FunctionDefinition)
-			return !slices.Contains(usedFuncs, function.Name())
+	for i, stmt := range program.Body() {
+		if function, ok := stmt.(FunctionDefinition); ok && !slices.Contains(usedFuncs, function.Name()) {
+			continue
 		}
-		return false
-	})
+		statements = append(statements, stmt)
+		positions = append(positions, program.Positions()[i])
+	}
 	return Program{
-		body: statements,
+		body:      statements,
+		positions: positions,
 	}, nil
 }
 
@@ -543,6 +695,18 @@ func (p *Parser) evaluateValues(ctx context) (evaluatedValues, error) {
 	}, nil
 }
 
+// withLookup converts a single map evaluation into its comma-ok form (e.g. v, ok := m[k]) if two values are expected.
+func (ev evaluatedValues) withLookup(expected int) evaluatedValues {
+	if expected == 2 && len(ev.values) == 1 {
+		if evaluation, ok := ev.values[0].(MapEvaluation); ok {
+			return evaluatedValues{
+				values: []Expression{MapLookup{evaluation}},
+			}
+		}
+	}
+	return ev
+}
+
 func (p *Parser) evaluateBuiltInFunction(tokenType lexer.TokenType, keyword string, minArgs int, maxArg int, ctx context, stmtCallout func(keywordToken lexer.Token, expressions []Expression) (Statement, error)) (Statement, error) {
 	keywordToken := p.eat()
 
@@ -601,7 +765,7 @@ func (p *Parser) evaluateBuiltInFunction(tokenType lexer.TokenType, keyword stri
 
 func (p *Parser) evaluateProgram() (Program, error) {
 	ctx := newContext()
-	statements, err := p.evaluateImports(ctx)
+	statements, positions, err := p.evaluateImports(ctx)
 
 	if err != nil {
 		return Program{}, err
@@ -614,21 +778,59 @@ func (p *Parser) evaluateProgram() (Program, error) {
 	if err != nil {
 		return Program{}, err
 	}
-	statementsTemp, err := p.evaluateBlockContent([]lexer.TokenType{lexer.EOF}, nil, ctx, SCOPE_PROGRAM)
+	statementsTemp, positionsTemp, err := p.evaluateBlockContent([]lexer.TokenType{lexer.EOF}, nil, ctx, SCOPE_PROGRAM)
 
 	if err != nil {
 		return Program{}, err
 	}
 	statements = append(statements, statementsTemp...)
-
+	positions = append(positions, positionsTemp...)
+
+	// If the original program defines a main function, it's called after all top-level statements.
+	if len(p.prefix) == 0 {
+		for i, stmt := range statementsTemp {
+			if function, ok := stmt.(FunctionDefinition); ok && function.Name() == mainFunction {
+				mainStatements := p.mainCall(function)
+				statements = append(statements, mainStatements...)
+
+				// The main call is attributed to the main function definition.
+				for range mainStatements {
+					positions = append(positions, positionsTemp[i])
+				}
+				break
+			}
+		}
+	}
 	return Program{
-		body: statements,
+		body:      statements,
+		positions: positions,
 	}, nil
 }
 
-func (p *Parser) evaluateImports(ctx context) ([]Statement, error) {
+// mainCall returns the statements which call the main function and exit with its return value (or 0
+// if it doesn't return a value).
+func (p *Parser) mainCall(main FunctionDefinition) []Statement {
+	call := FunctionCall{
+		name:        main.Name(),
+		arguments:   []Expression{},
+		returnTypes: main.ReturnTypes(),
+	}
+
+	// Mark main as used by the top level to make sure it doesn't get removed.
+	if !slices.Contains(p.usedFuncs[""], call.Name()) {
+		p.usedFuncs[""] = append(p.usedFuncs[""], call.Name())
+	}
+
+	if len(main.ReturnTypes()) > 0 {
+		return []Statement{Exit{code: call}}
+	}
+	return []Statement{call, Exit{code: IntegerLiteral{}}}
+}
+
+func (p *Parser) evaluateImports(ctx context) ([]Statement, []Position, error) {
 	var nextToken lexer.Token
 	statementsTemp := []Statement{}
+	positionsTemp := []Position{}
 
 	// Skip empty characters.
 	for {
@@ -649,7 +851,7 @@ func (p *Parser) evaluateImports(ctx context) ([]Statement, error) {
 			nextToken = p.eat()
 
 			if nextToken.Type() != lexer.NEWLINE {
-				return nil, p.expectedNewlineError(nextToken)
+				return nil, nil, p.expectedNewlineError(nextToken)
 			}
 		}
 
@@ -657,69 +859,16 @@ func (p *Parser) evaluateImports(ctx context) ([]Statement, error) {
 			imp, err := p.evaluateImport()
 
 			if err != nil {
-				return nil, err
-			}
-			path := imp.path
-			alias := imp.alias
-			absPath := path
-
-			// If path is relative, create an absolute path by combining the loaded path with the import path.
-			if !filepath.IsAbs(absPath) {
-				absPath = filepath.Join(filepath.Dir(p.path), absPath)
-			}
-			aliasLen := len(alias)
-
-			// If path doesn't exist, try to find it in the standard library.
-			if _, err := os.Stat(absPath); err != nil {
-				ex, err := os.Executable()
-
-				if err != nil {
-					return nil, err
-				}
-				pathWithoutExt := strings.TrimSuffix(path, filepath.Ext(path))
-				absPathTemp := filepath.Join(filepath.Dir(ex), "std", fmt.Sprintf("%s.tsh", pathWithoutExt)) // Standart library is at <executable-path>/std.
-
-				// If path exists, use it.
-				if _, err := os.Stat(absPath); err != nil {
-					absPath = absPathTemp
-
-					if aliasLen == 0 {
-						alias = filepath.Base(pathWithoutExt)
-					}
-				}
-			} else if aliasLen == 0 {
-				// If it's not a standard library path, an alias must be provided.
-				return nil, fmt.Errorf(`an alias must be provided for the local import "%s" in "%s"`, path, p.path)
-			}
-			importParser := New()
-			importedProg, err := importParser.parse(absPath, true)
-
-			if err != nil {
-				return nil, err
-			}
-
-			if _, exists := ctx.findImport(alias); exists {
-				return nil, fmt.Errorf(`import alias "%s" already exists`, alias)
-			}
-			err = ctx.addImport(alias, importParser.prefix)
-
-			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
-			statementsTemp = append(statementsTemp, importedProg.Body()...)
+			importedStatements, importedPositions, err := p.evaluateImportedFile(imp, ctx)
 
-			// Import-parser funcs with current parser funcs.
-			for funcName, usedFuncs := range importParser.usedFuncs {
-				if foundUsedFuncs, exists := p.usedFuncs[funcName]; !exists {
-					p.usedFuncs[funcName] = usedFuncs
-				} else {
-					for _, usedFunc := range foundUsedFuncs {
-						if !slices.Contains(foundUsedFuncs, usedFunc) {
-							p.usedFuncs[funcName] = append(p.usedFuncs[funcName], usedFunc)
-						}
-					}
-				}
+			// Import errors (e.g. a missing alias) are recorded and parsing continues with the next import.
+			if err != nil && !p.addError(err, imp.token) {
+				return nil, nil, errParsingStopped
 			}
+			statementsTemp = append(statementsTemp, importedStatements...)
+			positionsTemp = append(positionsTemp, importedPositions...)
 
 			nextToken = p.peek()
 			nextTokenType := nextToken.Type()
@@ -732,14 +881,15 @@ func (p *Parser) evaluateImports(ctx context) ([]Statement, error) {
 			} else if slices.Contains([]lexer.TokenType{lexer.IDENTIFIER, lexer.STRING_LITERAL}, nextTokenType) {
 				// Nothing to do, parse next import in the next cycle.
 			} else {
-				return nil, p.expectedError(`")"`, nextToken)
+				return nil, nil, p.expectedError(`")"`, nextToken)
 			}
 		}
 	}
 	statements := []Statement{}
+	positions := []Position{}
 
 	// Add functions add variables.
-	for _, statement := range statementsTemp {
+	for i, statement := range statementsTemp {
 		exists := false
 
 		switch statement.StatementType() {
@@ -760,18 +910,111 @@ func (p *Parser) evaluateImports(ctx context) ([]Statement, error) {
 			if _, exists = ctx.functions[name]; !exists && definedFunction.Public() {
 				ctx.functions[name] = definedFunction
 			}
+		case STATEMENT_TYPE_STRUCT_DEFINITION:
+			definedStruct := statement.(StructDefinition)
+			name := definedStruct.Name()
+
+			if _, exists = ctx.structs[name]; !exists && definedStruct.Public() {
+				ctx.structs[name] = definedStruct.ValueType()
+			}
 		}
 
 		// Prevent code duplication.
 		if !exists {
 			statements = append(statements, statement)
+			positions = append(positions, positionsTemp[i])
+		}
+	}
+	return statements, positions, nil
+}
+
+// evaluateImportedFile parses the imported file and adds its public definitions to the context.
+func (p *Parser) evaluateImportedFile(imp evaluatedImport, ctx context) ([]Statement, []Position, error) {
+	path := imp.path
+	alias := imp.alias
+	absPath := path
+
+	// If path is relative, create an absolute path by combining the loaded path with the import path.
+	if !filepath.IsAbs(absPath) {
+		absPath = filepath.Join(filepath.Dir(p.path), absPath)
+	}
+	aliasLen := len(alias)
+
+	// If path doesn't exist, try to find it in the standard library.
+	if _, err := os.Stat(absPath); err != nil {
+		ex, err := os.Executable()
+
+		if err != nil {
+			return nil, nil, err
+		}
+		pathWithoutExt := strings.TrimSuffix(path, filepath.Ext(path))
+		absPathTemp := filepath.Join(filepath.Dir(ex), "std", fmt.Sprintf("%s.tsh", pathWithoutExt)) // Standart library is at <executable-path>/std.
+
+		// If path exists, use it.
+		if _, err := os.Stat(absPath); err != nil {
+			absPath = absPathTemp
+
+			if aliasLen == 0 {
+				alias = filepath.Base(pathWithoutExt)
+			}
+		}
+	} else if aliasLen == 0 {
+		// If it's not a standard library path, an alias must be provided.
+		return nil, nil, p.tokenDiagnostic(fmt.Sprintf(`an alias must be provided for the local import "%s"`, path), imp.token, DIAGNOSTIC_CODE_IMPORT)
+	}
+	importParser := New()
+	importParser.symbols = p.symbols // Share symbol table to collect the symbols of all files.
+	importedProg, err := importParser.parse(absPath, true)
+
+	if err != nil {
+		var diagnostics Diagnostics
+
+		// The errors of the imported file are reported as they are, all other errors (e.g. if the
+		// file doesn't exist) are reported at the import. Parsing continues to find further errors.
+		if !errors.As(err, &diagnostics) {
+			err = p.tokenDiagnostic(err.Error(), imp.token, DIAGNOSTIC_CODE_IMPORT)
+		}
+
+		if !p.addError(err, imp.token) {
+			return nil, nil, errParsingStopped
+		}
+	}
+
+	if _, exists := ctx.findImport(alias); exists {
+		return nil, nil, p.tokenDiagnostic(fmt.Sprintf(`import alias "%s" already exists`, alias), imp.token, DIAGNOSTIC_CODE_IMPORT)
+	}
+	err = ctx.addImport(alias, importParser.prefix)
+
+	if err != nil {
+		return nil, nil, err
+	}
+	p.addSymbol(Symbol{
+		name:       alias,
+		kind:       SYMBOL_KIND_IMPORT,
+		importPath: absPath,
+		global:     true,
+	}, imp.token, ctx)
+
+	// Import-parser funcs with current parser funcs.
+	for funcName, usedFuncs := range importParser.usedFuncs {
+		if foundUsedFuncs, exists := p.usedFuncs[funcName]; !exists {
+			p.usedFuncs[funcName] = usedFuncs
+		} else {
+			for _, usedFunc := range foundUsedFuncs {
+				if !slices.Contains(foundUsedFuncs, usedFunc) {
+					p.usedFuncs[funcName] = append(p.usedFuncs[funcName], usedFunc)
+				}
+			}
 		}
 	}
-	return statements, nil
+
+	maps.Copy(p.errorResults, importParser.errorResults)
+	return importedProg.Body(), importedProg.Positions(), nil
 }
 
 func (p *Parser) evaluateImport() (evaluatedImport, error) {
 	nextToken := p.eat()
+	token := nextToken
 	var alias string
 
 	if nextToken.Type() == lexer.IDENTIFIER {
@@ -791,6 +1034,7 @@ func (p *Parser) evaluateImport() (evaluatedImport, error) {
 	return evaluatedImport{
 		alias,
 		path,
+		token,
 	}, nil
 }
 
@@ -808,18 +1052,17 @@ func (p *Parser) evaluateBlockBegin() error {
 	return nil
 }
 
-func (p *Parser) evaluateBlockContent(terminationTokenTypes []lexer.TokenType, callback blockCallback, ctx context, scope scope) ([]Statement, error) {
+func (p *Parser) evaluateBlockContent(terminationTokenTypes []lexer.TokenType, callback blockCallback, ctx context, scope scope) ([]Statement, []Position, error) {
 	var err error
 
 	statements := []Statement{}
+	positions := []Position{}
 	loop := true
 	callCallback := func() error {
-		errTemp := err
-
-		if errTemp == nil && callback != nil {
-			errTemp = callback(statements, !loop)
+		if callback != nil {
+			return callback(statements, !loop)
 		}
-		return errTemp
+		return nil
 	}
 
 	// Clone context to avoid modification of the original.
@@ -831,6 +1074,8 @@ func (p *Parser) evaluateBlockContent(terminationTokenTypes []lexer.TokenType, c
 	for loop {
 		token := p.peek()
 		tokenType := token.Type()
+		startIndex := p.index
+		currFunc := p.currFunc
 		var stmt Statement
 
 		if slices.Contains(terminationTokenTypes, tokenType) {
@@ -839,6 +1084,10 @@ func (p *Parser) evaluateBlockContent(terminationTokenTypes []lexer.TokenType, c
 			switch tokenType {
 			case lexer.NEWLINE:
 				// Ignore termination tokens as they are handled after the switch.
+			case lexer.EOF:
+				// The file ended before the block has been terminated, there's nothing left to recover.
+				p.addError(p.expectedError("termination token", token), token)
+				return statements, positions, errParsingStopped
 			default:
 				stmt, err = p.evaluateStatement(ctx)
 				prefix := p.prefix
@@ -852,44 +1101,50 @@ func (p *Parser) evaluateBlockContent(terminationTokenTypes []lexer.TokenType, c
 				case STATEMENT_TYPE_VAR_DEFINITION:
 					// Store new variable.
 					err = ctx.addVariables(prefix, global, stmt.(VariableDefinition).Variables()...)
-
-					if err != nil {
-						return nil, err
-					}
 				case STATEMENT_TYPE_VAR_DEFINITION_CALL_ASSIGNMENT:
 					// Store new variable.
 					err = ctx.addVariables(prefix, global, stmt.(VariableDefinitionCallAssignment).Variables()...)
-
-					if err != nil {
-						return nil, err
-					}
 				case STATEMENT_TYPE_FUNCTION_DEFINITION:
 					// Store new function.
 					err = ctx.addFunctions(prefix, global, stmt.(FunctionDefinition))
-
-					if err != nil {
-						return nil, err
-					}
+				case STATEMENT_TYPE_STRUCT_DEFINITION:
+					// Store new struct.
+					err = ctx.addStructs(prefix, global, stmt.(StructDefinition))
 				}
 			}
 		}
 
 		if err != nil {
-			break
+			if !p.addError(err, token) {
+				return statements, positions, errParsingStopped
+			}
+			// Skip the erroneous statement and continue with the next one to report as many errors as possible.
+			err = nil
+			p.index = startIndex
+			p.currFunc = currFunc
+			p.skipStatement(terminationTokenTypes)
+			continue
 		}
 
 		if !loop {
 			err = callCallback()
+
+			if err != nil && !p.addError(err, token) {
+				return statements, positions, errParsingStopped
+			}
 			break
 		}
 
 		if stmt != nil {
+			p.collectFindings(statements, stmt, startIndex)
 			statements = append(statements, stmt)
+			positions = append(positions, p.position(token))
 			err = callCallback()
 
-			if err != nil {
-				break
+			if err != nil && !p.addError(err, token) {
+				return statements, positions, errParsingStopped
 			}
+			err = nil
 		}
 		terminationToken := p.peek()
 
@@ -897,11 +1152,43 @@ func (p *Parser) evaluateBlockContent(terminationTokenTypes []lexer.TokenType, c
 		if terminationToken.Type() == lexer.NEWLINE {
 			p.eat()
 		} else if !slices.Contains(terminationTokenTypes, terminationToken.Type()) {
-			err = p.expectedError("termination token", terminationToken)
-			break
+			if !p.addError(p.expectedError("termination token", terminationToken), terminationToken) {
+				return statements, positions, errParsingStopped
+			}
+			p.skipStatement(terminationTokenTypes)
+		}
+	}
+	return statements, positions, nil
+}
+
+// skipStatement skips all tokens up to the end of the current statement (a newline or a termination token
+// outside of curly brackets). This allows to continue parsing after an error.
+func (p *Parser) skipStatement(terminationTokenTypes []lexer.TokenType) {
+	depth := 0
+
+	for {
+		token := p.peek()
+		tokenType := token.Type()
+
+		if tokenType == lexer.EOF {
+			return
+		}
+		if depth == 0 && slices.Contains(terminationTokenTypes, tokenType) {
+			return
+		}
+		p.eat()
+
+		switch tokenType {
+		case lexer.OPENING_CURLY_BRACKET:
+			depth++
+		case lexer.CLOSING_CURLY_BRACKET:
+			depth = max(depth-1, 0) // Ignore unmatched brackets.
+		case lexer.NEWLINE:
+			if depth == 0 {
+				return
+			}
 		}
 	}
-	return statements, err
 }
 
 func (p *Parser) evaluateBlockEnd() error {
@@ -913,31 +1200,32 @@ func (p *Parser) evaluateBlockEnd() error {
 	return nil
 }
 
-func (p *Parser) evaluateBlock(callback blockCallback, ctx context, scope scope) ([]Statement, error) {
+func (p *Parser) evaluateBlock(callback blockCallback, ctx context, scope scope) ([]Statement, []Position, error) {
 	err := p.evaluateBlockBegin()
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	statements, err := p.evaluateBlockContent([]lexer.TokenType{lexer.CLOSING_CURLY_BRACKET}, callback, ctx, scope)
+	statements, positions, err := p.evaluateBlockContent([]lexer.TokenType{lexer.CLOSING_CURLY_BRACKET}, callback, ctx, scope)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	err = p.evaluateBlockEnd()
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return statements, nil
+	return statements, positions, nil
 }
 
-func (p *Parser) evaluateValueType() (ValueType, error) {
+func (p *Parser) evaluateValueType(ctx context) (ValueType, error) {
 	nextToken := p.peek()
 	evaluatedType := NewValueType(DATA_TYPE_UNKNOWN, false)
+	sliceDepth := 0
 
-	// Evaluate if value type is a slice type.
-	if nextToken.Type() == lexer.OPENING_SQUARE_BRACKET {
+	// Evaluate if value type is a (multi-dimensional) slice type.
+	for nextToken.Type() == lexer.OPENING_SQUARE_BRACKET {
 		p.eat()             // Eat opening square bracket.
 		nextToken = p.eat() // Eat closing square bracket.
 
@@ -945,23 +1233,127 @@ func (p *Parser) evaluateValueType() (ValueType, error) {
 			return evaluatedType, p.expectedError(`"]"`, nextToken)
 		}
 		nextToken = p.peek()
-		evaluatedType.isSlice = true
+		sliceDepth++
 	}
 
 	// Evaluate data type.
-	if nextToken.Type() != lexer.DATA_TYPE {
+	switch nextToken.Type() {
+	case lexer.DATA_TYPE:
+		p.eat() // Eat data type token.
+		dataType, exists := typeMapping[nextToken.Value()]
+
+		if !exists {
+			return evaluatedType, p.expectedError("valid data type", nextToken)
+		}
+		evaluatedType.dataType = dataType
+	case lexer.IDENTIFIER:
+		structType, err := p.evaluateStructType(ctx)
+
+		if err != nil {
+			return evaluatedType, err
+		}
+		evaluatedType = structType
+	case lexer.MAP:
+		mapType, err := p.evaluateMapType(ctx)
+
+		if err != nil {
+			return evaluatedType, err
+		}
+		evaluatedType = mapType
+	default:
 		return evaluatedType, p.expectedError("data type", nextToken)
 	}
-	p.eat() // Eat data type token.
-	dataType, exists := typeMapping[nextToken.Value()]
 
-	if !exists {
-		return evaluatedType, p.expectedError("valid data type", nextToken)
+	if sliceDepth > 0 {
+		// Map elements would require a map per slice element which is not supported.
+		if evaluatedType.IsMap() {
+			return evaluatedType, p.atError("slices of maps are not supported", nextToken)
+		}
+
+		// Slices of structs are stored as one slice per field, therefore fields must not be slices themselves.
+		if evaluatedType.containsSlice() {
+			return evaluatedType, p.atError(fmt.Sprintf("slices of %s are not supported because it contains slices", evaluatedType.String()), nextToken)
+		}
+
+		// For the same reason, the elements of a slice of structs must not be slices either.
+		if evaluatedType.IsStruct() && sliceDepth > 1 {
+			return evaluatedType, p.atError(fmt.Sprintf("multi-dimensional slices of %s are not supported", evaluatedType.String()), nextToken)
+		}
+	}
+
+	for i := 0; i < sliceDepth; i++ {
+		evaluatedType = evaluatedType.SliceType()
 	}
-	evaluatedType.dataType = dataType
 	return evaluatedType, nil
 }
 
+func (p *Parser) evaluateMapType(ctx context) (ValueType, error) {
+	mapToken := p.eat()
+
+	if mapToken.Type() != lexer.MAP {
+		return ValueType{}, p.expectedKeywordError("map", mapToken)
+	}
+	nextToken := p.eat()
+
+	if nextToken.Type() != lexer.OPENING_SQUARE_BRACKET {
+		return ValueType{}, p.expectedError(`"["`, nextToken)
+	}
+	keyToken := p.peek()
+	keyValueType, err := p.evaluateValueType(ctx)
+
+	if err != nil {
+		return ValueType{}, err
+	}
+
+	// Only basic types can be used as keys.
+	if !keyValueType.IsBool() && !keyValueType.IsInt() && !keyValueType.IsString() {
+		return ValueType{}, p.atError(fmt.Sprintf("invalid map key type %s", keyValueType.String()), keyToken)
+	}
+	nextToken = p.eat()
+
+	if nextToken.Type() != lexer.CLOSING_SQUARE_BRACKET {
+		return ValueType{}, p.expectedError(`"]"`, nextToken)
+	}
+	valueValueType, err := p.evaluateValueType(ctx)
+
+	if err != nil {
+		return ValueType{}, err
+	}
+	return NewMapValueType(keyValueType, valueValueType), nil
+}
+
+func (p *Parser) evaluateStructType(ctx context) (ValueType, error) {
+	nameToken := p.eat()
+
+	if nameToken.Type() != lexer.IDENTIFIER {
+		return ValueType{}, p.expectedError("struct name", nameToken)
+	}
+	name := nameToken.Value()
+	prefix := p.prefix
+	dotedName := name
+
+	// If next token is a dot, it's an imported struct.
+	if p.peek().Type() == lexer.DOT {
+		p.addReference(SYMBOL_KIND_IMPORT, nameToken, p.prefix, true, ctx)
+		p.eat()
+		prefix = name
+		nameToken = p.eat()
+
+		if nameToken.Type() != lexer.IDENTIFIER {
+			return ValueType{}, p.expectedError("struct name", nameToken)
+		}
+		name = nameToken.Value()
+		dotedName = fmt.Sprintf("%s.%s", prefix, name)
+	}
+	valueType, exists := ctx.findStruct(name, prefix)
+
+	if !exists {
+		return ValueType{}, p.atError(fmt.Sprintf("struct %s has not been defined", dotedName), nameToken)
+	}
+	p.addReference(SYMBOL_KIND_STRUCT, nameToken, prefix, true, ctx)
+	return valueType, nil
+}
+
 func (p *Parser) evaluateVarDefinition(ctx context) (Statement, error) {
 	// Possible variable declarations/definitions:
 	// var v int
@@ -1024,8 +1416,8 @@ func (p *Parser) evaluateVarDefinition(ctx context) (Statement, error) {
 		nextToken := p.peek()
 
 		// If next token starts a type definition, evaluate value type.
-		if slices.Contains([]lexer.TokenType{lexer.DATA_TYPE, lexer.OPENING_SQUARE_BRACKET}, nextToken.Type()) {
-			specifiedTypeTemp, err := p.evaluateValueType()
+		if slices.Contains([]lexer.TokenType{lexer.DATA_TYPE, lexer.OPENING_SQUARE_BRACKET, lexer.IDENTIFIER, lexer.MAP}, nextToken.Type()) {
+			specifiedTypeTemp, err := p.evaluateValueType(ctx)
 
 			if err != nil {
 				return nil, err
@@ -1075,6 +1467,7 @@ func (p *Parser) evaluateVarDefinition(ctx context) (Statement, error) {
 		if err != nil {
 			return nil, err
 		}
+		evaluatedVals = evaluatedVals.withLookup(len(nameTokens))
 		values = evaluatedVals.values
 		valuesTypes := []ValueType{}
 		isMultiReturnFuncCall, call := evaluatedVals.isMultiReturnCall()
@@ -1108,7 +1501,7 @@ func (p *Parser) evaluateVarDefinition(ctx context) (Statement, error) {
 		if specifiedType.DataType() != DATA_TYPE_UNKNOWN {
 			for _, valueType := range valuesTypes {
 				if !valueType.Equals(specifiedType) {
-					return nil, p.expectedError(fmt.Sprintf("%s but got %s", specifiedType.String(), valueType.String()), nextToken)
+					return nil, p.mismatchError(fmt.Sprintf("%s but got %s", specifiedType.String(), valueType.String()), nextToken)
 				}
 			}
 		}
@@ -1121,12 +1514,14 @@ func (p *Parser) evaluateVarDefinition(ctx context) (Statement, error) {
 			if variableValueType.DataType() == DATA_TYPE_UNKNOWN {
 				variables[i].valueType = valueValueType // Use index here to make sure the original variable is modified, not the copy.
 			} else if !variableValueType.Equals(valueValueType) {
-				return nil, p.expectedError(fmt.Sprintf("%s but got %s for variable %s", variableValueType.String(), valueValueType.String(), variable.Name()), nextToken)
+				return nil, p.mismatchError(fmt.Sprintf("%s but got %s for variable %s", variableValueType.String(), valueValueType.String(), variable.Name()), nextToken)
 			}
 		}
 
 		// If it's a function call multi assignment, build return value here.
 		if isMultiReturnFuncCall {
+			p.addVariableSymbols(nameTokens, variables, ctx)
+
 			call := VariableDefinitionCallAssignment{
 				variables,
 				call,
@@ -1146,6 +1541,8 @@ func (p *Parser) evaluateVarDefinition(ctx context) (Statement, error) {
 			values = append(values, value)
 		}
 	}
+	p.addVariableSymbols(nameTokens, variables, ctx)
+
 	variable := VariableDefinition{
 		variables,
 		values,
@@ -1153,6 +1550,24 @@ func (p *Parser) evaluateVarDefinition(ctx context) (Statement, error) {
 	return variable, nil
 }
 
+// addVariableSymbols adds the symbols of newly defined variables. Variables which are re-defined via the
+// short init operator are added as references.
+func (p *Parser) addVariableSymbols(nameTokens []lexer.Token, variables []Variable, ctx context) {
+	global := ctx.global()
+
+	for i, nameToken := range nameTokens {
+		if _, exists := ctx.findVariable(nameToken.Value(), p.prefix, global); exists {
+			p.addReference(SYMBOL_KIND_VARIABLE, nameToken, p.prefix, global, ctx)
+		} else {
+			p.addSymbol(Symbol{
+				kind:      SYMBOL_KIND_VARIABLE,
+				valueType: variables[i].ValueType(),
+				global:    global,
+			}, nameToken, ctx)
+		}
+	}
+}
+
 func (p *Parser) evaluateCompoundAssignment(ctx context) (Statement, error) {
 	nameTokens, err := p.evaluateVarNames()
 
@@ -1202,17 +1617,18 @@ func (p *Parser) evaluateCompoundAssignment(ctx context) (Statement, error) {
 	if !exists {
 		return nil, p.atError(fmt.Sprintf("variable %s has not been defined", name), nameToken)
 	}
+	p.addReference(SYMBOL_KIND_VARIABLE, nameToken, p.prefix, ctx.global(), ctx)
 	valueType := valuesTypes[0]
 	expectedValueType := definedVariable.ValueType()
 
-	if valueType != expectedValueType {
-		return nil, p.expectedError(fmt.Sprintf("%s but got %s", expectedValueType.String(), valueType.String()), valuesToken)
+	if !valueType.Equals(expectedValueType) {
+		return nil, p.mismatchError(fmt.Sprintf("%s but got %s", expectedValueType.String(), valueType.String()), valuesToken)
 	}
 	assignOperator := assignToken.Value()
 	binaryOperator := string(assignOperator[0])
 
 	if !slices.Contains(allowedBinaryOperators(valueType), binaryOperator) {
-		return nil, p.expectedError(fmt.Sprintf(`valid %s compound assign operator but got "%s"`, valueType.String(), assignOperator), assignToken)
+		return nil, p.mismatchError(fmt.Sprintf(`valid %s compound assign operator but got "%s"`, valueType.String(), assignOperator), assignToken)
 	}
 	return VariableAssignment{
 		variables: []Variable{definedVariable},
@@ -1244,6 +1660,7 @@ func (p *Parser) evaluateVarAssignment(ctx context) (Statement, error) {
 	if err != nil {
 		return nil, err
 	}
+	evaluatedVals = evaluatedVals.withLookup(len(nameTokens))
 	isMultiReturnFuncCall, call := evaluatedVals.isMultiReturnCall()
 	valuesTypes := []ValueType{}
 
@@ -1273,13 +1690,14 @@ func (p *Parser) evaluateVarAssignment(ctx context) (Statement, error) {
 		if !exists {
 			return nil, p.atError(fmt.Sprintf("variable %s has not been defined", name), nameToken)
 		}
+		p.addReference(SYMBOL_KIND_VARIABLE, nameToken, p.prefix, ctx.global(), ctx)
 		valueType := valuesTypes[i]
 		expectedValueType := definedVariable.ValueType()
 
-		if valueType != expectedValueType {
-			return nil, p.expectedError(fmt.Sprintf("%s but got %s", expectedValueType.String(), valueType.String()), valuesToken)
+		if !valueType.Equals(expectedValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s but got %s", expectedValueType.String(), valueType.String()), valuesToken)
 		}
-		variables = append(variables, NewVariable(name, valueType, ctx.global(), isPublic(name)))
+		variables = append(variables, definedVariable)
 	}
 
 	if isMultiReturnFuncCall {
@@ -1288,14 +1706,27 @@ func (p *Parser) evaluateVarAssignment(ctx context) (Statement, error) {
 			call,
 		}, nil
 	}
-	return VariableAssignment{
-		variables: variables,
-		values:    evaluatedVals.values,
-	}, nil
-}
+	values := evaluatedVals.values
+
+	// If a slice is appended to itself (e.g. s = append(s, 1)), the values can be appended in place
+	// because the original slice is replaced anyway.
+	if len(variables) == 1 {
+		if appendExpr, ok := values[0].(Append); ok {
+			if evaluation, ok := appendExpr.Value().(VariableEvaluation); ok && evaluation.Name() == variables[0].Name() && evaluation.Global() == variables[0].Global() {
+				appendExpr.inPlace = true
+				values[0] = appendExpr
+			}
+		}
+	}
+	return VariableAssignment{
+		variables: variables,
+		values:    values,
+	}, nil
+}
 
-func (p *Parser) evaluateParams(ctx context) ([]Variable, error) {
+func (p *Parser) evaluateParams(ctx context) ([]Variable, bool, error) {
 	params := []Variable{}
+	variadic := false
 
 	for {
 		nameToken := p.peek()
@@ -1306,7 +1737,7 @@ func (p *Parser) evaluateParams(ctx context) ([]Variable, error) {
 			break
 		}
 		if nameTokenType != lexer.IDENTIFIER {
-			return params, p.expectedError("parameter name", nameToken)
+			return params, false, p.expectedError("parameter name", nameToken)
 		}
 		p.eat()
 
@@ -1314,24 +1745,52 @@ func (p *Parser) evaluateParams(ctx context) ([]Variable, error) {
 		_, exists := ctx.findVariable(name, p.prefix, false)
 
 		if exists {
-			return params, fmt.Errorf("scope already contains a variable with the name %s", name)
+			return params, false, fmt.Errorf("scope already contains a variable with the name %s", name)
+		}
+		ellipsisToken := p.peek()
+
+		// If the type is preceded by an ellipsis, the parameter is variadic.
+		if ellipsisToken.Type() == lexer.ELLIPSIS {
+			p.eat()
+			variadic = true
 		}
-		valueType, err := p.evaluateValueType()
+		valueType, err := p.evaluateValueType(ctx)
 
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		nextToken := p.peek()
 		nextTokenType := nextToken.Type()
 
 		if nextTokenType != lexer.COMMA && nextTokenType != lexer.CLOSING_ROUND_BRACKET {
-			return params, p.expectedError(`"," or ")"`, nextToken)
+			return params, false, p.expectedError(`"," or ")"`, nextToken)
 		} else if nextTokenType == lexer.COMMA {
 			p.eat()
 		}
+
+		if variadic {
+			// Only the final parameter can be variadic.
+			if nextTokenType != lexer.CLOSING_ROUND_BRACKET {
+				return nil, false, p.atError("can only use ... with final parameter", ellipsisToken)
+			}
+			// The same restrictions as for slice types apply (see evaluateValueType).
+			if valueType.IsMap() {
+				return nil, false, p.atError("slices of maps are not supported", ellipsisToken)
+			} else if valueType.containsSlice() {
+				return nil, false, p.atError(fmt.Sprintf("slices of %s are not supported because it contains slices", valueType.String()), ellipsisToken)
+			} else if valueType.IsStructSlice() {
+				return nil, false, p.atError(fmt.Sprintf("multi-dimensional slices of %s are not supported", valueType.ElementType().String()), ellipsisToken)
+			}
+			valueType = valueType.SliceType()
+		}
 		params = append(params, NewVariable(name, valueType, false, false))
+
+		p.addSymbol(Symbol{
+			kind:      SYMBOL_KIND_PARAMETER,
+			valueType: valueType,
+		}, nameToken, ctx)
 	}
-	return params, nil
+	return params, variadic, nil
 }
 
 func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
@@ -1358,6 +1817,7 @@ func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
 	}
 	openingBrace := p.peek()
 	params := []Variable{}
+	variadic := false
 
 	// Clone context to avoid modification of the original.
 	ctx = ctx.clone()
@@ -1366,13 +1826,14 @@ func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
 	maps.DeleteFunc(ctx.variables, func(_ string, v Variable) bool {
 		return !v.Global()
 	})
+	ctx.symbols = map[string]Symbol{} // The context only holds local symbols (see addSymbol).
 
 	// If no parameters are given, the brackets are optional.
 	if openingBrace.Type() == lexer.OPENING_ROUND_BRACKET {
 		var err error
 
 		p.eat()
-		params, err = p.evaluateParams(ctx)
+		params, variadic, err = p.evaluateParams(ctx)
 
 		if err != nil {
 			return nil, err
@@ -1386,6 +1847,7 @@ func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
 	returnTypeToken := p.peek()
 	multiple := false
 	returnTypes := []ValueType{}
+	errorResults := []int{}
 
 	if returnTypeToken.Type() == lexer.OPENING_ROUND_BRACKET {
 		p.eat()
@@ -1395,12 +1857,17 @@ func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
 
 	for {
 		// Check if a return type has been specified.
-		if slices.Contains([]lexer.TokenType{lexer.DATA_TYPE, lexer.OPENING_SQUARE_BRACKET}, returnTypeToken.Type()) {
-			returnTypeTemp, err := p.evaluateValueType()
+		if slices.Contains([]lexer.TokenType{lexer.DATA_TYPE, lexer.OPENING_SQUARE_BRACKET, lexer.IDENTIFIER, lexer.MAP}, returnTypeToken.Type()) {
+			returnTypeTemp, err := p.evaluateValueType(ctx)
 
 			if err != nil {
 				return nil, err
 			}
+
+			// Errors are strings internally, therefore remember which results have been declared as error.
+			if returnTypeToken.Value() == string(lexer.DATA_TYPE_ERROR) {
+				errorResults = append(errorResults, len(returnTypes))
+			}
 			returnTypes = append(returnTypes, returnTypeTemp)
 		}
 
@@ -1418,6 +1885,16 @@ func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
 		returnTypeToken = p.peek()
 	}
 
+	// The main function is called by the program, therefore it can't have parameters and it can only return the exit code.
+	if name == mainFunction && len(p.prefix) == 0 {
+		if len(params) > 0 {
+			return nil, p.atError("function main must not have parameters", nameToken)
+		}
+		if len(returnTypes) > 1 || (len(returnTypes) == 1 && !returnTypes[0].IsInt()) {
+			return nil, p.atError("function main must not return a value or must return an int", nameToken)
+		}
+	}
+
 	// Add parameters to variables.
 	for _, param := range params {
 		err := ctx.addVariables(p.prefix, false, param)
@@ -1427,11 +1904,33 @@ func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
 		}
 	}
 	prefixedName := buildPrefixedName(p.prefix, name)
+	p.errorResults[prefixedName] = errorResults
+
+	p.addSymbol(Symbol{
+		kind:        SYMBOL_KIND_FUNCTION,
+		params:      params,
+		returnTypes: returnTypes,
+		variadic:    variadic,
+		global:      true,
+	}, nameToken, ctx)
+
+	// Add function to its own context to allow recursive calls.
+	err := ctx.addFunctions(p.prefix, true, FunctionDefinition{
+		name:        prefixedName,
+		returnTypes: returnTypes,
+		params:      params,
+		variadic:    variadic,
+		public:      isPublic(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
 
 	// Make sure sub-statements know in which function they are currently in.
 	p.currFunc = prefixedName
 
-	statements, err := p.evaluateBlock(func(statements []Statement, last bool) error {
+	statements, positions, err := p.evaluateBlock(func(statements []Statement, last bool) error {
 		var errTemp error
 		var lastStatement Statement
 		length := len(statements)
@@ -1475,11 +1974,117 @@ func (p *Parser) evaluateFunctionDefinition(ctx context) (Statement, error) {
 		name:        prefixedName,
 		returnTypes: returnTypes,
 		params:      params,
+		variadic:    variadic,
 		body:        statements,
+		positions:   positions,
 		public:      isPublic(name),
 	}, nil
 }
 
+func (p *Parser) evaluateStructDefinition(ctx context) (Statement, error) {
+	typeToken := p.eat()
+
+	if !ctx.global() {
+		return nil, p.expectedError("type definition at top level", typeToken)
+	}
+	if typeToken.Type() != lexer.TYPE_DEFINITION {
+		return nil, p.expectedKeywordError("type", typeToken)
+	}
+	nameToken := p.eat()
+
+	if nameToken.Type() != lexer.IDENTIFIER {
+		return nil, p.expectedError("type name", nameToken)
+	}
+	name := nameToken.Value()
+
+	// Make sure no struct exists with the same name.
+	_, exists := ctx.findStruct(name, p.prefix)
+
+	if exists {
+		return nil, p.expectedError("unique type name", nameToken)
+	}
+	nextToken := p.eat()
+
+	if nextToken.Type() != lexer.STRUCT {
+		return nil, p.expectedKeywordError("struct", nextToken)
+	}
+	nextToken = p.eat()
+
+	if nextToken.Type() != lexer.OPENING_CURLY_BRACKET {
+		return nil, p.expectedError(`"{"`, nextToken)
+	}
+	fields := []StructField{}
+
+	for {
+		p.skipNewlines()
+		nextToken = p.peek()
+
+		if nextToken.Type() == lexer.CLOSING_CURLY_BRACKET {
+			p.eat()
+			break
+		}
+		fieldTokens := []lexer.Token{}
+
+		// Evaluate field names (e.g. x, y int).
+		for {
+			fieldToken := p.eat()
+
+			if fieldToken.Type() != lexer.IDENTIFIER {
+				return nil, p.expectedError("field name", fieldToken)
+			}
+			fieldTokens = append(fieldTokens, fieldToken)
+
+			if p.peek().Type() != lexer.COMMA {
+				break
+			}
+			p.eat() // Eat comma token.
+		}
+		valueType, err := p.evaluateValueType(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fieldToken := range fieldTokens {
+			fieldName := fieldToken.Value()
+
+			if slices.ContainsFunc(fields, func(field StructField) bool { return field.Name() == fieldName }) {
+				return nil, p.atError(fmt.Sprintf("field %s has already been defined", fieldName), fieldToken)
+			}
+			fields = append(fields, StructField{
+				name:      fieldName,
+				valueType: valueType,
+			})
+		}
+		nextToken = p.peek()
+
+		if !slices.Contains([]lexer.TokenType{lexer.NEWLINE, lexer.CLOSING_CURLY_BRACKET}, nextToken.Type()) {
+			return nil, p.expectedError(`newline or "}"`, nextToken)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil, p.atError(fmt.Sprintf("struct %s must have at least one field", name), nameToken)
+	}
+	prefixedName := buildPrefixedName(p.prefix, name)
+	valueType := ValueType{
+		dataType: DataType(prefixedName),
+		name:     name,
+		fields:   fields,
+	}
+	p.addSymbol(Symbol{
+		kind:      SYMBOL_KIND_STRUCT,
+		valueType: valueType,
+		global:    true,
+	}, nameToken, ctx)
+
+	return StructDefinition{
+		name:      prefixedName,
+		valueType: valueType,
+		public:    isPublic(name),
+	}, nil
+}
+
 func (p *Parser) evaluateReturn(ctx context) (Statement, error) {
 	returnToken := p.eat()
 
@@ -1576,7 +2181,7 @@ func (p *Parser) evaluateIf(ctx context) (Statement, error) {
 			}
 			condition = expr
 		}
-		statements, err := p.evaluateBlock(nil, ctx, SCOPE_IF)
+		statements, positions, err := p.evaluateBlock(nil, ctx, SCOPE_IF)
 
 		if err != nil {
 			return nil, err
@@ -1588,18 +2193,21 @@ func (p *Parser) evaluateIf(ctx context) (Statement, error) {
 				ifBranch: IfBranch{
 					condition: condition,
 					body:      statements,
+					positions: positions,
 				},
 			}
 		} else {
 			// If condition has not been evaluated, it is the else-branch, otherwise it's an else-if-branch.
 			if !evaluateCondition {
 				ifStatement.elseBranch = Else{
-					body: statements,
+					body:      statements,
+					positions: positions,
 				}
 			} else {
 				ifStatement.elifBranches = append(ifStatement.elifBranches, IfBranch{
 					condition: condition,
 					body:      statements,
+					positions: positions,
 				})
 			}
 		}
@@ -1632,6 +2240,12 @@ func (p *Parser) evaluateSwitch(ctx context) (Statement, error) {
 	if switchExprValueType.IsSlice() {
 		return nil, p.atError("slices are not allowed in switch statements", exprToken)
 	}
+	if switchExprValueType.IsStruct() {
+		return nil, p.atError("structs are not allowed in switch statements", exprToken)
+	}
+	if switchExprValueType.IsMap() {
+		return nil, p.atError("maps are not allowed in switch statements", exprToken)
+	}
 	beginToken := p.eat()
 
 	if beginToken.Type() != lexer.OPENING_CURLY_BRACKET {
@@ -1646,8 +2260,22 @@ func (p *Parser) evaluateSwitch(ctx context) (Statement, error) {
 		ifBranch: IfBranch{
 			condition: BooleanLiteral{false}, // Use a fake if-branch that isn't entered if only a default branch has been set in switch.
 			body:      []Statement{},
+			positions: []Position{},
 		},
 	}
+
+	// Like in Go, the switch expression shall only be evaluated once. Therefore, it's stored in a helper
+	// variable before the cases are compared.
+	if _, ok := switchExpr.(BooleanLiteral); !ok {
+		switchVar := NewVariable(fmt.Sprintf("_sw%d", p.switchCounter), switchExprValueType, false, false)
+		p.switchCounter++
+
+		fakeIf.init = VariableDefinition{
+			variables: []Variable{switchVar},
+			values:    []Expression{switchExpr},
+		}
+		switchExpr = VariableEvaluation{switchVar}
+	}
 	useMock := true
 	nextToken = p.peek()
 	defaultSet := false
@@ -1677,7 +2305,7 @@ func (p *Parser) evaluateSwitch(ctx context) (Statement, error) {
 		if colonToken.Type() != lexer.COLON {
 			return nil, p.expectedError(`":"`, colonToken)
 		}
-		statements, err := p.evaluateBlockContent([]lexer.TokenType{lexer.CASE, lexer.DEFAULT, lexer.CLOSING_CURLY_BRACKET}, nil, ctx, SCOPE_SWITCH)
+		statements, positions, err := p.evaluateBlockContent([]lexer.TokenType{lexer.CASE, lexer.DEFAULT, lexer.CLOSING_CURLY_BRACKET}, nil, ctx, SCOPE_SWITCH)
 
 		if err != nil {
 			return nil, err
@@ -1693,6 +2321,7 @@ func (p *Parser) evaluateSwitch(ctx context) (Statement, error) {
 			ifBranch := IfBranch{
 				condition: NewComparison(switchExpr, COMPARE_OPERATOR_EQUAL, compareExpr),
 				body:      statements,
+				positions: positions,
 			}
 
 			// If fake-if has not been overwritten, overwrite it now.
@@ -1704,7 +2333,8 @@ func (p *Parser) evaluateSwitch(ctx context) (Statement, error) {
 			}
 		} else if !defaultSet {
 			fakeIf.elseBranch = Else{
-				body: statements,
+				body:      statements,
+				positions: positions,
 			}
 			defaultSet = true
 		} else {
@@ -1742,8 +2372,10 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 		if err != nil {
 			return nil, err
 		}
-		indexVarName := nextToken.Value()
+		indexVarToken := nextToken
+		indexVarName := indexVarToken.Value()
 		nextToken = p.peek()
+		valueVarToken := lexer.Token{}
 		valueVarName := ""
 
 		if nextToken.Type() == lexer.COMMA {
@@ -1758,7 +2390,8 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 			if err != nil {
 				return nil, err
 			}
-			valueVarName = nextToken.Value()
+			valueVarToken = nextToken
+			valueVarName = valueVarToken.Value()
 		}
 		nextToken = p.eat()
 		hasNamedVar := len(valueVarName) > 0
@@ -1778,14 +2411,18 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 			return nil, err
 		}
 		iterableValueType := iterableExpression.ValueType()
+
+		if iterableValueType.IsMap() {
+			return p.evaluateMapRange(indexVarToken, valueVarToken, iterableExpression, ctx)
+		}
 		indexVar := NewVariable(indexVarName, NewValueType(DATA_TYPE_INTEGER, false), false, false)
 		var iterableEvaluation Expression
 
 		if iterableValueType.IsSlice() {
 			iterableEvaluation = SliceEvaluation{
-				value:    iterableExpression,
-				index:    VariableEvaluation{indexVar},
-				dataType: iterableValueType.DataType(),
+				value:     iterableExpression,
+				index:     VariableEvaluation{indexVar},
+				valueType: iterableValueType.ElementType(),
 			}
 		} else if iterableValueType.IsString() {
 			iterableEvaluation = StringSubscript{
@@ -1793,13 +2430,14 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 				startIndex: VariableEvaluation{indexVar},
 			}
 		} else {
-			return nil, p.expectedError("slice or string", nextToken)
+			return nil, p.expectedError("slice, map or string", nextToken)
 		}
-		iterableValueType.isSlice = false // Make sure the value var is not a slice.
+		iterableValueType = iterableValueType.ElementType() // Make sure the value var is of the element type.
 		forRangeStatements := []Statement{}
 
 		// Add count variable.
 		ctx.addVariables(p.prefix, false, indexVar)
+		p.addSymbol(Symbol{kind: SYMBOL_KIND_VARIABLE, valueType: indexVar.ValueType()}, indexVarToken, ctx)
 
 		// If no value variable has been provided, there's no need to add it.
 		if hasNamedVar {
@@ -1807,6 +2445,7 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 
 			// Add value variable.
 			ctx.addVariables(p.prefix, false, valueVar)
+			p.addSymbol(Symbol{kind: SYMBOL_KIND_VARIABLE, valueType: iterableValueType}, valueVarToken, ctx)
 
 			forRangeStatements = []Statement{
 				VariableAssignment{
@@ -1826,7 +2465,7 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 			right:    Len{iterableExpression},
 		}
 		increment := incrementDecrementStatement(indexVar, true)
-		statements, err := p.evaluateBlock(nil, ctx, SCOPE_FOR)
+		statements, positions, err := p.evaluateBlock(nil, ctx, SCOPE_FOR)
 
 		if err != nil {
 			return nil, err
@@ -1837,6 +2476,7 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 			condition: condition,
 			increment: increment,
 			body:      append(forRangeStatements, statements...),
+			positions: append(p.repeatPosition(indexVarToken, len(forRangeStatements)), positions...),
 		}
 	} else {
 		var init Statement
@@ -1932,7 +2572,7 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 		if !condition.ValueType().IsBool() {
 			return nil, p.expectedError("boolean expression", conditionToken)
 		}
-		statements, err := p.evaluateBlock(nil, ctx, SCOPE_FOR)
+		statements, positions, err := p.evaluateBlock(nil, ctx, SCOPE_FOR)
 
 		if err != nil {
 			return nil, err
@@ -1942,11 +2582,84 @@ func (p *Parser) evaluateFor(ctx context) (Statement, error) {
 			condition: condition,
 			increment: increment,
 			body:      statements,
+			positions: positions,
 		}
 	}
 	return stmt, nil
 }
 
+func (p *Parser) evaluateMapRange(keyVarToken lexer.Token, valueVarToken lexer.Token, iterable Expression, ctx context) (Statement, error) {
+	// Maps are iterated by collecting their keys upfront and iterating over them. Therefore,
+	// for k, v := range m { ... } is evaluated like the following code.
+	// _rk := <keys of m>
+	// for _ri := 0; _ri < len(_rk); _ri++ { k := _rk[_ri]; v := m[k]; ... }
+	iterableValueType := iterable.ValueType()
+	keyValueType := iterableValueType.MapKeyType()
+	keyVarName := keyVarToken.Value()
+	valueVarName := valueVarToken.Value()
+	keysVar := NewVariable(fmt.Sprintf("_rk%d", p.rangeCounter), keyValueType.SliceType(), false, false)
+	indexVar := NewVariable(fmt.Sprintf("_ri%d", p.rangeCounter), NewValueType(DATA_TYPE_INTEGER, false), false, false)
+	keyVar := NewVariable(keyVarName, keyValueType, false, false)
+	p.rangeCounter++
+
+	// Add key variable.
+	ctx.addVariables(p.prefix, false, keyVar)
+	p.addSymbol(Symbol{kind: SYMBOL_KIND_VARIABLE, valueType: keyValueType}, keyVarToken, ctx)
+
+	forRangeStatements := []Statement{
+		VariableAssignment{
+			variables: []Variable{keyVar},
+			values: []Expression{
+				SliceEvaluation{
+					value:     VariableEvaluation{keysVar},
+					index:     VariableEvaluation{indexVar},
+					valueType: keyValueType,
+				},
+			},
+		},
+	}
+
+	// If no value variable has been provided, there's no need to add it.
+	if len(valueVarName) > 0 {
+		mapValueType := iterableValueType.MapValueType()
+		valueVar := NewVariable(valueVarName, mapValueType, false, false)
+
+		// Add value variable.
+		ctx.addVariables(p.prefix, false, valueVar)
+		p.addSymbol(Symbol{kind: SYMBOL_KIND_VARIABLE, valueType: mapValueType}, valueVarToken, ctx)
+
+		forRangeStatements = append(forRangeStatements, VariableAssignment{
+			variables: []Variable{valueVar},
+			values: []Expression{
+				MapEvaluation{
+					value:     iterable,
+					key:       VariableEvaluation{keyVar},
+					valueType: mapValueType,
+				},
+			},
+		})
+	}
+	statements, positions, err := p.evaluateBlock(nil, ctx, SCOPE_FOR)
+
+	if err != nil {
+		return nil, err
+	}
+	return For{
+		init: VariableAssignment{
+			variables: []Variable{indexVar, keysVar},
+			values:    []Expression{IntegerLiteral{0}, MapKeys{iterable}},
+		},
+		condition: Comparison{
+			left:     VariableEvaluation{indexVar},
+			operator: COMPARE_OPERATOR_LESS,
+			right:    Len{VariableEvaluation{keysVar}},
+		},
+		increment: incrementDecrementStatement(indexVar, true),
+		body:      append(forRangeStatements, statements...),
+		positions: append(p.repeatPosition(keyVarToken, len(forRangeStatements)), positions...),
+	}, nil
+}
+
 func (p *Parser) evaluateVarEvaluation(ctx context) (Expression, error) {
 	identifierToken := p.eat() // Eat identifier token.
 
@@ -1959,6 +2672,8 @@ func (p *Parser) evaluateVarEvaluation(ctx context) (Expression, error) {
 	if !exists {
 		return nil, p.atError(fmt.Sprintf("variable %s has not been defined", name), identifierToken)
 	}
+	p.addReference(SYMBOL_KIND_VARIABLE, identifierToken, p.prefix, ctx.global(), ctx)
+
 	return VariableEvaluation{
 		Variable: variable,
 	}, nil
@@ -1987,14 +2702,26 @@ func (p *Parser) evaluateSingleExpression(ctx context) (Expression, error) {
 		}
 	case lexer.NUMBER_LITERAL:
 		p.eat() // Eat number token.
-		// TODO: Implement float handling.
-		integer, err := strconv.Atoi(value)
 
-		if err != nil {
-			return nil, err
-		}
-		expr = IntegerLiteral{
-			value: integer,
+		// Numbers with a decimal point are floats.
+		if strings.Contains(value, ".") {
+			f, err := strconv.ParseFloat(value, 64)
+
+			if err != nil {
+				return nil, err
+			}
+			expr = FloatLiteral{
+				value: f,
+			}
+		} else {
+			integer, err := strconv.Atoi(value)
+
+			if err != nil {
+				return nil, err
+			}
+			expr = IntegerLiteral{
+				value: integer,
+			}
 		}
 	case lexer.NIL_LITERAL:
 		p.eat()                // Eat string token.
@@ -2026,10 +2753,26 @@ func (p *Parser) evaluateSingleExpression(ctx context) (Expression, error) {
 	case lexer.OPENING_SQUARE_BRACKET:
 		expr, err = p.evaluateSliceInstantiation(ctx)
 
+	// Handle map instantiation.
+	case lexer.MAP:
+		expr, err = p.evaluateMapInstantiation(ctx)
+
 	// Handle input.
 	case lexer.INPUT:
 		expr, err = p.evaluateInput(ctx)
 
+	// Handle args.
+	case lexer.ARGS:
+		expr, err = p.evaluateArgs(ctx)
+
+	// Handle environment variables.
+	case lexer.GETENV:
+		expr, err = p.evaluateGetEnv(ctx)
+	case lexer.HASENV:
+		expr, err = p.evaluateHasEnv(ctx)
+	case lexer.ENVIRON:
+		expr, err = p.evaluateEnviron(ctx)
+
 	// Handle read.
 	case lexer.READ:
 		expr, err = p.evaluateRead(ctx)
@@ -2038,10 +2781,30 @@ func (p *Parser) evaluateSingleExpression(ctx context) (Expression, error) {
 	case lexer.COPY:
 		expr, err = p.evaluateCopy(ctx)
 
+	// Handle append.
+	case lexer.APPEND:
+		expr, err = p.evaluateAppend(ctx)
+
 	// Handle itoa.
 	case lexer.ITOA:
 		expr, err = p.evaluateItoa(ctx)
 
+	// Handle atoi.
+	case lexer.ATOI:
+		expr, err = p.evaluateAtoi(ctx)
+
+	// Handle ftoa.
+	case lexer.FTOA:
+		expr, err = p.evaluateFtoa(ctx)
+
+	// Handle sprintf.
+	case lexer.SPRINTF:
+		expr, err = p.evaluateSprintf(ctx)
+
+	// Handle type conversions (e.g. float64(1)).
+	case lexer.DATA_TYPE:
+		expr, err = p.evaluateConversion(ctx)
+
 	// Handle exists.
 	case lexer.EXISTS:
 		expr, err = p.evaluateExists(ctx)
@@ -2057,18 +2820,30 @@ func (p *Parser) evaluateSingleExpression(ctx context) (Expression, error) {
 	// Handle identifiers.
 	case lexer.IDENTIFIER:
 		nextToken := p.peekAt(1)
+		_, isVariable := ctx.findVariable(value, p.prefix, ctx.global())
 
 		// If the current token is an identifier and the next is an opening
-		// round bracket or a dot, it's a function call if the next is an
-		// opening square bracket, it's a slice evaluation, otherwise it's
-		// a variable evaluation.
+		// round bracket, it's a function call. If it's followed by a dot,
+		// it's either a field access (if the identifier is a variable), a
+		// struct instantiation or a function call. Subscripts and field
+		// accesses are handled after the switch.
 		switch nextToken.Type() {
-		case lexer.OPENING_ROUND_BRACKET, lexer.DOT:
+		case lexer.OPENING_ROUND_BRACKET:
 			expr, err = p.evaluateFunctionCall(ctx)
-		case lexer.OPENING_SQUARE_BRACKET:
-			expr, err = p.evaluateSubscript(ctx)
+		case lexer.DOT:
+			if isVariable {
+				expr, err = p.evaluateVarEvaluation(ctx)
+			} else if p.isStructInstantiation(ctx) {
+				expr, err = p.evaluateStructInstantiation(ctx)
+			} else {
+				expr, err = p.evaluateFunctionCall(ctx)
+			}
 		default:
-			expr, err = p.evaluateVarEvaluation(ctx)
+			if !isVariable && p.isStructInstantiation(ctx) {
+				expr, err = p.evaluateStructInstantiation(ctx)
+			} else {
+				expr, err = p.evaluateVarEvaluation(ctx)
+			}
 		}
 
 	default:
@@ -2078,7 +2853,56 @@ func (p *Parser) evaluateSingleExpression(ctx context) (Expression, error) {
 	if err != nil {
 		return nil, err
 	}
-	return expr, nil
+	return p.evaluateSelectors(expr, token, ctx)
+}
+
+func (p *Parser) evaluateSelectors(expr Expression, valueToken lexer.Token, ctx context) (Expression, error) {
+	var err error
+
+	for {
+		nextToken := p.peek()
+
+		switch nextToken.Type() {
+		case lexer.DOT:
+			// Calls without return value can't be accessed.
+			if call, ok := expr.(Call); ok && len(call.ReturnTypes()) == 0 {
+				return expr, nil
+			}
+			valueType := expr.ValueType()
+
+			if !valueType.IsStruct() {
+				return nil, p.mismatchError(fmt.Sprintf("struct but got %s", valueType.String()), nextToken)
+			}
+			p.eat() // Eat dot token.
+			fieldToken := p.eat()
+
+			if fieldToken.Type() != lexer.IDENTIFIER {
+				return nil, p.expectedError("field name", fieldToken)
+			}
+			fieldName := fieldToken.Value()
+			field, exists := valueType.Field(fieldName)
+
+			if !exists {
+				return nil, p.atError(fmt.Sprintf("%s has no field %s", valueType.String(), fieldName), fieldToken)
+			}
+			expr = StructEvaluation{
+				value: expr,
+				field: field,
+			}
+		case lexer.OPENING_SQUARE_BRACKET:
+			// Calls without return value can't be subscripted.
+			if call, ok := expr.(Call); ok && len(call.ReturnTypes()) == 0 {
+				return expr, nil
+			}
+			expr, err = p.evaluateSubscript(expr, valueToken, ctx)
+
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return expr, nil
+		}
+	}
 }
 
 // -----------------------------------------------------------------------------------------------
@@ -2151,6 +2975,8 @@ func (p *Parser) evaluateStatement(ctx context) (Statement, error) {
 		stmt, err = p.evaluateVarDefinition(ctx)
 	case lexer.FUNCTION_DEFINITION:
 		stmt, err = p.evaluateFunctionDefinition(ctx)
+	case lexer.TYPE_DEFINITION:
+		stmt, err = p.evaluateStructDefinition(ctx)
 	case lexer.RETURN:
 		stmt, err = p.evaluateReturn(ctx)
 	case lexer.IF:
@@ -2163,12 +2989,20 @@ func (p *Parser) evaluateStatement(ctx context) (Statement, error) {
 		stmt, err = p.evaluateBreak(ctx)
 	case lexer.CONTINUE:
 		stmt, err = p.evaluateContinue(ctx)
-	case lexer.PRINT:
+	case lexer.PRINT, lexer.PRINTN, lexer.EPRINT, lexer.EPRINTN:
 		stmt, err = p.evaluatePrint(ctx)
 	case lexer.WRITE:
 		stmt, err = p.evaluateWrite(ctx)
 	case lexer.PANIC:
 		stmt, err = p.evaluatePanic(ctx)
+	case lexer.EXIT:
+		stmt, err = p.evaluateExit(ctx)
+	case lexer.SETENV:
+		stmt, err = p.evaluateSetEnv(ctx)
+	case lexer.UNSETENV:
+		stmt, err = p.evaluateUnsetEnv(ctx)
+	case lexer.DELETE:
+		stmt, err = p.evaluateDelete(ctx)
 	default:
 		// Variable initialization also starts with identifier but is a statement (e.g. x := 1234).
 		if p.isShortVarInit() {
@@ -2184,12 +3018,19 @@ func (p *Parser) evaluateStatement(ctx context) (Statement, error) {
 				case lexer.ASSIGN_OPERATOR, lexer.COMMA:
 					stmt, err = p.evaluateVarAssignment(ctx)
 				default:
-					// Handle slice assignment.
+					// Handle slice and struct assignment.
 					variable, exists := ctx.findVariable(token.Value(), p.prefix, ctx.global())
 
-					// If variable has been defined and is a slice, handles slice assignment.
-					if exists && variable.ValueType().IsSlice() {
-						stmt, err = p.evaluateSliceAssignment(ctx)
+					if exists {
+						valueType := variable.ValueType()
+
+						// If variable has been defined and is a struct, a slice of structs or a map, handle
+						// composite assignment. If it's any other slice, handle slice assignment.
+						if valueType.IsStruct() || valueType.IsStructSlice() || valueType.IsMap() {
+							stmt, err = p.evaluateCompositeAssignment(ctx)
+						} else if valueType.IsSlice() {
+							stmt, err = p.evaluateSliceAssignment(ctx)
+						}
 					}
 				}
 			}
@@ -2235,12 +3076,12 @@ func (p *Parser) evaluateBinaryOperation(ctx context, allowedOperators []BinaryO
 		rightType := rightExpression.ValueType()
 
 		if !leftType.Equals(rightType) {
-			return nil, p.expectedError(fmt.Sprintf("same binary operation types but got %s and %s", leftType.String(), rightType.String()), operatorToken)
+			return nil, p.mismatchError(fmt.Sprintf("same binary operation types but got %s and %s", leftType.String(), rightType.String()), operatorToken)
 		}
 		allowedTypeOperators := allowedBinaryOperators(leftType)
 
 		if !slices.Contains(allowedTypeOperators, operator) {
-			return nil, p.expectedError(fmt.Sprintf(`valid %s operator but got "%s"`, leftType.String(), operator), operatorToken)
+			return nil, p.mismatchError(fmt.Sprintf(`valid %s operator but got "%s"`, leftType.String(), operator), operatorToken)
 		}
 		leftExpression = BinaryOperation{
 			left:     leftExpression,
@@ -2274,12 +3115,12 @@ func (p *Parser) evaluateComparison(ctx context) (Expression, error) {
 		rightType := rightExpression.ValueType()
 
 		if !leftType.Equals(rightType) {
-			return nil, p.expectedError(fmt.Sprintf("same comparison types but got %s and %s", leftType.DataType(), rightType.String()), operatorToken)
+			return nil, p.mismatchError(fmt.Sprintf("same comparison types but got %s and %s", leftType.DataType(), rightType.String()), operatorToken)
 		}
 		allowedOperators := allowedCompareOperators(leftType)
 
 		if !slices.Contains(allowedOperators, operator) {
-			return nil, p.expectedError(fmt.Sprintf(`valid %s operator but got "%s"`, leftType.String(), operator), operatorToken)
+			return nil, p.mismatchError(fmt.Sprintf(`valid %s operator but got "%s"`, leftType.String(), operator), operatorToken)
 		}
 		return NewComparison(leftExpression, operator, rightExpression), nil
 	}
@@ -2320,23 +3161,33 @@ func (p *Parser) evaluateLogicalOperation(ctx context, operator LogicalOperator,
 	return leftExpression, nil
 }
 
-func (p *Parser) evaluateArguments(typeName string, name string, params []Variable, ctx context) ([]Expression, error) {
+// evaluateArguments evaluates call arguments. If the last argument is followed by an ellipsis, its
+// slice values are spread and the second return value is true. For variadic functions, arguments
+// which are not spread are packed into a slice for the final parameter.
+func (p *Parser) evaluateArguments(typeName string, name string, params []Variable, variadic bool, ctx context) ([]Expression, bool, error) {
 	var err error
 	openingBraceToken := p.eat()
 
 	if openingBraceToken.Type() != lexer.OPENING_ROUND_BRACKET {
-		return nil, p.expectedError(`"("`, openingBraceToken)
+		return nil, false, p.expectedError(`"("`, openingBraceToken)
 	}
 	nextToken := p.peek()
 	args := []Expression{}
 	ignoreParams := params == nil // If params is nil, arguments will not be checked for length or type.
 	paramsLength := 0
+	spread := false
 
 	if !ignoreParams {
 		paramsLength = len(params)
 	}
+	variadicIndex := paramsLength - 1
 	argsLengthError := func(amount int) error {
-		return fmt.Errorf("%s %s expects %d parameters but got %d", typeName, name, paramsLength, amount)
+		expected := fmt.Sprintf("%d", paramsLength)
+
+		if variadic {
+			expected = fmt.Sprintf("at least %d", variadicIndex)
+		}
+		return fmt.Errorf("%s %s expects %s parameters but got %d", typeName, name, expected, amount)
 	}
 
 	// While next-token is not closing brace, evaluate arguments.
@@ -2346,32 +3197,71 @@ func (p *Parser) evaluateArguments(typeName string, name string, params []Variab
 		expr, err = p.evaluateExpression(ctx)
 
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		args = append(args, expr)
+		ellipsisToken := p.peek()
+
+		// Check if the slice values shall be spread.
+		if ellipsisToken.Type() == lexer.ELLIPSIS {
+			p.eat()
+			spread = true
+			valueType := expr.ValueType()
+
+			if !ignoreParams && !variadic {
+				return nil, false, p.atError(fmt.Sprintf("cannot use ... in call to non-variadic %s %s", typeName, name), ellipsisToken)
+			}
+			if !valueType.IsSlice() {
+				return nil, false, p.mismatchError(fmt.Sprintf("slice but got %s", valueType.String()), argToken)
+			}
+		}
+
+		if ignoreParams {
+			valueType := expr.ValueType()
 
-		if !ignoreParams {
+			// If the values get spread, their type is relevant.
+			if spread {
+				valueType = valueType.ElementType()
+			}
+
+			// Structs are lowered to multiple variables and maps only exist within the script, therefore
+			// they cannot be passed as a single argument. Slices only hold a reference which is meaningless
+			// to a program, therefore they must be spread.
+			if valueType.IsStruct() || valueType.IsMap() || valueType.IsSlice() {
+				return nil, false, p.atError(fmt.Sprintf("%s cannot be passed to %s %s", valueType.String(), typeName, name), argToken)
+			}
+		} else {
 			argsLength := len(args)
 
 			// Make sure arguments have not been exceeded.
-			if argsLength > paramsLength {
-				return nil, argsLengthError(argsLength)
+			if !variadic && argsLength > paramsLength {
+				return nil, false, argsLengthError(argsLength)
 			}
 
 			// Make sure argument type fits parameter type.
 			lastArgsIndex := argsLength - 1
-			param := params[lastArgsIndex]
+			param := params[min(lastArgsIndex, variadicIndex)]
 			lastParamType := param.ValueType()
 			lastArgType := expr.ValueType()
 
+			if variadic && lastArgsIndex >= variadicIndex && !spread {
+				lastParamType = lastParamType.ElementType()
+			} else if spread && lastArgsIndex > variadicIndex {
+				return nil, false, p.atError(fmt.Sprintf("cannot use ... with additional arguments for parameter %s", param.Name()), ellipsisToken)
+			}
+
 			if !lastParamType.Equals(lastArgType) {
-				return nil, p.expectedError(fmt.Sprintf("parameter %s (%s) but got %s", lastParamType.String(), param.Name(), lastArgType.String()), argToken)
+				return nil, false, p.mismatchError(fmt.Sprintf("parameter %s (%s) but got %s", lastParamType.String(), param.Name(), lastArgType.String()), argToken)
 			}
 		}
 		nextToken = p.peek()
 		tokenType := nextToken.Type()
 
-		if !slices.Contains([]lexer.TokenType{lexer.COMMA, lexer.CLOSING_ROUND_BRACKET}, tokenType) {
+		// Spread values must be passed as last argument.
+		if spread && tokenType != lexer.CLOSING_ROUND_BRACKET {
+			err = p.expectedError(`")"`, nextToken)
+			break
+		} else if !slices.Contains([]lexer.TokenType{lexer.COMMA, lexer.CLOSING_ROUND_BRACKET}, tokenType) {
 			err = p.expectedError(`"," or ")"`, nextToken)
 			break
 		} else if tokenType == lexer.COMMA {
@@ -2379,25 +3269,32 @@ func (p *Parser) evaluateArguments(typeName string, name string, params []Variab
 		}
 	}
 
+	if err != nil {
+		return nil, false, err
+	}
+
 	// Check for the appropriate arguments amount.
 	if !ignoreParams {
 		argsLength := len(args)
 
-		if len(args) != paramsLength {
-			return nil, argsLengthError(argsLength)
+		if (variadic && argsLength < variadicIndex) || (!variadic && argsLength != paramsLength) {
+			return nil, false, argsLengthError(argsLength)
 		}
-	}
 
-	if err != nil {
-		return nil, err
+		// Pack the variadic arguments into a slice if no slice has been spread.
+		if variadic && !spread {
+			args = append(args[:variadicIndex:variadicIndex], SliceInstantiation{
+				valueType: params[variadicIndex].ValueType(),
+				values:    args[variadicIndex:],
+			})
+		}
 	}
-
 	closingBraceToken := p.eat()
 
 	if closingBraceToken.Type() != lexer.CLOSING_ROUND_BRACKET {
-		return nil, p.expectedError(`")"`, closingBraceToken)
+		return nil, false, p.expectedError(`")"`, closingBraceToken)
 	}
-	return args, nil
+	return args, spread, nil
 }
 
 func (p *Parser) evaluateFunctionCall(ctx context) (Call, error) {
@@ -2407,6 +3304,7 @@ func (p *Parser) evaluateFunctionCall(ctx context) (Call, error) {
 
 	// If next token is a dot, it's an include-function call.
 	if dotToken.Type() == lexer.DOT {
+		p.addReference(SYMBOL_KIND_IMPORT, nextToken, p.prefix, true, ctx)
 		p.eat()
 		alias = nextToken.Value()
 		nextToken = p.eat()
@@ -2431,7 +3329,8 @@ func (p *Parser) evaluateFunctionCall(ctx context) (Call, error) {
 	if !exists {
 		return nil, p.atError(fmt.Sprintf("function %s has not been defined", dotedName), nextToken)
 	}
-	args, err := p.evaluateArguments("function", dotedName, definedFunction.params, ctx)
+	p.addReference(SYMBOL_KIND_FUNCTION, nextToken, prefix, true, ctx)
+	args, _, err := p.evaluateArguments("function", dotedName, definedFunction.params, definedFunction.Variadic(), ctx)
 
 	if err != nil {
 		return nil, err
@@ -2469,14 +3368,15 @@ func (p *Parser) evaluateAppCall(ctx context) (Call, error) {
 	default:
 		return nil, p.expectedError("program identifier or string literal", nextToken)
 	}
-	args, err := p.evaluateArguments("program", name, nil, ctx)
+	args, spread, err := p.evaluateArguments("program", name, nil, false, ctx)
 
 	if err != nil {
 		return nil, err
 	}
 	call := AppCall{
-		name: name,
-		args: args,
+		name:   name,
+		args:   args,
+		spread: spread,
 	}
 
 	if p.peek().Type() == lexer.PIPE {
@@ -2494,34 +3394,38 @@ func (p *Parser) evaluateAppCall(ctx context) (Call, error) {
 
 func (p *Parser) evaluateSliceInstantiation(ctx context) (Expression, error) {
 	nextToken := p.peek()
-	sliceValueType, err := p.evaluateValueType()
+	sliceValueType, err := p.evaluateValueType(ctx)
 
 	if err != nil {
 		return nil, err
 	}
 	if !sliceValueType.IsSlice() {
-		return nil, p.expectedError(fmt.Sprintf("slice type but got %s", sliceValueType.String()), nextToken)
+		return nil, p.mismatchError(fmt.Sprintf("slice type but got %s", sliceValueType.String()), nextToken)
 	}
-	nextToken = p.eat()
+	return p.evaluateSliceValues(sliceValueType, ctx)
+}
+
+func (p *Parser) evaluateSliceValues(sliceValueType ValueType, ctx context) (Expression, error) {
+	nextToken := p.eat()
 
 	if nextToken.Type() != lexer.OPENING_CURLY_BRACKET {
 		return nil, p.expectedError(`"{"`, nextToken)
 	}
+	p.skipNewlines()
 	nextToken = p.peek()
 	values := []Expression{}
+	sliceElementValueType := sliceValueType.ElementType()
 
 	// Evaluate slice initialization values.
 	if nextToken.Type() != lexer.CLOSING_CURLY_BRACKET {
 		for {
 			valueToken := p.peek()
-			expr, err := p.evaluateExpression(ctx)
+			expr, err := p.evaluateElementValue(sliceElementValueType, ctx)
 
 			if err != nil {
 				return nil, err
 			}
 			valueDataType := expr.ValueType()
-			sliceElementValueType := sliceValueType
-			sliceElementValueType.isSlice = false
 
 			if !valueDataType.Equals(sliceElementValueType) {
 				return nil, p.atError(fmt.Sprintf("%s cannot not be added to %s", valueDataType.String(), sliceElementValueType.String()), valueToken)
@@ -2532,6 +3436,12 @@ func (p *Parser) evaluateSliceInstantiation(ctx context) (Expression, error) {
 
 			if nextTokenType == lexer.COMMA {
 				p.eat()
+				p.skipNewlines()
+
+				// Allow trailing comma before the closing bracket.
+				if p.peek().Type() == lexer.CLOSING_CURLY_BRACKET {
+					break
+				}
 			} else if nextTokenType == lexer.CLOSING_CURLY_BRACKET {
 				break
 			} else {
@@ -2545,64 +3455,162 @@ func (p *Parser) evaluateSliceInstantiation(ctx context) (Expression, error) {
 		return nil, p.expectedError(`"}"`, nextToken)
 	}
 	return SliceInstantiation{
-		dataType: sliceValueType.DataType(),
-		values:   values,
+		valueType: sliceValueType,
+		values:    values,
 	}, nil
 }
 
-func (p *Parser) evaluateSubscript(ctx context) (Expression, error) {
-	var value Expression
-	var err error
-
-	valueToken := p.peek()
-
-	switch valueToken.Type() {
-	case lexer.IDENTIFIER:
-		value, err = p.evaluateVarEvaluation(ctx)
-	case lexer.STRING_LITERAL:
-		value, err = p.evaluateExpression(ctx)
-	default:
-		return nil, p.expectedError("string or variable", valueToken)
+// evaluateElementValue evaluates an element of a slice or map literal. The type of composite
+// elements can be omitted (e.g. []Host{{"a", 1}} or map[string][]int{"a": {1, 2}}).
+func (p *Parser) evaluateElementValue(valueType ValueType, ctx context) (Expression, error) {
+	if p.peek().Type() == lexer.OPENING_CURLY_BRACKET {
+		if valueType.IsStruct() {
+			return p.evaluateStructValues(valueType, ctx)
+		} else if valueType.IsMap() {
+			return p.evaluateMapValues(valueType, ctx)
+		} else if valueType.IsSlice() {
+			return p.evaluateSliceValues(valueType, ctx)
+		}
 	}
+	return p.evaluateExpression(ctx)
+}
+
+func (p *Parser) evaluateMapInstantiation(ctx context) (Expression, error) {
+	nextToken := p.peek()
+	mapValueType, err := p.evaluateValueType(ctx)
 
 	if err != nil {
 		return nil, err
 	}
-	valueType := value.ValueType()
-	isSlice := valueType.IsSlice()
-
-	if !isSlice && valueType.DataType() != DATA_TYPE_STRING {
-		return nil, p.expectedError("slice or string", valueToken)
+	if !mapValueType.IsMap() {
+		return nil, p.mismatchError(fmt.Sprintf("map type but got %s", mapValueType.String()), nextToken)
 	}
-	nextToken := p.eat()
+	return p.evaluateMapValues(mapValueType, ctx)
+}
 
-	if nextToken.Type() != lexer.OPENING_SQUARE_BRACKET {
-		return nil, p.expectedError(`"["`, nextToken)
-	}
-	nextToken = p.peek()
-	startToken := nextToken
-	gotRange := nextToken.Type() == lexer.COLON
-	var startIndex Expression
+func (p *Parser) evaluateMapValues(mapValueType ValueType, ctx context) (Expression, error) {
+	nextToken := p.eat()
 
-	if gotRange {
-		p.eat()
-		startIndex = IntegerLiteral{0}
-		startToken = p.peek()
-	} else {
-		startIndex, err = p.evaluateExpression(ctx)
+	if nextToken.Type() != lexer.OPENING_CURLY_BRACKET {
+		return nil, p.expectedError(`"{"`, nextToken)
 	}
+	keyValueType := mapValueType.MapKeyType()
+	valueValueType := mapValueType.MapValueType()
+	keys := []Expression{}
+	values := []Expression{}
 
-	if err != nil {
-		return nil, err
-	}
-	startIndexValueType := startIndex.ValueType()
+	// Evaluate key-value pairs (e.g. map[string]int{"a": 1, "b": 2}).
+	for {
+		p.skipNewlines()
+		keyToken := p.peek()
 
-	if !startIndexValueType.IsInt() {
-		return nil, p.expectedError(fmt.Sprintf("%s as start-index but got %s", DATA_TYPE_INTEGER, startIndexValueType.String()), startToken)
-	}
-	nextToken = p.peek()
+		if keyToken.Type() == lexer.CLOSING_CURLY_BRACKET {
+			break
+		}
+		key, err := p.evaluateExpression(ctx)
 
-	if nextToken.Type() == lexer.COLON {
+		if err != nil {
+			return nil, err
+		}
+		if keyType := key.ValueType(); !keyType.Equals(keyValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s as key but got %s", keyValueType.String(), keyType.String()), keyToken)
+		}
+		nextToken = p.eat()
+
+		if nextToken.Type() != lexer.COLON {
+			return nil, p.expectedError(`":"`, nextToken)
+		}
+		valueToken := p.peek()
+		value, err := p.evaluateElementValue(valueValueType, ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		if valueType := value.ValueType(); !valueType.Equals(valueValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s as value but got %s", valueValueType.String(), valueType.String()), valueToken)
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+
+		if p.peek().Type() != lexer.COMMA {
+			p.skipNewlines()
+			break
+		}
+		p.eat() // Eat comma token.
+	}
+	nextToken = p.eat()
+
+	if nextToken.Type() != lexer.CLOSING_CURLY_BRACKET {
+		return nil, p.expectedError(`"," or "}"`, nextToken)
+	}
+	return MapInstantiation{
+		valueType: mapValueType,
+		keys:      keys,
+		values:    values,
+	}, nil
+}
+
+func (p *Parser) evaluateSubscript(value Expression, valueToken lexer.Token, ctx context) (Expression, error) {
+	var err error
+	valueType := value.ValueType()
+	isSlice := valueType.IsSlice()
+	isMap := valueType.IsMap()
+
+	if !isSlice && !isMap && valueType.DataType() != DATA_TYPE_STRING {
+		return nil, p.expectedError("slice, map or string", valueToken)
+	}
+	nextToken := p.eat()
+
+	if nextToken.Type() != lexer.OPENING_SQUARE_BRACKET {
+		return nil, p.expectedError(`"["`, nextToken)
+	}
+	nextToken = p.peek()
+
+	// Maps are subscripted by key.
+	if isMap {
+		keyValueType := valueType.MapKeyType()
+		key, err := p.evaluateExpression(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		if keyType := key.ValueType(); !keyType.Equals(keyValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s as key but got %s", keyValueType.String(), keyType.String()), nextToken)
+		}
+		nextToken = p.eat()
+
+		if nextToken.Type() != lexer.CLOSING_SQUARE_BRACKET {
+			return nil, p.expectedError(`"]"`, nextToken)
+		}
+		return MapEvaluation{
+			value:     value,
+			key:       key,
+			valueType: valueType.MapValueType(),
+		}, nil
+	}
+	startToken := nextToken
+	gotRange := nextToken.Type() == lexer.COLON
+	var startIndex Expression
+
+	if gotRange {
+		p.eat()
+		startIndex = IntegerLiteral{0}
+		startToken = p.peek()
+	} else {
+		startIndex, err = p.evaluateExpression(ctx)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	startIndexValueType := startIndex.ValueType()
+
+	if !startIndexValueType.IsInt() {
+		return nil, p.mismatchError(fmt.Sprintf("%s as start-index but got %s", DATA_TYPE_INTEGER, startIndexValueType.String()), startToken)
+	}
+	nextToken = p.peek()
+
+	if nextToken.Type() == lexer.COLON {
 		if gotRange {
 			return nil, p.expectedError("only one colon", nextToken)
 		}
@@ -2610,21 +3618,15 @@ func (p *Parser) evaluateSubscript(ctx context) (Expression, error) {
 		gotRange = true
 	}
 
-	if gotRange && isSlice {
-		return nil, p.atError("subscript range is not supported for slices", valueToken)
-	}
 	nextToken = p.peek()
 	endToken := nextToken
 	endIndex := startIndex
+	gotEndIndex := nextToken.Type() != lexer.CLOSING_SQUARE_BRACKET
 
-	if nextToken.Type() == lexer.CLOSING_SQUARE_BRACKET {
+	if !gotEndIndex {
 		// If range but no end-index is provided, create one by using Len-expression.
 		if gotRange {
-			endIndex = BinaryOperation{
-				left:     Len{value},
-				operator: BINARY_OPERATOR_SUBTRACTION,
-				right:    IntegerLiteral{1},
-			}
+			endIndex = Len{value}
 		}
 		p.eat() // Eat square bracket.
 	} else {
@@ -2638,31 +3640,41 @@ func (p *Parser) evaluateSubscript(ctx context) (Expression, error) {
 		if nextToken.Type() != lexer.CLOSING_SQUARE_BRACKET {
 			return nil, p.expectedError(`"]"`, nextToken)
 		}
-
-		// End-index is not included.
-		endIndex = BinaryOperation{
-			left:     endIndex,
-			operator: BINARY_OPERATOR_SUBTRACTION,
-			right:    IntegerLiteral{1},
-		}
 	}
 	endIndexValueType := endIndex.ValueType()
 
 	if !endIndexValueType.IsInt() {
-		return nil, p.expectedError(fmt.Sprintf("%s as stop-index but got %s", DATA_TYPE_INTEGER, endIndexValueType.String()), endToken)
+		return nil, p.mismatchError(fmt.Sprintf("%s as stop-index but got %s", DATA_TYPE_INTEGER, endIndexValueType.String()), endToken)
 	}
 
 	if !isSlice {
+		// End-index is not included.
+		if gotRange || gotEndIndex {
+			endIndex = BinaryOperation{
+				left:     endIndex,
+				operator: BINARY_OPERATOR_SUBTRACTION,
+				right:    IntegerLiteral{1},
+			}
+		}
 		return StringSubscript{
 			value:      value,
 			startIndex: startIndex,
 			endIndex:   endIndex,
 		}, nil
 	}
+
+	// Slice ranges create a new slice, the end-index is not included.
+	if gotRange {
+		return SliceRange{
+			value:      value,
+			startIndex: startIndex,
+			endIndex:   endIndex,
+		}, nil
+	}
 	return SliceEvaluation{
-		value:    value,
-		index:    startIndex,
-		dataType: valueType.DataType(),
+		value:     value,
+		index:     startIndex,
+		valueType: valueType.ElementType(),
 	}, nil
 }
 
@@ -2678,31 +3690,53 @@ func (p *Parser) evaluateSliceAssignment(ctx context) (Statement, error) {
 	if !exists {
 		return nil, p.atError(fmt.Sprintf("variable %s has not been defined", name), nameToken)
 	}
+	p.addReference(SYMBOL_KIND_VARIABLE, nameToken, p.prefix, ctx.global(), ctx)
 	variableValueType := variable.ValueType()
 
 	if !variableValueType.IsSlice() {
 		return nil, p.expectedError(fmt.Sprintf("slice but variable is of type %s", variableValueType.String()), nameToken)
 	}
-	nextToken := p.eat()
+	var slice Expression = VariableEvaluation{variable}
+	var index Expression
+	nextToken := p.peek()
 
-	if nextToken.Type() != lexer.OPENING_SQUARE_BRACKET {
-		return nil, p.expectedError(`"["`, nextToken)
-	}
-	nextToken = p.peek()
-	index, err := p.evaluateExpression(ctx)
+	// Evaluate subscripts (e.g. s[0] or s[0][1]). All subscripts except the last one evaluate the slice to assign to.
+	for nextToken.Type() == lexer.OPENING_SQUARE_BRACKET {
+		sliceValueType := slice.ValueType()
 
-	if err != nil {
-		return nil, err
-	}
-	indexValueType := index.ValueType()
+		if index != nil {
+			if !sliceValueType.ElementType().IsSlice() {
+				return nil, p.mismatchError(fmt.Sprintf("slice but got %s", sliceValueType.ElementType().String()), nextToken)
+			}
+			slice = SliceEvaluation{
+				value:     slice,
+				index:     index,
+				valueType: sliceValueType.ElementType(),
+			}
+		}
+		p.eat() // Eat opening square bracket.
+		nextToken = p.peek()
+		indexTemp, err := p.evaluateExpression(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		indexValueType := indexTemp.ValueType()
 
-	if !indexValueType.IsInt() {
-		return nil, p.expectedError(fmt.Sprintf("%s as index but got %s", DATA_TYPE_INTEGER, indexValueType.String()), nextToken)
+		if !indexValueType.IsInt() {
+			return nil, p.mismatchError(fmt.Sprintf("%s as index but got %s", DATA_TYPE_INTEGER, indexValueType.String()), nextToken)
+		}
+		nextToken = p.eat()
+
+		if nextToken.Type() != lexer.CLOSING_SQUARE_BRACKET {
+			return nil, p.expectedError(`"]"`, nextToken)
+		}
+		index = indexTemp
+		nextToken = p.peek()
 	}
-	nextToken = p.eat()
 
-	if nextToken.Type() != lexer.CLOSING_SQUARE_BRACKET {
-		return nil, p.expectedError(`"]"`, nextToken)
+	if index == nil {
+		return nil, p.expectedError(`"["`, nextToken)
 	}
 	nextToken = p.eat()
 
@@ -2715,11 +3749,25 @@ func (p *Parser) evaluateSliceAssignment(ctx context) (Statement, error) {
 	if err != nil {
 		return nil, err
 	}
-	variableDataType := variableValueType.DataType()
-	assignedDataType := value.ValueType().DataType()
+	elementValueType := slice.ValueType().ElementType()
+	assignedValueType := value.ValueType()
 
-	if variableDataType != assignedDataType {
-		return nil, p.expectedError(fmt.Sprintf("%s value but got %s", variableDataType, assignedDataType), valueToken)
+	if !assignedValueType.Equals(elementValueType) {
+		return nil, p.mismatchError(fmt.Sprintf("%s value but got %s", elementValueType.String(), assignedValueType.String()), valueToken)
+	}
+
+	// Nested slices are references, therefore the evaluated slice is stored in a helper variable
+	// which is used for the assignment (e.g. s[0][1] = 2 is evaluated like _sa := s[0]; _sa[1] = 2).
+	if _, ok := slice.(VariableEvaluation); !ok {
+		variable = NewVariable(fmt.Sprintf("_sa%d", p.sliceCounter), slice.ValueType(), false, false)
+		p.sliceCounter++
+
+		return SliceAssignment{
+			Variable: variable,
+			slice:    slice,
+			index:    index,
+			value:    value,
+		}, nil
 	}
 	return SliceAssignment{
 		Variable: variable,
@@ -2728,6 +3776,320 @@ func (p *Parser) evaluateSliceAssignment(ctx context) (Statement, error) {
 	}, nil
 }
 
+func (p *Parser) isStructInstantiation(ctx context) bool {
+	name := p.peek().Value()
+	prefix := p.prefix
+	bracketIndex := uint(1)
+
+	// If next token is a dot, it's an imported struct.
+	if p.peekAt(1).Type() == lexer.DOT {
+		prefix = name
+		name = p.peekAt(2).Value()
+		bracketIndex = 3
+	}
+	if p.peekAt(bracketIndex).Type() != lexer.OPENING_CURLY_BRACKET {
+		return false
+	}
+	_, exists := ctx.findStruct(name, prefix)
+	return exists
+}
+
+func (p *Parser) evaluateStructInstantiation(ctx context) (Expression, error) {
+	valueType, err := p.evaluateStructType(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+	return p.evaluateStructValues(valueType, ctx)
+}
+
+func (p *Parser) evaluateStructValues(valueType ValueType, ctx context) (Expression, error) {
+	nextToken := p.eat()
+
+	if nextToken.Type() != lexer.OPENING_CURLY_BRACKET {
+		return nil, p.expectedError(`"{"`, nextToken)
+	}
+	fields := valueType.Fields()
+	values := make([]Expression, len(fields))
+	keyed := false
+	count := 0
+
+	// Evaluate field values. Values can either be provided by key (e.g. Host{Name: "a", Port: 1}) or
+	// by position (e.g. Host{"a", 1}), but not mixed.
+	for {
+		p.skipNewlines()
+		nextToken = p.peek()
+
+		if nextToken.Type() == lexer.CLOSING_CURLY_BRACKET {
+			break
+		}
+		isKeyed := nextToken.Type() == lexer.IDENTIFIER && p.peekAt(1).Type() == lexer.COLON
+
+		if count > 0 && isKeyed != keyed {
+			return nil, p.atError("mixture of field:value and value elements in struct literal", nextToken)
+		}
+		keyed = isKeyed
+		fieldIndex := count
+
+		if keyed {
+			p.eat() // Eat field name token.
+			p.eat() // Eat colon token.
+			fieldName := nextToken.Value()
+			fieldIndex = slices.IndexFunc(fields, func(field StructField) bool { return field.Name() == fieldName })
+
+			if fieldIndex < 0 {
+				return nil, p.atError(fmt.Sprintf("%s has no field %s", valueType.String(), fieldName), nextToken)
+			}
+			if values[fieldIndex] != nil {
+				return nil, p.atError(fmt.Sprintf("duplicate field %s in struct literal", fieldName), nextToken)
+			}
+		} else if fieldIndex >= len(fields) {
+			return nil, p.atError(fmt.Sprintf("too many values in %s literal", valueType.String()), nextToken)
+		}
+		field := fields[fieldIndex]
+		valueToken := p.peek()
+		value, err := p.evaluateExpression(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		fieldValueType := field.ValueType()
+		valueValueType := value.ValueType()
+
+		if !valueValueType.Equals(fieldValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s but got %s for field %s", fieldValueType.String(), valueValueType.String(), field.Name()), valueToken)
+		}
+		values[fieldIndex] = value
+		count++
+
+		if p.peek().Type() != lexer.COMMA {
+			p.skipNewlines()
+			break
+		}
+		p.eat() // Eat comma token.
+	}
+	nextToken = p.eat()
+
+	if nextToken.Type() != lexer.CLOSING_CURLY_BRACKET {
+		return nil, p.expectedError(`"," or "}"`, nextToken)
+	}
+
+	// If values have been provided by position, all fields must be set.
+	if !keyed && count > 0 && count < len(fields) {
+		return nil, p.atError(fmt.Sprintf("too few values in %s literal", valueType.String()), nextToken)
+	}
+
+	// Initialize missing fields with their default values.
+	for i, value := range values {
+		if value == nil {
+			defaultValue, err := defaultVarValue(fields[i].ValueType())
+
+			if err != nil {
+				return nil, err
+			}
+			values[i] = defaultValue
+		}
+	}
+	return StructInstantiation{
+		valueType: valueType,
+		values:    values,
+	}, nil
+}
+
+func (p *Parser) evaluateCompositeAssignment(ctx context) (Statement, error) {
+	startIndex := p.index
+	startReferences := len(p.symbols.references)
+	nameToken := p.eat()
+
+	if nameToken.Type() != lexer.IDENTIFIER {
+		return nil, p.expectedError("variable", nameToken)
+	}
+	name := nameToken.Value()
+	variable, exists := ctx.findVariable(name, p.prefix, ctx.global())
+
+	if !exists {
+		return nil, p.atError(fmt.Sprintf("variable %s has not been defined", name), nameToken)
+	}
+	p.addReference(SYMBOL_KIND_VARIABLE, nameToken, p.prefix, ctx.global(), ctx)
+	var target Expression = VariableEvaluation{variable}
+	var index Expression
+	fields := []string{}
+	elementFields := []string{}
+	isMapKey := false
+
+	// Evaluate assignment target (e.g. h.Addr.Port, hs[0].Name, h.Ports[0] or m["a"]).
+	for loop := true; loop; {
+		nextToken := p.peek()
+		targetValueType := target.ValueType()
+
+		switch nextToken.Type() {
+		case lexer.DOT:
+			if isMapKey {
+				return nil, p.atError("cannot assign to struct field of map element", nextToken)
+			}
+			if !targetValueType.IsStruct() {
+				return nil, p.mismatchError(fmt.Sprintf("struct but got %s", targetValueType.String()), nextToken)
+			}
+			p.eat() // Eat dot token.
+			fieldToken := p.eat()
+
+			if fieldToken.Type() != lexer.IDENTIFIER {
+				return nil, p.expectedError("field name", fieldToken)
+			}
+			fieldName := fieldToken.Value()
+			field, exists := targetValueType.Field(fieldName)
+
+			if !exists {
+				return nil, p.atError(fmt.Sprintf("%s has no field %s", targetValueType.String(), fieldName), fieldToken)
+			}
+			target = StructEvaluation{
+				value: target,
+				field: field,
+			}
+
+			if index == nil {
+				fields = append(fields, fieldName)
+			} else {
+				elementFields = append(elementFields, fieldName)
+			}
+		case lexer.OPENING_SQUARE_BRACKET:
+			// Only a single slice subscript or map key is supported.
+			if index != nil || (!targetValueType.IsSlice() && !targetValueType.IsMap()) {
+				loop = false
+				break
+			}
+			isMapKey = targetValueType.IsMap()
+			p.eat() // Eat opening square bracket.
+			indexToken := p.peek()
+			indexTemp, err := p.evaluateExpression(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+			indexValueType := indexTemp.ValueType()
+
+			if isMapKey {
+				if keyValueType := targetValueType.MapKeyType(); !indexValueType.Equals(keyValueType) {
+					return nil, p.mismatchError(fmt.Sprintf("%s as key but got %s", keyValueType.String(), indexValueType.String()), indexToken)
+				}
+			} else if !indexValueType.IsInt() {
+				return nil, p.mismatchError(fmt.Sprintf("%s as index but got %s", DATA_TYPE_INTEGER, indexValueType.String()), indexToken)
+			}
+			nextToken = p.eat()
+
+			if nextToken.Type() != lexer.CLOSING_SQUARE_BRACKET {
+				return nil, p.expectedError(`"]"`, nextToken)
+			}
+
+			if isMapKey {
+				target = MapEvaluation{
+					value:     target,
+					key:       indexTemp,
+					valueType: targetValueType.MapValueType(),
+				}
+			} else {
+				target = SliceEvaluation{
+					value:     target,
+					index:     indexTemp,
+					valueType: targetValueType.ElementType(),
+				}
+			}
+			index = indexTemp
+		default:
+			loop = false
+		}
+	}
+	assignToken := p.peek()
+	targetValueType := target.ValueType()
+	var value Expression
+
+	switch assignToken.Type() {
+	case lexer.ASSIGN_OPERATOR:
+		p.eat() // Eat assign token.
+		valueToken := p.peek()
+		valueTemp, err := p.evaluateExpression(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		valueValueType := valueTemp.ValueType()
+
+		if !valueValueType.Equals(targetValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s but got %s", targetValueType.String(), valueValueType.String()), valueToken)
+		}
+		value = valueTemp
+	case lexer.COMPOUND_ASSIGN_OPERATOR:
+		p.eat() // Eat assign token.
+		valueToken := p.peek()
+		valueTemp, err := p.evaluateExpression(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		valueValueType := valueTemp.ValueType()
+
+		if !valueValueType.Equals(targetValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s but got %s", targetValueType.String(), valueValueType.String()), valueToken)
+		}
+		assignOperator := assignToken.Value()
+		binaryOperator := string(assignOperator[0])
+
+		if !slices.Contains(allowedBinaryOperators(targetValueType), binaryOperator) {
+			return nil, p.mismatchError(fmt.Sprintf(`valid %s compound assign operator but got "%s"`, targetValueType.String(), assignOperator), assignToken)
+		}
+		value = BinaryOperation{
+			left:     target,
+			operator: binaryOperator,
+			right:    valueTemp,
+		}
+	case lexer.INCREMENT_OPERATOR, lexer.DECREMENT_OPERATOR:
+		p.eat() // Eat increment/decrement token.
+
+		if !targetValueType.IsInt() {
+			return nil, p.mismatchError(fmt.Sprintf("%s but got %s", NewValueType(DATA_TYPE_INTEGER, false).String(), targetValueType.String()), assignToken)
+		}
+		operator := BINARY_OPERATOR_ADDITION
+
+		if assignToken.Type() == lexer.DECREMENT_OPERATOR {
+			operator = BINARY_OPERATOR_SUBTRACTION
+		}
+		value = BinaryOperation{
+			left:     target,
+			operator: operator,
+			right:    IntegerLiteral{value: 1},
+		}
+	default:
+		// It's not an assignment, therefore let the caller evaluate it as an expression (references are
+		// collected again).
+		p.index = startIndex
+		p.symbols.references = p.symbols.references[:startReferences]
+		return nil, nil
+	}
+
+	// If no field is involved, it's a simple map or slice assignment (e.g. m["a"] = 1 or hs[0] = Host{}).
+	if len(fields) == 0 && len(elementFields) == 0 {
+		if isMapKey {
+			return MapAssignment{
+				Variable: variable,
+				key:      index,
+				value:    value,
+			}, nil
+		}
+		return SliceAssignment{
+			Variable: variable,
+			index:    index,
+			value:    value,
+		}, nil
+	}
+	return StructAssignment{
+		Variable:      variable,
+		fields:        fields,
+		index:         index,
+		elementFields: elementFields,
+		value:         value,
+	}, nil
+}
+
 func (p *Parser) evaluateIncrementDecrement(ctx context) (Statement, error) {
 	identifierToken := p.eat()
 
@@ -2740,10 +4102,11 @@ func (p *Parser) evaluateIncrementDecrement(ctx context) (Statement, error) {
 	if !exists {
 		return nil, p.atError(fmt.Sprintf("variable %s has not been defined", name), identifierToken)
 	}
+	p.addReference(SYMBOL_KIND_VARIABLE, identifierToken, p.prefix, ctx.global(), ctx)
 	valueType := definedVariable.ValueType()
 
 	if !valueType.IsInt() {
-		return nil, p.expectedError(fmt.Sprintf("%s but got %s", NewValueType(DATA_TYPE_INTEGER, false).String(), valueType.String()), identifierToken)
+		return nil, p.mismatchError(fmt.Sprintf("%s but got %s", NewValueType(DATA_TYPE_INTEGER, false).String(), valueType.String()), identifierToken)
 	}
 	operationToken := p.eat()
 	increment := true
@@ -2764,8 +4127,8 @@ func (p *Parser) evaluateLen(ctx context) (Expression, error) {
 		expr := expressions[0]
 		valueType := expr.ValueType()
 
-		if !valueType.IsSlice() && !valueType.IsString() {
-			return nil, p.expectedError("slice or string", keywordToken)
+		if !valueType.IsSlice() && !valueType.IsMap() && !valueType.IsString() {
+			return nil, p.expectedError("slice, map or string", keywordToken)
 		}
 		return Len{
 			expression: expr,
@@ -2778,6 +4141,96 @@ func (p *Parser) evaluateLen(ctx context) (Expression, error) {
 	return expr.(Len), nil
 }
 
+func (p *Parser) evaluateArgs(ctx context) (Expression, error) {
+	expr, err := p.evaluateBuiltInFunction(lexer.ARGS, "args", 0, 0, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		return Args{}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(Args), nil
+}
+
+func (p *Parser) evaluateGetEnv(ctx context) (Expression, error) {
+	expr, err := p.evaluateBuiltInFunction(lexer.GETENV, "getenv", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		key := expressions[0]
+
+		if !key.ValueType().IsString() {
+			return nil, p.expectedError("key string", keywordToken)
+		}
+		return GetEnv{
+			key: key,
+		}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(GetEnv), nil
+}
+
+func (p *Parser) evaluateHasEnv(ctx context) (Expression, error) {
+	expr, err := p.evaluateBuiltInFunction(lexer.HASENV, "hasenv", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		key := expressions[0]
+
+		if !key.ValueType().IsString() {
+			return nil, p.expectedError("key string", keywordToken)
+		}
+		return HasEnv{
+			key: key,
+		}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(HasEnv), nil
+}
+
+func (p *Parser) evaluateSetEnv(ctx context) (Statement, error) {
+	return p.evaluateBuiltInFunction(lexer.SETENV, "setenv", 2, 2, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		key := expressions[0]
+
+		if !key.ValueType().IsString() {
+			return nil, p.expectedError("key string as first parameter", keywordToken)
+		}
+		value := expressions[1]
+
+		if !value.ValueType().IsString() {
+			return nil, p.expectedError("value string as second parameter", keywordToken)
+		}
+		return SetEnv{
+			key:   key,
+			value: value,
+		}, nil
+	})
+}
+
+func (p *Parser) evaluateUnsetEnv(ctx context) (Statement, error) {
+	return p.evaluateBuiltInFunction(lexer.UNSETENV, "unsetenv", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		key := expressions[0]
+
+		if !key.ValueType().IsString() {
+			return nil, p.expectedError("key string", keywordToken)
+		}
+		return UnsetEnv{
+			key: key,
+		}, nil
+	})
+}
+
+func (p *Parser) evaluateEnviron(ctx context) (Expression, error) {
+	expr, err := p.evaluateBuiltInFunction(lexer.ENVIRON, "environ", 0, 0, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		return Environ{}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(Environ), nil
+}
+
 func (p *Parser) evaluateInput(ctx context) (Expression, error) {
 	expr, err := p.evaluateBuiltInFunction(lexer.INPUT, "input", 0, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
 		var expr Expression
@@ -2797,9 +4250,14 @@ func (p *Parser) evaluateInput(ctx context) (Expression, error) {
 }
 
 func (p *Parser) evaluatePrint(ctx context) (Statement, error) {
-	return p.evaluateBuiltInFunction(lexer.PRINT, "print", 0, -1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+	keywordToken := p.peek()
+	tokenType := keywordToken.Type()
+
+	return p.evaluateBuiltInFunction(tokenType, keywordToken.Value(), 0, -1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
 		return Print{
 			expressions: expressions,
+			stderr:      tokenType == lexer.EPRINT || tokenType == lexer.EPRINTN,
+			newline:     tokenType == lexer.PRINT || tokenType == lexer.EPRINT,
 		}, nil
 	})
 }
@@ -2812,6 +4270,40 @@ func (p *Parser) evaluatePanic(ctx context) (Statement, error) {
 	})
 }
 
+func (p *Parser) evaluateExit(ctx context) (Statement, error) {
+	return p.evaluateBuiltInFunction(lexer.EXIT, "exit", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		code := expressions[0]
+
+		if !code.ValueType().IsInt() {
+			return nil, p.expectedError("exit code int", keywordToken)
+		}
+		return Exit{
+			code: code,
+		}, nil
+	})
+}
+
+func (p *Parser) evaluateDelete(ctx context) (Statement, error) {
+	return p.evaluateBuiltInFunction(lexer.DELETE, "delete", 2, 2, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		value := expressions[0]
+		key := expressions[1]
+		valueType := value.ValueType()
+
+		if !valueType.IsMap() {
+			return nil, p.expectedError("map as first argument", keywordToken)
+		}
+		keyValueType := valueType.MapKeyType()
+
+		if keyType := key.ValueType(); !keyType.Equals(keyValueType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s as second argument but got %s", keyValueType.String(), keyType.String()), keywordToken)
+		}
+		return Delete{
+			value: value,
+			key:   key,
+		}, nil
+	})
+}
+
 func (p *Parser) evaluateCopy(ctx context) (Expression, error) {
 	expr, err := p.evaluateBuiltInFunction(lexer.COPY, "copy", 2, 2, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
 		expressionsLen := len(expressions)
@@ -2848,6 +4340,76 @@ func (p *Parser) evaluateCopy(ctx context) (Expression, error) {
 	return expr.(Copy), nil
 }
 
+func (p *Parser) evaluateAppend(ctx context) (Expression, error) {
+	keywordToken := p.eat()
+
+	if keywordToken.Type() != lexer.APPEND {
+		return nil, p.expectedKeywordError("append", keywordToken)
+	}
+	nextToken := p.eat()
+
+	if nextToken.Type() != lexer.OPENING_ROUND_BRACKET {
+		return nil, p.expectedError(`"("`, nextToken)
+	}
+	expressions := []Expression{}
+	spread := false
+
+	for {
+		expr, err := p.evaluateExpression(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expr)
+		nextToken = p.eat()
+
+		// Like in Go, the values of a slice can be appended by adding an ellipsis to the second argument (e.g. append(s, t...)).
+		if nextToken.Type() == lexer.ELLIPSIS {
+			if len(expressions) != 2 {
+				return nil, p.atError("can only use ... with the second argument of append", nextToken)
+			}
+			spread = true
+			nextToken = p.eat()
+
+			if nextToken.Type() != lexer.CLOSING_ROUND_BRACKET {
+				return nil, p.expectedError(`")"`, nextToken)
+			}
+			break
+		}
+		nextTokenType := nextToken.Type()
+
+		if nextTokenType == lexer.CLOSING_ROUND_BRACKET {
+			break
+		} else if nextTokenType != lexer.COMMA {
+			return nil, p.expectedError(`"," or ")"`, nextToken)
+		}
+	}
+	slice := expressions[0]
+	sliceType := slice.ValueType()
+
+	if !sliceType.IsSlice() {
+		return nil, p.expectedError("slice as first argument", keywordToken)
+	}
+	elementType := sliceType.ElementType()
+	values := expressions[1:]
+
+	// If the values get spread, the second argument must be of the same slice type.
+	if spread {
+		elementType = sliceType
+	}
+
+	for _, value := range values {
+		if valueType := value.ValueType(); !valueType.Equals(elementType) {
+			return nil, p.mismatchError(fmt.Sprintf("%s but got %s", elementType.String(), valueType.String()), keywordToken)
+		}
+	}
+	return Append{
+		value:  slice,
+		values: values,
+		spread: spread,
+	}, nil
+}
+
 func (p *Parser) evaluateItoa(ctx context) (Expression, error) {
 	expr, err := p.evaluateBuiltInFunction(lexer.ITOA, "itoa", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
 		value := expressions[0]
@@ -2866,6 +4428,126 @@ func (p *Parser) evaluateItoa(ctx context) (Expression, error) {
 	return expr.(Itoa), nil
 }
 
+func (p *Parser) evaluateAtoi(ctx context) (Expression, error) {
+	expr, err := p.evaluateBuiltInFunction(lexer.ATOI, "atoi", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		value := expressions[0]
+
+		if !value.ValueType().IsString() {
+			return nil, p.expectedError("string", keywordToken)
+		}
+		return Atoi{
+			value: value,
+		}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(Atoi), nil
+}
+
+func (p *Parser) evaluateFtoa(ctx context) (Expression, error) {
+	expr, err := p.evaluateBuiltInFunction(lexer.FTOA, "ftoa", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		value := expressions[0]
+
+		if !value.ValueType().IsFloat() {
+			return nil, p.expectedError("float", keywordToken)
+		}
+		return Ftoa{
+			value: value,
+		}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(Ftoa), nil
+}
+
+func (p *Parser) evaluateSprintf(ctx context) (Expression, error) {
+	expr, err := p.evaluateBuiltInFunction(lexer.SPRINTF, "sprintf", 1, -1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		format, ok := expressions[0].(StringLiteral)
+
+		// The format must be a literal to check the argument types when parsing.
+		if !ok {
+			return nil, p.expectedError("format string literal", keywordToken)
+		}
+		parts, err := parseFormat(format.Value())
+
+		if err != nil {
+			return nil, p.atError(err.Error(), keywordToken)
+		}
+		args := expressions[1:]
+		verbs := []int{}
+
+		for i, part := range parts {
+			if !part.IsText() {
+				verbs = append(verbs, i)
+			}
+		}
+
+		if len(verbs) != len(args) {
+			return nil, p.mismatchError(fmt.Sprintf("%d arguments for format but got %d", len(verbs), len(args)), keywordToken)
+		}
+
+		// Make sure each argument fits its verb.
+		for argIndex, i := range verbs {
+			verb := parts[i].Verb().Verb()
+			arg := args[argIndex]
+			valueType := arg.ValueType()
+			valid := false
+
+			switch verb {
+			case 'd':
+				valid = valueType.IsInt()
+			case 's', 'q':
+				valid = valueType.IsString()
+			case 't':
+				valid = valueType.IsBool()
+			case 'v':
+				valid = !valueType.IsSlice() && !valueType.IsMap()
+			}
+
+			if !valid {
+				return nil, p.atError(fmt.Sprintf("%%%c doesn't support %s", verb, valueType.String()), keywordToken)
+			}
+			parts[i].value = arg
+		}
+		return Sprintf{
+			parts: parts,
+		}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(Sprintf), nil
+}
+
+func (p *Parser) evaluateConversion(ctx context) (Expression, error) {
+	typeToken := p.peek()
+	typeName := typeToken.Value()
+	expr, err := p.evaluateBuiltInFunction(lexer.DATA_TYPE, typeName, 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
+		value := expressions[0]
+		valueType := value.ValueType()
+		targetType := NewValueType(typeMapping[typeName], false)
+
+		// Only numbers can be converted.
+		if (!targetType.IsInt() && !targetType.IsFloat()) || (!valueType.IsInt() && !valueType.IsFloat()) {
+			return nil, p.atError(fmt.Sprintf("cannot convert %s to %s", valueType.String(), targetType.String()), keywordToken)
+		}
+		return Conversion{
+			value:     value,
+			valueType: targetType,
+		}, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return expr.(Conversion), nil
+}
+
 func (p *Parser) evaluateExists(ctx context) (Expression, error) {
 	expr, err := p.evaluateBuiltInFunction(lexer.EXISTS, "exists", 1, 1, ctx, func(keywordToken lexer.Token, expressions []Expression) (Statement, error) {
 		path := expressions[0]
@@ -0,0 +1,20 @@
+package parser
+
+// Position describes where a statement starts in a source file.
+type Position struct {
+	path   string
+	row    int
+	column int
+}
+
+func (p Position) Path() string {
+	return p.path
+}
+
+func (p Position) Row() int {
+	return p.row
+}
+
+func (p Position) Column() int {
+	return p.column
+}
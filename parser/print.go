@@ -2,6 +2,8 @@ package parser
 
 type Print struct {
 	expressions []Expression
+	stderr      bool // Print to stderr instead of stdout.
+	newline     bool // Terminate the output with a newline.
 }
 
 func (p Print) StatementType() StatementType {
@@ -11,3 +13,11 @@ func (p Print) StatementType() StatementType {
 func (p Print) Expressions() []Expression {
 	return p.expressions
 }
+
+func (p Print) Stderr() bool {
+	return p.stderr
+}
+
+func (p Print) Newline() bool {
+	return p.newline
+}
@@ -1,7 +1,8 @@
 package parser
 
 type Program struct {
-	body []Statement
+	body      []Statement
+	positions []Position
 }
 
 func (p Program) StatementType() StatementType {
@@ -11,3 +12,7 @@ func (p Program) StatementType() StatementType {
 func (p Program) Body() []Statement {
 	return p.body
 }
+
+func (p Program) Positions() []Position {
+	return p.positions
+}
@@ -1,8 +1,8 @@
 package parser
 
 type SliceInstantiation struct {
-	dataType DataType
-	values   []Expression
+	valueType ValueType
+	values    []Expression
 }
 
 func (s SliceInstantiation) StatementType() StatementType {
@@ -10,7 +10,7 @@ func (s SliceInstantiation) StatementType() StatementType {
 }
 
 func (s SliceInstantiation) ValueType() ValueType {
-	return ValueType{dataType: s.dataType, isSlice: true}
+	return s.valueType
 }
 
 func (s SliceInstantiation) Values() []Expression {
@@ -18,9 +18,9 @@ func (s SliceInstantiation) Values() []Expression {
 }
 
 type SliceEvaluation struct {
-	value    Expression
-	index    Expression
-	dataType DataType
+	value     Expression
+	index     Expression
+	valueType ValueType
 }
 
 func (s SliceEvaluation) StatementType() StatementType {
@@ -36,11 +36,39 @@ func (s SliceEvaluation) Index() Expression {
 }
 
 func (s SliceEvaluation) ValueType() ValueType {
-	return ValueType{dataType: s.dataType}
+	return s.valueType
+}
+
+// SliceRange creates a new slice from the values of the start- to (excluding) the end-index.
+type SliceRange struct {
+	value      Expression
+	startIndex Expression
+	endIndex   Expression
+}
+
+func (s SliceRange) StatementType() StatementType {
+	return STATEMENT_TYPE_SLICE_RANGE
+}
+
+func (s SliceRange) ValueType() ValueType {
+	return s.value.ValueType()
+}
+
+func (s SliceRange) Value() Expression {
+	return s.value
+}
+
+func (s SliceRange) StartIndex() Expression {
+	return s.startIndex
+}
+
+func (s SliceRange) EndIndex() Expression {
+	return s.endIndex
 }
 
 type SliceAssignment struct {
 	Variable
+	slice Expression // Only set for nested slices (e.g. s[0] for s[0][1] = 2). Its value is assigned to the variable first.
 	index Expression
 	value Expression
 }
@@ -53,6 +81,10 @@ func (s SliceAssignment) Name() string {
 	return s.name
 }
 
+func (s SliceAssignment) Slice() Expression {
+	return s.slice
+}
+
 func (s SliceAssignment) Index() Expression {
 	return s.index
 }
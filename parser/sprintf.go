@@ -0,0 +1,133 @@
+package parser
+
+import "fmt"
+
+// FormatVerb describes a formatting verb of a sprintf format string (e.g. %-5s or %03d).
+type FormatVerb struct {
+	verb      rune
+	width     int
+	leftAlign bool
+	zeroPad   bool
+}
+
+func (v FormatVerb) Verb() rune {
+	return v.verb
+}
+
+func (v FormatVerb) Width() int {
+	return v.width
+}
+
+func (v FormatVerb) LeftAlign() bool {
+	return v.leftAlign
+}
+
+func (v FormatVerb) ZeroPad() bool {
+	return v.zeroPad
+}
+
+// FormatPart is either a literal text or a value which is formatted according to its verb.
+type FormatPart struct {
+	text  string
+	verb  FormatVerb
+	value Expression
+}
+
+func (p FormatPart) Text() string {
+	return p.text
+}
+
+func (p FormatPart) Verb() FormatVerb {
+	return p.verb
+}
+
+func (p FormatPart) Value() Expression {
+	return p.value
+}
+
+func (p FormatPart) IsText() bool {
+	return p.verb.verb == 0
+}
+
+type Sprintf struct {
+	parts []FormatPart
+}
+
+func (e Sprintf) StatementType() StatementType {
+	return STATEMENT_TYPE_SPRINTF
+}
+
+func (e Sprintf) ValueType() ValueType {
+	return NewValueType(DATA_TYPE_STRING, false)
+}
+
+func (e Sprintf) Parts() []FormatPart {
+	return e.parts
+}
+
+// parseFormat splits a sprintf format string into its literal texts and verbs. The values of the verb
+// parts are set by the caller.
+func parseFormat(format string) ([]FormatPart, error) {
+	parts := []FormatPart{}
+	runes := []rune(format)
+	text := []rune{}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if r != '%' {
+			text = append(text, r)
+			continue
+		}
+		i++
+
+		// A double percent sign is printed as percent sign.
+		if i < len(runes) && runes[i] == '%' {
+			text = append(text, '%')
+			continue
+		}
+		verb := FormatVerb{}
+
+		for ; i < len(runes) && (runes[i] == '-' || runes[i] == '0'); i++ {
+			if runes[i] == '-' {
+				verb.leftAlign = true
+			} else {
+				verb.zeroPad = true
+			}
+		}
+
+		for ; i < len(runes) && runes[i] >= '0' && runes[i] <= '9'; i++ {
+			verb.width = verb.width*10 + int(runes[i]-'0')
+		}
+
+		if i >= len(runes) {
+			return nil, fmt.Errorf("missing verb at end of format")
+		}
+		verb.verb = runes[i]
+
+		switch verb.verb {
+		case 'd', 's', 'q', 't', 'v':
+		default:
+			return nil, fmt.Errorf("unsupported verb %%%c", verb.verb)
+		}
+
+		// Like in Go, left alignment takes precedence over zero padding.
+		if verb.leftAlign {
+			verb.zeroPad = false
+		}
+		if verb.zeroPad && verb.verb != 'd' {
+			return nil, fmt.Errorf("zero padding is only supported for %%d")
+		}
+
+		if len(text) > 0 {
+			parts = append(parts, FormatPart{text: string(text)})
+			text = []rune{}
+		}
+		parts = append(parts, FormatPart{verb: verb})
+	}
+
+	if len(text) > 0 {
+		parts = append(parts, FormatPart{text: string(text)})
+	}
+	return parts, nil
+}
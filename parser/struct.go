@@ -0,0 +1,89 @@
+package parser
+
+type StructDefinition struct {
+	name      string
+	valueType ValueType
+	public    bool
+}
+
+func (s StructDefinition) StatementType() StatementType {
+	return STATEMENT_TYPE_STRUCT_DEFINITION
+}
+
+func (s StructDefinition) Name() string {
+	return s.name
+}
+
+func (s StructDefinition) ValueType() ValueType {
+	return s.valueType
+}
+
+func (s StructDefinition) Public() bool {
+	return s.public
+}
+
+type StructInstantiation struct {
+	valueType ValueType
+	values    []Expression // Field values in the order of the struct definition.
+}
+
+func (s StructInstantiation) StatementType() StatementType {
+	return STATEMENT_TYPE_STRUCT_INSTANTIATION
+}
+
+func (s StructInstantiation) ValueType() ValueType {
+	return s.valueType
+}
+
+func (s StructInstantiation) Values() []Expression {
+	return s.values
+}
+
+type StructEvaluation struct {
+	value Expression
+	field StructField
+}
+
+func (s StructEvaluation) StatementType() StatementType {
+	return STATEMENT_TYPE_STRUCT_EVALUATION
+}
+
+func (s StructEvaluation) Value() Expression {
+	return s.value
+}
+
+func (s StructEvaluation) Field() string {
+	return s.field.Name()
+}
+
+func (s StructEvaluation) ValueType() ValueType {
+	return s.field.ValueType()
+}
+
+type StructAssignment struct {
+	Variable
+	fields        []string   // Field path up to the index (e.g. h.Addr in h.Addr.Ports[0]).
+	index         Expression // Optional slice index.
+	elementFields []string   // Field path after the index (e.g. Name in hs[0].Name).
+	value         Expression
+}
+
+func (s StructAssignment) StatementType() StatementType {
+	return STATEMENT_TYPE_STRUCT_ASSIGNMENT
+}
+
+func (s StructAssignment) Fields() []string {
+	return s.fields
+}
+
+func (s StructAssignment) Index() Expression {
+	return s.index
+}
+
+func (s StructAssignment) ElementFields() []string {
+	return s.elementFields
+}
+
+func (s StructAssignment) Value() Expression {
+	return s.value
+}
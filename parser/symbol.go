@@ -0,0 +1,209 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/monstermichl/typeshell/lexer"
+)
+
+type SymbolKind string
+
+const (
+	SYMBOL_KIND_VARIABLE  SymbolKind = "variable"
+	SYMBOL_KIND_PARAMETER SymbolKind = "parameter"
+	SYMBOL_KIND_FUNCTION  SymbolKind = "function"
+	SYMBOL_KIND_STRUCT    SymbolKind = "struct"
+	SYMBOL_KIND_IMPORT    SymbolKind = "import"
+)
+
+// Symbol describes a named definition (e.g. a variable or a function) at a specific position of a source file.
+type Symbol struct {
+	name        string
+	kind        SymbolKind
+	valueType   ValueType   // Type of variables, parameters and structs.
+	params      []Variable  // Parameters of functions.
+	returnTypes []ValueType // Return types of functions.
+	variadic    bool        // Specifies if a function is variadic.
+	importPath  string      // Resolved path of imports.
+	global      bool
+	path        string
+	row         int
+	column      int
+}
+
+func (s Symbol) Name() string {
+	return s.name
+}
+
+func (s Symbol) Kind() SymbolKind {
+	return s.kind
+}
+
+func (s Symbol) ValueType() ValueType {
+	return s.valueType
+}
+
+func (s Symbol) ImportPath() string {
+	return s.importPath
+}
+
+func (s Symbol) Global() bool {
+	return s.global
+}
+
+func (s Symbol) Public() bool {
+	return isPublic(s.name)
+}
+
+func (s Symbol) Path() string {
+	return s.path
+}
+
+func (s Symbol) Row() int {
+	return s.row
+}
+
+func (s Symbol) Column() int {
+	return s.column
+}
+
+// Signature returns the symbol the way it's written in TypeShell (e.g. func add(a int, b int) int).
+func (s Symbol) Signature() string {
+	switch s.kind {
+	case SYMBOL_KIND_FUNCTION:
+		params := []string{}
+
+		for i, param := range s.params {
+			paramType := param.ValueType().String()
+
+			// Variadic parameters are stored as slices but are written with an ellipsis.
+			if s.variadic && i == len(s.params)-1 {
+				paramType = fmt.Sprintf("...%s", param.ValueType().ElementType().String())
+			}
+			params = append(params, fmt.Sprintf("%s %s", param.Name(), paramType))
+		}
+		signature := fmt.Sprintf("func %s(%s)", s.name, strings.Join(params, ", "))
+		returnTypes := []string{}
+
+		for _, returnType := range s.returnTypes {
+			returnTypes = append(returnTypes, returnType.String())
+		}
+
+		if len(returnTypes) == 1 {
+			signature = fmt.Sprintf("%s %s", signature, returnTypes[0])
+		} else if len(returnTypes) > 1 {
+			signature = fmt.Sprintf("%s (%s)", signature, strings.Join(returnTypes, ", "))
+		}
+		return signature
+	case SYMBOL_KIND_STRUCT:
+		fields := []string{}
+
+		for _, field := range s.valueType.Fields() {
+			fields = append(fields, fmt.Sprintf("\t%s %s\n", field.Name(), field.ValueType().String()))
+		}
+		return fmt.Sprintf("type %s struct {\n%s}", s.name, strings.Join(fields, ""))
+	case SYMBOL_KIND_IMPORT:
+		return fmt.Sprintf(`import %s "%s"`, s.name, s.importPath)
+	case SYMBOL_KIND_PARAMETER:
+		return fmt.Sprintf("%s %s", s.name, s.valueType.String())
+	}
+	return fmt.Sprintf("var %s %s", s.name, s.valueType.String())
+}
+
+// Reference describes the usage of a symbol at a specific position of a source file.
+type Reference struct {
+	symbol Symbol
+	path   string
+	row    int
+	column int
+	length int
+}
+
+func (r Reference) Symbol() Symbol {
+	return r.symbol
+}
+
+func (r Reference) Path() string {
+	return r.path
+}
+
+func (r Reference) Row() int {
+	return r.row
+}
+
+func (r Reference) Column() int {
+	return r.column
+}
+
+func (r Reference) Length() int {
+	return r.length
+}
+
+// symbolTable collects the symbols and references of a program. It's shared between the parser of
+// a program and the parsers of its imports.
+type symbolTable struct {
+	symbols    []Symbol
+	references []Reference
+	globals    map[string]Symbol // Maps prefixed global names to their symbols (locals are stored in the context).
+}
+
+func newSymbolTable() *symbolTable {
+	return &symbolTable{
+		globals: map[string]Symbol{},
+	}
+}
+
+func symbolKey(kind SymbolKind, prefixedName string) string {
+	// Parameters are variables within the function body.
+	if kind == SYMBOL_KIND_PARAMETER {
+		kind = SYMBOL_KIND_VARIABLE
+	}
+	return fmt.Sprintf("%s:%s", kind, prefixedName)
+}
+
+// addSymbol stores a symbol which has been defined at the provided token. If the symbol has no name yet, the
+// token's value is used.
+func (p *Parser) addSymbol(symbol Symbol, token lexer.Token, ctx context) {
+	if len(symbol.name) == 0 {
+		symbol.name = token.Value()
+	}
+
+	symbol.path = p.path
+	symbol.row = token.Row()
+	symbol.column = token.Column()
+	key := symbolKey(symbol.kind, buildPrefixedName(p.prefix, symbol.name))
+
+	if symbol.global {
+		p.symbols.globals[key] = symbol
+	} else {
+		ctx.symbols[symbolKey(symbol.kind, symbol.name)] = symbol
+	}
+	p.symbols.symbols = append(p.symbols.symbols, symbol)
+}
+
+// addReference stores a reference to the symbol which is found by the prefixed name. The name is prefixed the same
+// way as by the context's find-functions.
+func (p *Parser) addReference(kind SymbolKind, token lexer.Token, prefix string, global bool, ctx context) {
+	prefixedName, err := ctx.buildPrefixedName(token.Value(), prefix, global, true)
+
+	if err != nil {
+		return
+	}
+	key := symbolKey(kind, prefixedName)
+	symbol, exists := ctx.symbols[key]
+
+	if !exists {
+		symbol, exists = p.symbols.globals[key]
+	}
+	if !exists {
+		return
+	}
+	p.symbols.references = append(p.symbols.references, Reference{
+		symbol: symbol,
+		path:   p.path,
+		row:    token.Row(),
+		column: token.Column(),
+		length: len(token.Value()),
+	})
+}
@@ -1,6 +1,9 @@
 package parser
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type StatementType string
 type DataType string
@@ -9,15 +12,44 @@ type UnaryOperator = string
 type BinaryOperator = string
 type LogicalOperator = string
 
+type StructField struct {
+	name      string
+	valueType ValueType
+}
+
+func (f StructField) Name() string {
+	return f.name
+}
+
+func (f StructField) ValueType() ValueType {
+	return f.valueType
+}
+
 type ValueType struct {
-	dataType DataType
-	isSlice  bool
+	dataType   DataType
+	sliceDepth int           // Amount of slice dimensions (e.g. 2 for [][]string).
+	fields     []StructField // Only set for struct types.
+	name       string        // Struct name as written in the source (dataType holds the prefixed name).
+	mapKey     *ValueType    // Only set for map types.
+	mapValue   *ValueType    // Only set for map types.
 }
 
 func NewValueType(dataType DataType, isSlice bool) ValueType {
+	valueType := ValueType{
+		dataType: dataType,
+	}
+
+	if isSlice {
+		valueType.sliceDepth = 1
+	}
+	return valueType
+}
+
+func NewMapValueType(keyType ValueType, valueType ValueType) ValueType {
 	return ValueType{
-		dataType,
-		isSlice,
+		dataType: DATA_TYPE_MAP,
+		mapKey:   &keyType,
+		mapValue: &valueType,
 	}
 }
 
@@ -26,20 +58,74 @@ func (vt ValueType) DataType() DataType {
 }
 
 func (vt ValueType) IsSlice() bool {
-	return vt.isSlice
+	return vt.sliceDepth > 0
+}
+
+func (vt ValueType) Fields() []StructField {
+	return vt.fields
+}
+
+func (vt ValueType) Field(name string) (StructField, bool) {
+	for _, field := range vt.fields {
+		if field.Name() == name {
+			return field, true
+		}
+	}
+	return StructField{}, false
+}
+
+// MapKeyType returns the key type of a map.
+func (vt ValueType) MapKeyType() ValueType {
+	if vt.mapKey == nil {
+		return NewValueType(DATA_TYPE_UNKNOWN, false)
+	}
+	return *vt.mapKey
+}
+
+// MapValueType returns the value type of a map.
+func (vt ValueType) MapValueType() ValueType {
+	if vt.mapValue == nil {
+		return NewValueType(DATA_TYPE_UNKNOWN, false)
+	}
+	return *vt.mapValue
+}
+
+// ElementType returns the type of a slice's elements (e.g. []string for [][]string).
+func (vt ValueType) ElementType() ValueType {
+	if vt.sliceDepth > 0 {
+		vt.sliceDepth--
+	}
+	return vt
+}
+
+// SliceType returns the slice type of the value type (e.g. [][]string for []string).
+func (vt ValueType) SliceType() ValueType {
+	vt.sliceDepth++
+	return vt
 }
 
 func (vt ValueType) String() string {
 	s := string(vt.dataType)
 
-	if vt.isSlice {
-		s = fmt.Sprintf("[]%s", s)
+	if vt.name != "" {
+		s = vt.name
+	} else if vt.dataType == DATA_TYPE_MAP {
+		s = fmt.Sprintf("map[%s]%s", vt.MapKeyType().String(), vt.MapValueType().String())
 	}
-	return s
+
+	return strings.Repeat("[]", vt.sliceDepth) + s
 }
 
 func (vt ValueType) Equals(valueType ValueType) bool {
-	return vt.DataType() == valueType.DataType() && vt.IsSlice() == valueType.IsSlice()
+	if vt.DataType() != valueType.DataType() || vt.sliceDepth != valueType.sliceDepth {
+		return false
+	}
+
+	// Maps are only equal if their key and value types are equal.
+	if vt.DataType() == DATA_TYPE_MAP {
+		return vt.MapKeyType().Equals(valueType.MapKeyType()) && vt.MapValueType().Equals(valueType.MapValueType())
+	}
+	return true
 }
 
 func (vt ValueType) IsBool() bool {
@@ -50,10 +136,37 @@ func (vt ValueType) IsInt() bool {
 	return vt.isNonSliceType(DATA_TYPE_INTEGER)
 }
 
+func (vt ValueType) IsFloat() bool {
+	return vt.isNonSliceType(DATA_TYPE_FLOAT)
+}
+
 func (vt ValueType) IsString() bool {
 	return vt.isNonSliceType(DATA_TYPE_STRING)
 }
 
+func (vt ValueType) IsStruct() bool {
+	return len(vt.fields) > 0 && !vt.IsSlice()
+}
+
+func (vt ValueType) IsMap() bool {
+	return vt.isNonSliceType(DATA_TYPE_MAP)
+}
+
+func (vt ValueType) IsStructSlice() bool {
+	return len(vt.fields) > 0 && vt.IsSlice()
+}
+
+func (vt ValueType) containsSlice() bool {
+	for _, field := range vt.fields {
+		fieldValueType := field.ValueType()
+
+		if fieldValueType.IsSlice() || fieldValueType.IsMap() || fieldValueType.containsSlice() {
+			return true
+		}
+	}
+	return false
+}
+
 func (vt ValueType) isNonSliceType(dataType DataType) bool {
 	return vt.DataType() == dataType && !vt.IsSlice()
 }
@@ -62,6 +175,7 @@ const (
 	STATEMENT_TYPE_PROGRAM                        StatementType = "program"
 	STATEMENT_TYPE_BOOL_LITERAL                   StatementType = "boolean"
 	STATEMENT_TYPE_INT_LITERAL                    StatementType = "integer"
+	STATEMENT_TYPE_FLOAT_LITERAL                  StatementType = "float"
 	STATEMENT_TYPE_STRING_LITERAL                 StatementType = "string"
 	STATEMENT_TYPE_STRING_SUBSCRIPT               StatementType = "string subscript"
 	STATEMENT_TYPE_NIL_LITERAL                    StatementType = "nil"
@@ -87,16 +201,39 @@ const (
 	STATEMENT_TYPE_INSTANTIATION                  StatementType = "instantiation"
 	STATEMENT_TYPE_PRINT                          StatementType = "print"
 	STATEMENT_TYPE_ITOA                           StatementType = "itoa"
+	STATEMENT_TYPE_ATOI                           StatementType = "atoi"
+	STATEMENT_TYPE_FTOA                           StatementType = "ftoa"
+	STATEMENT_TYPE_SPRINTF                        StatementType = "sprintf"
+	STATEMENT_TYPE_CONVERSION                     StatementType = "conversion"
 	STATEMENT_TYPE_EXISTS                         StatementType = "exists"
 	STATEMENT_TYPE_PANIC                          StatementType = "panic"
+	STATEMENT_TYPE_EXIT                           StatementType = "exit"
 	STATEMENT_TYPE_LEN                            StatementType = "len"
 	STATEMENT_TYPE_INPUT                          StatementType = "input"
+	STATEMENT_TYPE_ARGS                           StatementType = "args"
+	STATEMENT_TYPE_GETENV                         StatementType = "getenv"
+	STATEMENT_TYPE_HASENV                         StatementType = "hasenv"
+	STATEMENT_TYPE_SETENV                         StatementType = "setenv"
+	STATEMENT_TYPE_UNSETENV                       StatementType = "unsetenv"
+	STATEMENT_TYPE_ENVIRON                        StatementType = "environ"
 	STATEMENT_TYPE_COPY                           StatementType = "copy"
+	STATEMENT_TYPE_APPEND                         StatementType = "append"
 	STATEMENT_TYPE_READ                           StatementType = "read"
 	STATEMENT_TYPE_WRITE                          StatementType = "write"
 	STATEMENT_TYPE_SLICE_INSTANTIATION            StatementType = "slice instantiation"
 	STATEMENT_TYPE_SLICE_ASSIGNMENT               StatementType = "slice assignment"
 	STATEMENT_TYPE_SLICE_EVALUATION               StatementType = "slice evaluation"
+	STATEMENT_TYPE_SLICE_RANGE                    StatementType = "slice range"
+	STATEMENT_TYPE_STRUCT_DEFINITION              StatementType = "struct definition"
+	STATEMENT_TYPE_STRUCT_INSTANTIATION           StatementType = "struct instantiation"
+	STATEMENT_TYPE_STRUCT_EVALUATION              StatementType = "struct evaluation"
+	STATEMENT_TYPE_STRUCT_ASSIGNMENT              StatementType = "struct assignment"
+	STATEMENT_TYPE_MAP_INSTANTIATION              StatementType = "map instantiation"
+	STATEMENT_TYPE_MAP_ASSIGNMENT                 StatementType = "map assignment"
+	STATEMENT_TYPE_MAP_EVALUATION                 StatementType = "map evaluation"
+	STATEMENT_TYPE_MAP_LOOKUP                     StatementType = "map lookup"
+	STATEMENT_TYPE_MAP_KEYS                       StatementType = "map keys"
+	STATEMENT_TYPE_DELETE                         StatementType = "delete"
 )
 
 const (
@@ -104,7 +241,9 @@ const (
 	DATA_TYPE_MULTIPLE DataType = "multiple"
 	DATA_TYPE_BOOLEAN  DataType = "bool"
 	DATA_TYPE_INTEGER  DataType = "int"
+	DATA_TYPE_FLOAT    DataType = "float64"
 	DATA_TYPE_STRING   DataType = "string"
+	DATA_TYPE_MAP      DataType = "map"
 	DATA_TYPE_ERROR    DataType = DATA_TYPE_STRING
 )
 
@@ -0,0 +1,218 @@
+package parser
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/monstermichl/typeshell/lexer"
+)
+
+type VetRule string
+
+const (
+	VET_RULE_UNUSED_VARIABLE   VetRule = "unused-variable"   // Variables and parameters which are never used.
+	VET_RULE_UNUSED_IMPORT     VetRule = "unused-import"     // Imports which are never used.
+	VET_RULE_UNREACHABLE_CODE  VetRule = "unreachable-code"  // Statements after return, break, continue, panic or exit.
+	VET_RULE_SHADOWING         VetRule = "shadowing"         // Local variables and parameters which hide a function, type, import or later global variable.
+	VET_RULE_IGNORED_ERROR     VetRule = "ignored-error"     // Error results of calls which are discarded.
+	VET_RULE_IGNORED_EXIT_CODE VetRule = "ignored-exit-code" // Exit codes of program calls which are discarded.
+)
+
+// VetRules returns all rules in the order in which they are documented.
+func VetRules() []VetRule {
+	return []VetRule{
+		VET_RULE_UNUSED_VARIABLE,
+		VET_RULE_UNUSED_IMPORT,
+		VET_RULE_UNREACHABLE_CODE,
+		VET_RULE_SHADOWING,
+		VET_RULE_IGNORED_ERROR,
+		VET_RULE_IGNORED_EXIT_CODE,
+	}
+}
+
+// Vet returns the findings of the provided rules for the file which has been parsed last. Imported
+// files are not vetted. Findings are only meaningful if parsing succeeded.
+func (p Parser) Vet(rules []VetRule) Diagnostics {
+	findings := append(Diagnostics{}, p.findings...)
+	findings = append(findings, p.unusedFindings()...)
+	findings = append(findings, p.shadowingFindings()...)
+
+	// Only keep the findings of the requested rules.
+	findings = slices.DeleteFunc(findings, func(d Diagnostic) bool {
+		return !slices.Contains(rules, VetRule(d.Code()))
+	})
+	slices.SortStableFunc(findings, func(a Diagnostic, b Diagnostic) int {
+		if a.Row() != b.Row() {
+			return a.Row() - b.Row()
+		}
+		return a.Column() - b.Column()
+	})
+	return findings
+}
+
+func (p *Parser) addFinding(rule VetRule, message string, token lexer.Token) {
+	p.findings = append(p.findings, NewDiagnostic(message, p.path, token.Row(), token.Column(), token.Length(), SEVERITY_WARNING, DiagnosticCode(rule)))
+}
+
+func symbolFinding(rule VetRule, message string, symbol Symbol) Diagnostic {
+	return NewDiagnostic(message, symbol.Path(), symbol.Row(), symbol.Column(), len(symbol.Name()), SEVERITY_WARNING, DiagnosticCode(rule))
+}
+
+func sameSymbol(a Symbol, b Symbol) bool {
+	return a.name == b.name && a.path == b.path && a.row == b.row && a.column == b.column
+}
+
+// fileSymbols returns the symbols which have been defined in the file which has been parsed last.
+func (p Parser) fileSymbols() []Symbol {
+	return slices.DeleteFunc(slices.Clone(p.symbols.symbols), func(s Symbol) bool {
+		return s.path != p.path
+	})
+}
+
+// unusedFindings reports variables, parameters and imports which are never referenced. Assignments count
+// as usage. Public global variables are not reported because they might be used by importing files.
+func (p Parser) unusedFindings() Diagnostics {
+	findings := Diagnostics{}
+
+	for _, symbol := range p.fileSymbols() {
+		var rule VetRule
+		var message string
+
+		switch {
+		case symbol.kind == SYMBOL_KIND_IMPORT:
+			rule = VET_RULE_UNUSED_IMPORT
+			message = fmt.Sprintf(`import "%s" is not used`, symbol.name)
+		case symbol.kind == SYMBOL_KIND_PARAMETER:
+			rule = VET_RULE_UNUSED_VARIABLE
+			message = fmt.Sprintf("parameter %s is not used", symbol.name)
+		case symbol.kind == SYMBOL_KIND_VARIABLE && !(symbol.global && isPublic(symbol.name)):
+			rule = VET_RULE_UNUSED_VARIABLE
+			message = fmt.Sprintf("variable %s is not used", symbol.name)
+		default:
+			continue
+		}
+		used := slices.ContainsFunc(p.symbols.references, func(r Reference) bool {
+			return sameSymbol(r.symbol, symbol)
+		})
+
+		if !used {
+			findings = append(findings, symbolFinding(rule, message, symbol))
+		}
+	}
+	return findings
+}
+
+// shadowingFindings reports local variables and parameters which have the same name as a function, type
+// or import of the same file. Locals can't be defined with the name of an already defined variable (which
+// is an error), therefore global variables can only be shadowed if they are defined later.
+func (p Parser) shadowingFindings() Diagnostics {
+	findings := Diagnostics{}
+	symbols := p.fileSymbols()
+
+	for _, local := range symbols {
+		if local.global || (local.kind != SYMBOL_KIND_VARIABLE && local.kind != SYMBOL_KIND_PARAMETER) {
+			continue
+		}
+		i := slices.IndexFunc(symbols, func(s Symbol) bool {
+			return s.global && s.name == local.name
+		})
+
+		if i >= 0 {
+			global := symbols[i]
+			findings = append(findings, symbolFinding(VET_RULE_SHADOWING, fmt.Sprintf("%s %s shadows the %s defined at row %d", local.kind, local.name, global.kind, global.row), local))
+		}
+	}
+	return findings
+}
+
+// collectFindings stores the findings of a statement which has been evaluated in a block. The statement
+// starts at the provided token index.
+func (p *Parser) collectFindings(statements []Statement, stmt Statement, startIndex int) {
+	token := p.tokens[startIndex]
+
+	// Only report the first unreachable statement of a block. Definitions aren't executed, therefore
+	// they are not unreachable.
+	if length := len(statements); length > 0 && isTerminating(statements[length-1]) &&
+		!slices.Contains([]StatementType{STATEMENT_TYPE_FUNCTION_DEFINITION, STATEMENT_TYPE_STRUCT_DEFINITION}, stmt.StatementType()) {
+		p.addFinding(VET_RULE_UNREACHABLE_CODE, "unreachable code", token)
+	}
+	var variables []Variable
+	var call Statement
+
+	switch stmt.StatementType() {
+	case STATEMENT_TYPE_VAR_DEFINITION_CALL_ASSIGNMENT:
+		definition := stmt.(VariableDefinitionCallAssignment)
+		variables = definition.Variables()
+		call = definition.Call()
+	case STATEMENT_TYPE_VAR_ASSIGNMENT_CALL_ASSIGNMENT:
+		assignment := stmt.(VariableAssignmentCallAssignment)
+		variables = assignment.Variables()
+		call = assignment.Call()
+	default:
+		call = stmt // Results of standalone calls are discarded entirely.
+	}
+	var errorResults []int
+	exitCodeResult := -1
+
+	switch call.StatementType() {
+	case STATEMENT_TYPE_FUNCTION_CALL:
+		errorResults = p.errorResults[call.(FunctionCall).Name()]
+	case STATEMENT_TYPE_ATOI:
+		errorResults = []int{1}
+	case STATEMENT_TYPE_APP_CALL:
+		exitCodeResult = 2
+	}
+	discarded := func(result int) bool {
+		return variables == nil
+	}
+	name, nameToken := p.callName(startIndex, variables != nil)
+
+	// The exit code of a pipe is the one of the last program.
+	if appCall, ok := call.(AppCall); ok {
+		for appCall.Next() != nil {
+			appCall = *appCall.Next()
+		}
+		name = fmt.Sprintf("@%s", appCall.Name())
+	}
+
+	if slices.ContainsFunc(errorResults, discarded) {
+		p.addFinding(VET_RULE_IGNORED_ERROR, fmt.Sprintf("error result of %s is not checked", name), nameToken)
+	}
+	if exitCodeResult >= 0 && discarded(exitCodeResult) {
+		p.addFinding(VET_RULE_IGNORED_EXIT_CODE, fmt.Sprintf("exit code of %s is not checked", name), nameToken)
+	}
+}
+
+// callName returns the called name the way it's written (e.g. strings.Split) and the token where it starts.
+// If the call is assigned, the name starts after the assignment operator.
+func (p Parser) callName(startIndex int, assigned bool) (string, lexer.Token) {
+	name := []string{}
+	nameToken := p.tokens[startIndex]
+
+	for _, token := range p.tokens[startIndex:] {
+		tokenType := token.Type()
+
+		if tokenType == lexer.OPENING_ROUND_BRACKET || tokenType == lexer.NEWLINE || tokenType == lexer.EOF {
+			break
+		} else if assigned {
+			// Skip the assigned variables.
+			assigned = tokenType != lexer.ASSIGN_OPERATOR && tokenType != lexer.SHORT_INIT_OPERATOR
+			continue
+		} else if len(name) == 0 {
+			nameToken = token
+		}
+		name = append(name, token.Value())
+	}
+	return strings.Join(name, ""), nameToken
+}
+
+func isTerminating(stmt Statement) bool {
+	return slices.Contains([]StatementType{
+		STATEMENT_TYPE_RETURN,
+		STATEMENT_TYPE_BREAK,
+		STATEMENT_TYPE_CONTINUE,
+		STATEMENT_TYPE_PANIC,
+		STATEMENT_TYPE_EXIT,
+	}, stmt.StatementType())
+}
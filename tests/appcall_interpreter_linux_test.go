@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpreterLsCallPipeToGrepCallSuccess(t *testing.T) {
+	interpretFunc(t, func(dir string) (string, error) {
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @ls("%s") | @grep(".tsh")`, dir) + `
+
+			print(stdout, code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "test.tsh 0", output)
+	})
+}
+
+func TestInterpreterLsCallFail(t *testing.T) {
+	interpret(t, `
+		var stdout, stderr, code = @ls("not-present-dir")
+
+		print(code != 0, stderr != "")
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true true", output)
+	})
+}
+
+func TestInterpreterCallStderrSuccess(t *testing.T) {
+	interpretFunc(t, func(dir string) (string, error) {
+		shFile := path.Join(dir, "stderr.sh")
+		err := os.WriteFile(shFile, []byte("#!/bin/sh\necho out; echo err1 >&2; echo err2 >&2; exit 3"), 0700)
+
+		if err != nil {
+			return "", err
+		}
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @"%s"()`, shFile) + `
+
+			print(stdout)
+			print(stderr)
+			print(code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr1\nerr2\n3", output)
+	})
+}
+
+func TestInterpreterCallPipeStderrSuccess(t *testing.T) {
+	interpret(t, `
+		var stdout, stderr, code = @sh("-c", "echo out; echo err1 >&2; exit 3") | @sh("-c", "cat; echo err2 >&2")
+
+		print(stdout)
+		print(stderr)
+		print(code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr1\nerr2\n0", output)
+	})
+}
+
+func TestInterpreterNotExistingCallFail(t *testing.T) {
+	interpret(t, `
+		var stdout, stderr, code = @"not-existing-program"("a") | @cat()
+
+		print(stdout == "", stderr != "", code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true true 0", output)
+	})
+}
+
+func TestInterpreterPrintfCallSpreadSuccess(t *testing.T) {
+	expected := []string{}
+
+	for _, value := range quotingCorpus {
+		expected = append(expected, fmt.Sprintf("[%s]", value))
+	}
+
+	interpret(t, `
+		values := []string{`+strings.Join(quotingCorpusLiterals(quotingCorpus), ", ")+`}
+		var stdout, stderr, code = @printf("[%s]\\n", values...)
+
+		print(stdout)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), output)
+	})
+}
+
+func TestInterpreterPrintfCallSpreadWithArgsSuccess(t *testing.T) {
+	interpret(t, `
+		values := []string{"b", "c"}
+		empty := []string{}
+		@printf("%s|", "a", values...) | @cat()
+		@printf("%s|\\n", "d", empty...)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a|b|c|d|", output)
+	})
+}
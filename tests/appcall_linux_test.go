@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -72,3 +73,97 @@ func TestLsCallFail(t *testing.T) {
 		require.NotEqual(t, "0", output)
 	})
 }
+
+func TestCallStderrSuccess(t *testing.T) {
+	transpileBashFunc(t, func(dir string) (string, error) {
+		bashFile := path.Join(dir, "stderr.sh")
+		err := os.WriteFile(bashFile, []byte("echo out; echo err1 >&2; echo err2 >&2; exit 3"), 0700)
+
+		if err != nil {
+			return "", err
+		}
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @"%s"()`, bashFile) + `
+
+			print(stdout)
+			print(stderr)
+			print(code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr1\nerr2\n3", output)
+	})
+}
+
+func TestCallPipeStderrSuccess(t *testing.T) {
+	transpileBash(t, `
+		var stdout, stderr, code = @sh("-c", "echo out; echo err1 >&2; exit 3") | @sh("-c", "cat; echo err2 >&2")
+
+		print(stdout)
+		print(stderr)
+		print(code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr1\nerr2\n0", output)
+	})
+}
+
+func TestLsCallPipeToGrepCallStderrSuccess(t *testing.T) {
+	transpileBashFunc(t, func(dir string) (string, error) {
+		return `
+			var stdout, stderr, code = @ls("not-present-dir") | @grep("x")
+
+			print(stdout == "", stderr != "")
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true true", output)
+	})
+}
+
+func TestPrintfCallQuotingSuccess(t *testing.T) {
+	expected := []string{}
+
+	for _, value := range quotingCorpus {
+		expected = append(expected, fmt.Sprintf("[%s]", value))
+	}
+
+	transpileBash(t, `
+		var stdout, stderr, code = @printf("[%s]\\n", `+strings.Join(quotingCorpusLiterals(quotingCorpus), ", ")+`)
+
+		print(stdout)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), output)
+	})
+}
+
+func TestPrintfCallSpreadSuccess(t *testing.T) {
+	expected := []string{}
+
+	for _, value := range quotingCorpus {
+		expected = append(expected, fmt.Sprintf("[%s]", value))
+	}
+
+	transpileBash(t, `
+		values := []string{`+strings.Join(quotingCorpusLiterals(quotingCorpus), ", ")+`}
+		var stdout, stderr, code = @printf("[%s]\\n", values...)
+
+		print(stdout)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), output)
+	})
+}
+
+func TestPrintfCallSpreadWithArgsSuccess(t *testing.T) {
+	transpileBash(t, `
+		values := []string{"b", "c"}
+		empty := []string{}
+		@printf("%s|", "a", values...) | @cat()
+		@printf("%s|\\n", "d", empty...)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a|b|c|d|", output)
+	})
+}
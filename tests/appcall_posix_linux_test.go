@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPosixLsCallSuccess(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @ls("%s")`, dir) + `
+
+			print(stdout, code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "test.sh\ntest.tsh 0", output)
+	})
+}
+
+func TestPosixLsCallPipeToGrepCallSuccess(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @ls("%s") | @grep(".tsh")`, dir) + `
+
+			print(stdout, code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "test.tsh 0", output)
+	})
+}
+
+func TestPosixShFileFromSubDirCallCallPipeToGrepCallSuccess(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		subdir := path.Join(dir, "subdir")
+		err := os.Mkdir(subdir, 0700)
+
+		if err != nil {
+			return "", err
+		}
+		shFile := path.Join(subdir, "script.sh")
+		err = os.WriteFile(shFile, []byte("ls $1"), 0700)
+
+		if err != nil {
+			return "", err
+		}
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @"%s"("%s") | @grep(".tsh")`, shFile, dir) + `
+
+			print(stdout, code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "test.tsh 0", output)
+	})
+}
+
+func TestPosixLsCallFail(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		return `
+			var stdout, stderr, code = @ls("not-present-dir")
+
+			print(code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.NotEqual(t, "0", output)
+	})
+}
+
+func TestPosixCallStderrSuccess(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		shFile := path.Join(dir, "stderr.sh")
+		err := os.WriteFile(shFile, []byte("echo out; echo err1 >&2; echo err2 >&2; exit 3"), 0700)
+
+		if err != nil {
+			return "", err
+		}
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @"%s"()`, shFile) + `
+
+			print(stdout)
+			print(stderr)
+			print(code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr1\nerr2\n3", output)
+	})
+}
+
+func TestPosixCallPipeStderrSuccess(t *testing.T) {
+	transpilePosix(t, `
+		var stdout, stderr, code = @sh("-c", "echo out; echo err1 >&2; exit 3") | @sh("-c", "cat; echo err2 >&2")
+
+		print(stdout)
+		print(stderr)
+		print(code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr1\nerr2\n0", output)
+	})
+}
+
+func TestPosixLsCallPipeToGrepCallStderrSuccess(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		return `
+			var stdout, stderr, code = @ls("not-present-dir") | @grep("x")
+
+			print(stdout == "", stderr != "")
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true true", output)
+	})
+}
+
+func TestPosixPrintfCallQuotingSuccess(t *testing.T) {
+	expected := []string{}
+
+	for _, value := range quotingCorpus {
+		expected = append(expected, fmt.Sprintf("[%s]", value))
+	}
+
+	transpilePosix(t, `
+		var stdout, stderr, code = @printf("[%s]\\n", `+strings.Join(quotingCorpusLiterals(quotingCorpus), ", ")+`)
+
+		print(stdout)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), output)
+	})
+}
+
+func TestPosixPrintfCallSpreadSuccess(t *testing.T) {
+	expected := []string{}
+
+	for _, value := range quotingCorpus {
+		expected = append(expected, fmt.Sprintf("[%s]", value))
+	}
+
+	transpilePosix(t, `
+		values := []string{`+strings.Join(quotingCorpusLiterals(quotingCorpus), ", ")+`}
+		var stdout, stderr, code = @printf("[%s]\\n", values...)
+
+		print(stdout)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), output)
+	})
+}
+
+func TestPosixPrintfCallSpreadWithArgsSuccess(t *testing.T) {
+	transpilePosix(t, `
+		values := []string{"b", "c"}
+		empty := []string{}
+		@printf("%s|", "a", values...) | @cat()
+		@printf("%s|\\n", "d", empty...)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a|b|c|d|", output)
+	})
+}
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPowerShellCallStderrSuccess(t *testing.T) {
+	transpilePowerShell(t, `
+		var stdout, stderr, code = @pwsh("-NoProfile", "-Command", "[Console]::Out.Write('out'); [Console]::Error.Write('err'); exit 3")
+
+		print(stdout)
+		print(stderr)
+		print(code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr\n3", output)
+	})
+}
+
+func TestPowerShellCallPipeSuccess(t *testing.T) {
+	transpilePowerShell(t, `
+		var stdout, stderr, code = @pwsh("-NoProfile", "-Command", "'a'; 'b'") | @pwsh("-NoProfile", "-Command", "$input | Select-Object -Last 1")
+
+		print(stdout, code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "b 0", output)
+	})
+}
+
+func TestPowerShellCallSpreadSuccess(t *testing.T) {
+	transpilePowerShell(t, `
+		args := []string{"-Command", "'a b'; 'c'"}
+		var stdout, stderr, code = @pwsh("-NoProfile", args...)
+
+		print(stdout, code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a b\nc 0", output)
+	})
+}
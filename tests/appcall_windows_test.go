@@ -73,3 +73,96 @@ func TestDirCallFail(t *testing.T) {
 		require.NotEqual(t, "0", output)
 	})
 }
+
+func TestCallStderrSuccess(t *testing.T) {
+	transpileBatchFunc(t, func(dir string) (string, error) {
+		batFile := path.Join(dir, "stderr.bat")
+		err := os.WriteFile(batFile, []byte("@echo out\r\n@(echo err1)1>&2\r\n@(echo err2)1>&2\r\n@exit /B 3"), 0700)
+
+		if err != nil {
+			return "", err
+		}
+		return `
+			` + fmt.Sprintf(`var stdout, stderr, code = @"%s"()`, strings.ReplaceAll(strings.ReplaceAll(batFile, `/`, `\`), `\`, `\\`)) + `
+
+			print(stdout)
+			print(stderr)
+			print(code)
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "out\nerr1\nerr2\n3", output)
+	})
+}
+
+func TestDirCallPipeToFindstrCallStderrSuccess(t *testing.T) {
+	transpileBatchFunc(t, func(dir string) (string, error) {
+		return `
+			var stdout, stderr, code = @dir("/B", "not-present-dir") | @findstr("x")
+
+			print(stdout == "", stderr != "")
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true true", output)
+	})
+}
+
+func TestEchoCallQuotingSuccess(t *testing.T) {
+	// Arguments are passed by reference and not parsed again. Values with double quotes are not tested because
+	// programs parse them themselves.
+	values := []string{"$(echo hi)", "${HOME}", "c`d`e", `x\y`, "*", "it's", "a;b", "!x!", "a!b", "%PATH%", "%%", "a^b", "^!", "&|<>()", "äö"}
+	expected := []string{}
+
+	for _, value := range values {
+		expected = append(expected, fmt.Sprintf(`"%s"`, value))
+	}
+
+	transpileBatch(t, `
+		var stdout, stderr, code = @echo(`+strings.Join(quotingCorpusLiterals(values), ", ")+`)
+
+		print(stdout)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, " "), output)
+	})
+}
+
+func TestEchoCallNotCapturedQuotingSuccess(t *testing.T) {
+	// Arguments are passed by reference and not parsed again. Values with double quotes are not tested because
+	// programs parse them themselves.
+	values := []string{"$(echo hi)", "${HOME}", "c`d`e", `x\y`, "*", "it's", "a;b", "!x!", "a!b", "%PATH%", "%%", "a^b", "^!", "&|<>()", "äö"}
+	expected := []string{}
+
+	for _, value := range values {
+		expected = append(expected, fmt.Sprintf(`"%s"`, value))
+	}
+
+	transpileBatch(t, `
+		@echo(`+strings.Join(quotingCorpusLiterals(values), ", ")+`)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, " "), output)
+	})
+}
+
+func TestEchoCallSpreadSuccess(t *testing.T) {
+	// Arguments are passed by reference and not parsed again. Values with double quotes are not tested because
+	// programs parse them themselves.
+	values := []string{"$(echo hi)", "${HOME}", "c`d`e", `x\y`, "*", "it's", "a;b", "!x!", "a!b", "%PATH%", "%%", "a^b", "^!", "&|<>()", "äö"}
+	expected := []string{}
+
+	for _, value := range values {
+		expected = append(expected, fmt.Sprintf(`"%s"`, value))
+	}
+
+	transpileBatch(t, `
+		values := []string{`+strings.Join(quotingCorpusLiterals(values), ", ")+`}
+		var stdout, stderr, code = @echo("a", values...)
+
+		print(stdout)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, `"a" `+strings.Join(expected, " "), output)
+	})
+}
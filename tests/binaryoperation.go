@@ -172,3 +172,29 @@ func testCompoundAssignmentModuloSuccess(t *testing.T, transpilerFunc transpiler
 		require.Equal(t, "0", output)
 	})
 }
+
+func testDivisionByZeroFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 0
+		print(4 / a)
+		print("got through")
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 1, exitCode(err))
+		require.Equal(t, "panic: division by zero at test.tsh:3:3", errorOutput(err))
+		require.Empty(t, output)
+	})
+}
+
+func testModuloByZeroFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 0
+		print(4 % a)
+		print("got through")
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 1, exitCode(err))
+		require.Equal(t, "panic: division by zero at test.tsh:3:3", errorOutput(err))
+		require.Empty(t, output)
+	})
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterAdditionSuccess(t *testing.T) {
+	testAdditionSuccess(t, interpret)
+}
+
+func TestInterpreterSubtractionSuccess(t *testing.T) {
+	testSubtractionSuccess(t, interpret)
+}
+
+func TestInterpreterMultiplicationSuccess(t *testing.T) {
+	testMultiplicationSuccess(t, interpret)
+}
+
+func TestInterpreterDivisionSuccess(t *testing.T) {
+	testDivisionSuccess(t, interpret)
+}
+
+func TestInterpreterModuloSuccess(t *testing.T) {
+	testModuloSuccess(t, interpret)
+}
+
+func TestInterpreterMoreComplexCalculationSuccess(t *testing.T) {
+	testMoreComplexCalculationSuccess(t, interpret)
+}
+
+func TestInterpreterMoreComplexCalculationWithBracketsSuccess(t *testing.T) {
+	testMoreComplexCalculationWithBracketsSuccess(t, interpret)
+}
+
+func TestInterpreterComplexCalculationSuccess(t *testing.T) {
+	testComplexCalculationSuccess(t, interpret)
+}
+
+func TestInterpreterCompoundAssignmentAdditionSuccess(t *testing.T) {
+	testCompoundAssignmentAdditionSuccess(t, interpret)
+}
+
+func TestInterpreterCompoundAssignmentSubtractionSuccess(t *testing.T) {
+	testCompoundAssignmentSubtractionSuccess(t, interpret)
+}
+
+func TestInterpreterCompoundAssignmentMultiplicationSuccess(t *testing.T) {
+	testCompoundAssignmentMultiplicationSuccess(t, interpret)
+}
+
+func TestInterpreterCompoundAssignmentDivisionSuccess(t *testing.T) {
+	testCompoundAssignmentDivisionSuccess(t, interpret)
+}
+
+func TestInterpreterCompoundAssignmentModuloSuccess(t *testing.T) {
+	testCompoundAssignmentModuloSuccess(t, interpret)
+}
+
+func TestInterpreterDivisionByZeroFail(t *testing.T) {
+	testDivisionByZeroFail(t, interpret)
+}
+
+func TestInterpreterModuloByZeroFail(t *testing.T) {
+	testModuloByZeroFail(t, interpret)
+}
@@ -55,3 +55,11 @@ func TestCompoundAssignmentDivisionSuccess(t *testing.T) {
 func TestCompoundAssignmentModuloSuccess(t *testing.T) {
 	testCompoundAssignmentModuloSuccess(t, transpileBash)
 }
+
+func TestDivisionByZeroFail(t *testing.T) {
+	testDivisionByZeroFail(t, transpileBash)
+}
+
+func TestModuloByZeroFail(t *testing.T) {
+	testModuloByZeroFail(t, transpileBash)
+}
@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixAdditionSuccess(t *testing.T) {
+	testAdditionSuccess(t, transpilePosix)
+}
+
+func TestPosixSubtractionSuccess(t *testing.T) {
+	testSubtractionSuccess(t, transpilePosix)
+}
+
+func TestPosixMultiplicationSuccess(t *testing.T) {
+	testMultiplicationSuccess(t, transpilePosix)
+}
+
+func TestPosixDivisionSuccess(t *testing.T) {
+	testDivisionSuccess(t, transpilePosix)
+}
+
+func TestPosixModuloSuccess(t *testing.T) {
+	testModuloSuccess(t, transpilePosix)
+}
+
+func TestPosixMoreComplexCalculationSuccess(t *testing.T) {
+	testMoreComplexCalculationSuccess(t, transpilePosix)
+}
+
+func TestPosixMoreComplexCalculationWithBracketsSuccess(t *testing.T) {
+	testMoreComplexCalculationWithBracketsSuccess(t, transpilePosix)
+}
+
+func TestPosixComplexCalculationSuccess(t *testing.T) {
+	testComplexCalculationSuccess(t, transpilePosix)
+}
+
+func TestPosixCompoundAssignmentAdditionSuccess(t *testing.T) {
+	testCompoundAssignmentAdditionSuccess(t, transpilePosix)
+}
+
+func TestPosixCompoundAssignmentSubtractionSuccess(t *testing.T) {
+	testCompoundAssignmentSubtractionSuccess(t, transpilePosix)
+}
+
+func TestPosixCompoundAssignmentMultiplicationSuccess(t *testing.T) {
+	testCompoundAssignmentMultiplicationSuccess(t, transpilePosix)
+}
+
+func TestPosixCompoundAssignmentDivisionSuccess(t *testing.T) {
+	testCompoundAssignmentDivisionSuccess(t, transpilePosix)
+}
+
+func TestPosixCompoundAssignmentModuloSuccess(t *testing.T) {
+	testCompoundAssignmentModuloSuccess(t, transpilePosix)
+}
+
+func TestPosixDivisionByZeroFail(t *testing.T) {
+	testDivisionByZeroFail(t, transpilePosix)
+}
+
+func TestPosixModuloByZeroFail(t *testing.T) {
+	testModuloByZeroFail(t, transpilePosix)
+}
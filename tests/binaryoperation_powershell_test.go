@@ -0,0 +1,65 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellAdditionSuccess(t *testing.T) {
+	testAdditionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSubtractionSuccess(t *testing.T) {
+	testSubtractionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMultiplicationSuccess(t *testing.T) {
+	testMultiplicationSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellDivisionSuccess(t *testing.T) {
+	testDivisionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellModuloSuccess(t *testing.T) {
+	testModuloSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMoreComplexCalculationSuccess(t *testing.T) {
+	testMoreComplexCalculationSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMoreComplexCalculationWithBracketsSuccess(t *testing.T) {
+	testMoreComplexCalculationWithBracketsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellComplexCalculationSuccess(t *testing.T) {
+	testComplexCalculationSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCompoundAssignmentAdditionSuccess(t *testing.T) {
+	testCompoundAssignmentAdditionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCompoundAssignmentSubtractionSuccess(t *testing.T) {
+	testCompoundAssignmentSubtractionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCompoundAssignmentMultiplicationSuccess(t *testing.T) {
+	testCompoundAssignmentMultiplicationSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCompoundAssignmentDivisionSuccess(t *testing.T) {
+	testCompoundAssignmentDivisionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCompoundAssignmentModuloSuccess(t *testing.T) {
+	testCompoundAssignmentModuloSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellDivisionByZeroFail(t *testing.T) {
+	testDivisionByZeroFail(t, transpilePowerShell)
+}
+
+func TestPowerShellModuloByZeroFail(t *testing.T) {
+	testModuloByZeroFail(t, transpilePowerShell)
+}
@@ -55,3 +55,11 @@ func TestCompoundAssignmentDivisionSuccess(t *testing.T) {
 func TestCompoundAssignmentModuloSuccess(t *testing.T) {
 	testCompoundAssignmentModuloSuccess(t, transpileBatch)
 }
+
+func TestDivisionByZeroFail(t *testing.T) {
+	testDivisionByZeroFail(t, transpileBatch)
+}
+
+func TestModuloByZeroFail(t *testing.T) {
+	testModuloByZeroFail(t, transpileBatch)
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -61,6 +62,21 @@ func testReadSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 	})
 }
 
+func testReadLfLinesSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	file := "read-test.txt"
+	content := "a\n\n!b! ^c \"d\" %e%\n" + strings.Repeat("f", 2000)
+	os.WriteFile(file, []byte(content), 0700)
+	defer os.Remove(file)
+
+	transpilerFunc(t, `
+		`+fmt.Sprintf(`a := read("%s")`, file)+`
+		print(a)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, content, output)
+	})
+}
+
 func testWriteSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 	file := "read-test.txt"
 	content := "Hello Moon"
@@ -92,6 +108,16 @@ func testWriteAppendSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 	})
 }
 
+func testWriteFailSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		write("missing-dir/read-test.txt", "Hello Moon")
+		print("got through")
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "got through", output)
+	})
+}
+
 func testPanicSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 	transpilerFunc(t, `
 		var a = 1
@@ -102,7 +128,9 @@ func testPanicSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print("got through")
 	`, func(output string, err error) {
 		require.Error(t, err)
-		require.Equal(t, "panic: panic", output)
+		require.Equal(t, 1, exitCode(err))
+		require.Equal(t, "panic: panic at test.tsh:5:4", errorOutput(err))
+		require.Empty(t, output)
 	})
 }
 
@@ -202,6 +230,133 @@ func testPanicInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		test()
 	`, func(output string, err error) {
 		require.Error(t, err)
-		require.Equal(t, "panic: panic", output)
+		require.Equal(t, 1, exitCode(err))
+		require.Equal(t, "panic: panic at test.tsh:6:5", errorOutput(err))
+		require.Empty(t, output)
+	})
+}
+
+func testArgsSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, `
+		a := args()
+
+		print(len(a))
+
+		for i := 0; i < len(a); i++ {
+			print(a[i] + ";")
+		}
+	`, []string{"a", "b c", "", "--d=1"}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "4\na;\nb c;\n;\n--d=1;", output)
+	})
+}
+
+func testArgsInFunctionSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, `
+		func first(s string) string {
+			return args()[0] + s
+		}
+		print(first("b"))
+	`, []string{"a"}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "ab", output)
+	})
+}
+
+func testNoArgsSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, `
+		print(len(args()))
+	`, []string{}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "0", output)
+	})
+}
+
+func testExitSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print("before")
+		exit(3)
+		print("after")
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 3, exitCode(err))
+		require.Equal(t, "before", output)
+	})
+}
+
+func testExitZeroSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print("before")
+		exit(0)
+		print("after")
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "before", output)
+	})
+}
+
+func testExitInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func inner(code int) {
+			exit(code)
+			print("after inner exit")
+		}
+
+		func outer() int {
+			inner(4)
+			print("after inner call")
+			return 1
+		}
+		v := outer()
+		print("after outer call", v)
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 4, exitCode(err))
+		require.Empty(t, output)
+	})
+}
+
+func testExitNonIntFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		exit("1")
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected exit code int")
+	})
+}
+
+func testPrintBoolSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := true
+		print(a, false, 1 == 1)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true false true", output)
+	})
+}
+
+func testPrintnSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		printn("a")
+		printn("b", 1)
+		print("c")
+		printn(true)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "ab 1c\ntrue", output)
+	})
+}
+
+func testEprintSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print("out")
+		eprint("err", 1)
+		eprintn("err")
+		eprint(false)
+		exit(2)
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 2, exitCode(err))
+		require.Equal(t, "err 1\nerrfalse", errorOutput(err))
+		require.Equal(t, "out", output)
 	})
 }
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterpreterLenSliceSuccess(t *testing.T) {
+	testLenSliceSuccess(t, interpret)
+}
+
+func TestInterpreterLenStringSuccess(t *testing.T) {
+	testLenStringSuccess(t, interpret)
+}
+
+func TestInterpreterCopySuccess(t *testing.T) {
+	testCopySuccess(t, interpret)
+}
+
+func TestInterpreterExistsSuccess(t *testing.T) {
+	interpretFunc(t, func(dir string) (string, error) {
+		return fmt.Sprintf(`print(exists("%s"))`, strings.ReplaceAll(dir, `\`, `\\`)), nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true", output)
+	})
+}
+
+func TestInterpreterReadSuccess(t *testing.T) {
+	testReadSuccess(t, interpret)
+}
+
+func TestInterpreterReadLfLinesSuccess(t *testing.T) {
+	testReadLfLinesSuccess(t, interpret)
+}
+
+func TestInterpreterWriteSuccess(t *testing.T) {
+	testWriteSuccess(t, interpret)
+}
+
+func TestInterpreterWriteAppendSuccess(t *testing.T) {
+	testWriteAppendSuccess(t, interpret)
+}
+
+func TestInterpreterPanicSuccess(t *testing.T) {
+	testPanicSuccess(t, interpret)
+}
+
+func TestInterpreterLenSliceInFunctionSuccess(t *testing.T) {
+	testLenSliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterLenStringInFunctionSuccess(t *testing.T) {
+	testLenStringInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterCopyInFunctionSuccess(t *testing.T) {
+	testCopyInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterExistsInFunctionSuccess(t *testing.T) {
+	interpretFunc(t, func(dir string) (string, error) {
+		return `
+			func test() {
+			` + fmt.Sprintf(`print(exists("%s"))`, strings.ReplaceAll(dir, `\`, `\\`)) + `
+			}
+			test()
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true", output)
+	})
+}
+
+func TestInterpreterReadInFunctionSuccess(t *testing.T) {
+	testReadInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterWriteInFunctionSuccess(t *testing.T) {
+	testWriteInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterPanicInFunctionSuccess(t *testing.T) {
+	testPanicInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterArgsSuccess(t *testing.T) {
+	testArgsSuccess(t, interpretArgs)
+}
+
+func TestInterpreterArgsInFunctionSuccess(t *testing.T) {
+	testArgsInFunctionSuccess(t, interpretArgs)
+}
+
+func TestInterpreterNoArgsSuccess(t *testing.T) {
+	testNoArgsSuccess(t, interpretArgs)
+}
+
+func TestInterpreterExitSuccess(t *testing.T) {
+	testExitSuccess(t, interpret)
+}
+
+func TestInterpreterExitZeroSuccess(t *testing.T) {
+	testExitZeroSuccess(t, interpret)
+}
+
+func TestInterpreterExitInFunctionSuccess(t *testing.T) {
+	testExitInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterExitNonIntFail(t *testing.T) {
+	testExitNonIntFail(t, interpret)
+}
+
+func TestInterpreterPrintBoolSuccess(t *testing.T) {
+	testPrintBoolSuccess(t, interpret)
+}
+
+func TestInterpreterPrintnSuccess(t *testing.T) {
+	testPrintnSuccess(t, interpret)
+}
+
+func TestInterpreterEprintSuccess(t *testing.T) {
+	testEprintSuccess(t, interpret)
+}
+
+func TestInterpreterWriteFailSuccess(t *testing.T) {
+	testWriteFailSuccess(t, interpret)
+}
@@ -24,7 +24,7 @@ func TestExistsSuccess(t *testing.T) {
 		return fmt.Sprintf(`print(exists("%s"))`, dir), nil
 	}, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -32,6 +32,10 @@ func TestReadSuccess(t *testing.T) {
 	testReadSuccess(t, transpileBash)
 }
 
+func TestReadLfLinesSuccess(t *testing.T) {
+	testReadLfLinesSuccess(t, transpileBash)
+}
+
 func TestWriteSuccess(t *testing.T) {
 	testWriteSuccess(t, transpileBash)
 }
@@ -66,7 +70,7 @@ func TestExistsInFunctionSuccess(t *testing.T) {
 		`, nil
 	}, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -81,3 +85,47 @@ func TestWriteInFunctionSuccess(t *testing.T) {
 func TestPanicInFunctionSuccess(t *testing.T) {
 	testPanicInFunctionSuccess(t, transpileBash)
 }
+
+func TestArgsSuccess(t *testing.T) {
+	testArgsSuccess(t, transpileBashArgs)
+}
+
+func TestArgsInFunctionSuccess(t *testing.T) {
+	testArgsInFunctionSuccess(t, transpileBashArgs)
+}
+
+func TestNoArgsSuccess(t *testing.T) {
+	testNoArgsSuccess(t, transpileBashArgs)
+}
+
+func TestExitSuccess(t *testing.T) {
+	testExitSuccess(t, transpileBash)
+}
+
+func TestExitZeroSuccess(t *testing.T) {
+	testExitZeroSuccess(t, transpileBash)
+}
+
+func TestExitInFunctionSuccess(t *testing.T) {
+	testExitInFunctionSuccess(t, transpileBash)
+}
+
+func TestExitNonIntFail(t *testing.T) {
+	testExitNonIntFail(t, transpileBash)
+}
+
+func TestPrintBoolSuccess(t *testing.T) {
+	testPrintBoolSuccess(t, transpileBash)
+}
+
+func TestPrintnSuccess(t *testing.T) {
+	testPrintnSuccess(t, transpileBash)
+}
+
+func TestEprintSuccess(t *testing.T) {
+	testEprintSuccess(t, transpileBash)
+}
+
+func TestWriteFailSuccess(t *testing.T) {
+	testWriteFailSuccess(t, transpileBash)
+}
@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPosixLenSliceSuccess(t *testing.T) {
+	testLenSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixLenStringSuccess(t *testing.T) {
+	testLenStringSuccess(t, transpilePosix)
+}
+
+func TestPosixCopySuccess(t *testing.T) {
+	testCopySuccess(t, transpilePosix)
+}
+
+func TestPosixExistsSuccess(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		return fmt.Sprintf(`print(exists("%s"))`, dir), nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true", output)
+	})
+}
+
+func TestPosixReadSuccess(t *testing.T) {
+	testReadSuccess(t, transpilePosix)
+}
+
+func TestPosixReadLfLinesSuccess(t *testing.T) {
+	testReadLfLinesSuccess(t, transpilePosix)
+}
+
+func TestPosixWriteSuccess(t *testing.T) {
+	testWriteSuccess(t, transpilePosix)
+}
+
+func TestPosixWriteAppendSuccess(t *testing.T) {
+	testWriteAppendSuccess(t, transpilePosix)
+}
+
+func TestPosixPanicSuccess(t *testing.T) {
+	testPanicSuccess(t, transpilePosix)
+}
+
+func TestPosixLenSliceInFunctionSuccess(t *testing.T) {
+	testLenSliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixLenStringInFunctionSuccess(t *testing.T) {
+	testLenStringInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixCopyInFunctionSuccess(t *testing.T) {
+	testCopyInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixExistsInFunctionSuccess(t *testing.T) {
+	transpilePosixFunc(t, func(dir string) (string, error) {
+		return `
+			func test() {
+			` + fmt.Sprintf(`print(exists("%s"))`, dir) + `
+			}
+			test()
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true", output)
+	})
+}
+
+func TestPosixReadInFunctionSuccess(t *testing.T) {
+	testReadInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixWriteInFunctionSuccess(t *testing.T) {
+	testWriteInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixPanicInFunctionSuccess(t *testing.T) {
+	testPanicInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixArgsSuccess(t *testing.T) {
+	testArgsSuccess(t, transpilePosixArgs)
+}
+
+func TestPosixArgsInFunctionSuccess(t *testing.T) {
+	testArgsInFunctionSuccess(t, transpilePosixArgs)
+}
+
+func TestPosixNoArgsSuccess(t *testing.T) {
+	testNoArgsSuccess(t, transpilePosixArgs)
+}
+
+func TestPosixExitSuccess(t *testing.T) {
+	testExitSuccess(t, transpilePosix)
+}
+
+func TestPosixExitZeroSuccess(t *testing.T) {
+	testExitZeroSuccess(t, transpilePosix)
+}
+
+func TestPosixExitInFunctionSuccess(t *testing.T) {
+	testExitInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixExitNonIntFail(t *testing.T) {
+	testExitNonIntFail(t, transpilePosix)
+}
+
+func TestPosixPrintBoolSuccess(t *testing.T) {
+	testPrintBoolSuccess(t, transpilePosix)
+}
+
+func TestPosixPrintnSuccess(t *testing.T) {
+	testPrintnSuccess(t, transpilePosix)
+}
+
+func TestPosixEprintSuccess(t *testing.T) {
+	testEprintSuccess(t, transpilePosix)
+}
+
+func TestPosixWriteFailSuccess(t *testing.T) {
+	testWriteFailSuccess(t, transpilePosix)
+}
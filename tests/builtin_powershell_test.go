@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPowerShellLenSliceSuccess(t *testing.T) {
+	testLenSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellLenStringSuccess(t *testing.T) {
+	testLenStringSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCopySuccess(t *testing.T) {
+	testCopySuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellExistsSuccess(t *testing.T) {
+	transpilePowerShellFunc(t, func(dir string) (string, error) {
+		return fmt.Sprintf(`print(exists("%s"))`, strings.ReplaceAll(dir, `\`, `\\`)), nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true", output)
+	})
+}
+
+func TestPowerShellReadSuccess(t *testing.T) {
+	testReadSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellReadLfLinesSuccess(t *testing.T) {
+	testReadLfLinesSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellWriteSuccess(t *testing.T) {
+	testWriteSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellWriteAppendSuccess(t *testing.T) {
+	testWriteAppendSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellPanicSuccess(t *testing.T) {
+	testPanicSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellLenSliceInFunctionSuccess(t *testing.T) {
+	testLenSliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellLenStringInFunctionSuccess(t *testing.T) {
+	testLenStringInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCopyInFunctionSuccess(t *testing.T) {
+	testCopyInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellExistsInFunctionSuccess(t *testing.T) {
+	transpilePowerShellFunc(t, func(dir string) (string, error) {
+		return `
+			func test() {
+			` + fmt.Sprintf(`print(exists("%s"))`, strings.ReplaceAll(dir, `\`, `\\`)) + `
+			}
+			test()
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true", output)
+	})
+}
+
+func TestPowerShellReadInFunctionSuccess(t *testing.T) {
+	testReadInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellWriteInFunctionSuccess(t *testing.T) {
+	testWriteInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellPanicInFunctionSuccess(t *testing.T) {
+	testPanicInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellArgsSuccess(t *testing.T) {
+	testArgsSuccess(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellArgsInFunctionSuccess(t *testing.T) {
+	testArgsInFunctionSuccess(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellNoArgsSuccess(t *testing.T) {
+	testNoArgsSuccess(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellExitSuccess(t *testing.T) {
+	testExitSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellExitZeroSuccess(t *testing.T) {
+	testExitZeroSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellExitInFunctionSuccess(t *testing.T) {
+	testExitInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellExitNonIntFail(t *testing.T) {
+	testExitNonIntFail(t, transpilePowerShell)
+}
+
+func TestPowerShellPrintBoolSuccess(t *testing.T) {
+	testPrintBoolSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellPrintnSuccess(t *testing.T) {
+	testPrintnSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellEprintSuccess(t *testing.T) {
+	testEprintSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellWriteFailSuccess(t *testing.T) {
+	testWriteFailSuccess(t, transpilePowerShell)
+}
@@ -25,7 +25,7 @@ func TestExistsSuccess(t *testing.T) {
 		return fmt.Sprintf(`print(exists("%s"))`, strings.ReplaceAll(dir, `\`, `\\`)), nil
 	}, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -33,6 +33,10 @@ func TestReadSuccess(t *testing.T) {
 	testReadSuccess(t, transpileBatch)
 }
 
+func TestReadLfLinesSuccess(t *testing.T) {
+	testReadLfLinesSuccess(t, transpileBatch)
+}
+
 func TestWriteSuccess(t *testing.T) {
 	testWriteSuccess(t, transpileBatch)
 }
@@ -67,7 +71,7 @@ func TestExistsInFunctionSuccess(t *testing.T) {
 		`, nil
 	}, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -82,3 +86,47 @@ func TestWriteInFunctionSuccess(t *testing.T) {
 func TestPanicInFunctionSuccess(t *testing.T) {
 	testPanicInFunctionSuccess(t, transpileBatch)
 }
+
+func TestArgsSuccess(t *testing.T) {
+	testArgsSuccess(t, transpileBatchArgs)
+}
+
+func TestArgsInFunctionSuccess(t *testing.T) {
+	testArgsInFunctionSuccess(t, transpileBatchArgs)
+}
+
+func TestNoArgsSuccess(t *testing.T) {
+	testNoArgsSuccess(t, transpileBatchArgs)
+}
+
+func TestExitSuccess(t *testing.T) {
+	testExitSuccess(t, transpileBatch)
+}
+
+func TestExitZeroSuccess(t *testing.T) {
+	testExitZeroSuccess(t, transpileBatch)
+}
+
+func TestExitInFunctionSuccess(t *testing.T) {
+	testExitInFunctionSuccess(t, transpileBatch)
+}
+
+func TestExitNonIntFail(t *testing.T) {
+	testExitNonIntFail(t, transpileBatch)
+}
+
+func TestPrintBoolSuccess(t *testing.T) {
+	testPrintBoolSuccess(t, transpileBatch)
+}
+
+func TestPrintnSuccess(t *testing.T) {
+	testPrintnSuccess(t, transpileBatch)
+}
+
+func TestEprintSuccess(t *testing.T) {
+	testEprintSuccess(t, transpileBatch)
+}
+
+func TestWriteFailSuccess(t *testing.T) {
+	testWriteFailSuccess(t, transpileBatch)
+}
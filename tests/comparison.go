@@ -11,7 +11,7 @@ func testIntEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(2 == 2)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -20,7 +20,7 @@ func testIntNotEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(2 != 1)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -29,7 +29,7 @@ func testIntLessSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(1 < 2)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -38,7 +38,7 @@ func testIntLessOrEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(2 <= 2)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -47,7 +47,7 @@ func testIntGreaterSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(2 > 1)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -56,7 +56,7 @@ func testIntGreaterOrEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(2 >= 2)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -67,7 +67,7 @@ func testComplexIntComparisonSuccess(t *testing.T, transpilerFunc transpilerFunc
 		print(a)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -76,7 +76,7 @@ func testStringEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print("test" == "test")
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -85,7 +85,7 @@ func testStringNotEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print("test" != "no test")
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -94,7 +94,7 @@ func testBooleanEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(true == true)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -103,6 +103,6 @@ func testBooleanNotEqualSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(true != false)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterIntEqualSuccess(t *testing.T) {
+	testIntEqualSuccess(t, interpret)
+}
+
+func TestInterpreterIntNotEqualSuccess(t *testing.T) {
+	testIntNotEqualSuccess(t, interpret)
+}
+
+func TestInterpreterIntLessSuccess(t *testing.T) {
+	testIntLessSuccess(t, interpret)
+}
+
+func TestInterpreterIntLessOrEqualSuccess(t *testing.T) {
+	testIntLessOrEqualSuccess(t, interpret)
+}
+
+func TestInterpreterIntGreaterSuccess(t *testing.T) {
+	testIntGreaterSuccess(t, interpret)
+}
+
+func TestInterpreterIntGreaterOrEqualSuccess(t *testing.T) {
+	testIntGreaterOrEqualSuccess(t, interpret)
+}
+
+func TestInterpreterComplexIntComparisonSuccess(t *testing.T) {
+	testComplexIntComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterStringEqualSuccess(t *testing.T) {
+	testStringEqualSuccess(t, interpret)
+}
+
+func TestInterpreterStringNotEqualSuccess(t *testing.T) {
+	testStringNotEqualSuccess(t, interpret)
+}
+
+func TestInterpreterBooleanEqualSuccess(t *testing.T) {
+	testBooleanEqualSuccess(t, interpret)
+}
+
+func TestInterpreterBooleanNotEqualSuccess(t *testing.T) {
+	testBooleanNotEqualSuccess(t, interpret)
+}
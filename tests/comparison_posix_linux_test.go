@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixIntEqualSuccess(t *testing.T) {
+	testIntEqualSuccess(t, transpilePosix)
+}
+
+func TestPosixIntNotEqualSuccess(t *testing.T) {
+	testIntNotEqualSuccess(t, transpilePosix)
+}
+
+func TestPosixIntLessSuccess(t *testing.T) {
+	testIntLessSuccess(t, transpilePosix)
+}
+
+func TestPosixIntLessOrEqualSuccess(t *testing.T) {
+	testIntLessOrEqualSuccess(t, transpilePosix)
+}
+
+func TestPosixIntGreaterSuccess(t *testing.T) {
+	testIntGreaterSuccess(t, transpilePosix)
+}
+
+func TestPosixIntGreaterOrEqualSuccess(t *testing.T) {
+	testIntGreaterOrEqualSuccess(t, transpilePosix)
+}
+
+func TestPosixComplexIntComparisonSuccess(t *testing.T) {
+	testComplexIntComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixStringEqualSuccess(t *testing.T) {
+	testStringEqualSuccess(t, transpilePosix)
+}
+
+func TestPosixStringNotEqualSuccess(t *testing.T) {
+	testStringNotEqualSuccess(t, transpilePosix)
+}
+
+func TestPosixBooleanEqualSuccess(t *testing.T) {
+	testBooleanEqualSuccess(t, transpilePosix)
+}
+
+func TestPosixBooleanNotEqualSuccess(t *testing.T) {
+	testBooleanNotEqualSuccess(t, transpilePosix)
+}
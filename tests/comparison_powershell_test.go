@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellIntEqualSuccess(t *testing.T) {
+	testIntEqualSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIntNotEqualSuccess(t *testing.T) {
+	testIntNotEqualSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIntLessSuccess(t *testing.T) {
+	testIntLessSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIntLessOrEqualSuccess(t *testing.T) {
+	testIntLessOrEqualSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIntGreaterSuccess(t *testing.T) {
+	testIntGreaterSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIntGreaterOrEqualSuccess(t *testing.T) {
+	testIntGreaterOrEqualSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellComplexIntComparisonSuccess(t *testing.T) {
+	testComplexIntComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringEqualSuccess(t *testing.T) {
+	testStringEqualSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringNotEqualSuccess(t *testing.T) {
+	testStringNotEqualSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellBooleanEqualSuccess(t *testing.T) {
+	testBooleanEqualSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellBooleanNotEqualSuccess(t *testing.T) {
+	testBooleanNotEqualSuccess(t, transpilePowerShell)
+}
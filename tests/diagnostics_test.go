@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/monstermichl/typeshell/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func parseDiagnostics(t *testing.T, source string) parser.Diagnostics {
+	p := parser.New()
+	_, err := p.ParseSource("test.tsh", source)
+
+	var diagnostics parser.Diagnostics
+	require.True(t, errors.As(err, &diagnostics))
+
+	return diagnostics
+}
+
+func TestDiagnosticsMultipleErrorsSuccess(t *testing.T) {
+	diagnostics := parseDiagnostics(t, `var a int = "1"
+func f() {
+	print(b)
+	var c string = 1
+}
+print(d)
+`)
+
+	require.Len(t, diagnostics, 4)
+	expected := []struct {
+		message string
+		row     int
+		column  int
+	}{
+		{"expected int but got string", 1, 13},
+		{"variable b has not been defined", 3, 8},
+		{"expected string but got int", 4, 17},
+		{"variable d has not been defined", 6, 7},
+	}
+
+	for i, e := range expected {
+		require.Equal(t, e.message, diagnostics[i].Message())
+		require.Equal(t, "test.tsh", diagnostics[i].Path())
+		require.Equal(t, e.row, diagnostics[i].Row())
+		require.Equal(t, e.column, diagnostics[i].Column())
+		require.Equal(t, parser.SEVERITY_ERROR, diagnostics[i].Severity())
+	}
+}
+
+func TestDiagnosticsSpanSuccess(t *testing.T) {
+	diagnostics := parseDiagnostics(t, `print(unknown)`)
+
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, 1, diagnostics[0].EndRow())
+	require.Equal(t, 14, diagnostics[0].EndColumn())
+	require.Equal(t, parser.DIAGNOSTIC_CODE_SEMANTIC, diagnostics[0].Code())
+}
+
+func TestDiagnosticsLexicalErrorSuccess(t *testing.T) {
+	diagnostics := parseDiagnostics(t, "a := 1\nb := \"")
+
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, "string has not been terminated", diagnostics[0].Message())
+	require.Equal(t, 2, diagnostics[0].Row())
+	require.Equal(t, 6, diagnostics[0].Column())
+	require.Equal(t, parser.DIAGNOSTIC_CODE_LEXICAL, diagnostics[0].Code())
+}
+
+func TestDiagnosticsLimitSuccess(t *testing.T) {
+	diagnostics := parseDiagnostics(t, strings.Repeat("print(a)\n", 20))
+
+	require.Len(t, diagnostics, 10)
+	require.Equal(t, 10, diagnostics[9].Row())
+}
+
+func TestDiagnosticsErrorFormatSuccess(t *testing.T) {
+	diagnostics := parseDiagnostics(t, "print(a)\nprint(b)")
+
+	require.EqualError(t, diagnostics, "test.tsh:1:7: variable a has not been defined\ntest.tsh:2:7: variable b has not been defined")
+}
+
+func TestDiagnosticsImportErrorSuccess(t *testing.T) {
+	lib := filepath.Join(t.TempDir(), "lib.tsh")
+	err := os.WriteFile(lib, []byte("func Foo() {\n\tprint(a)\n\tprint(b)\n}"), 0700)
+
+	require.Nil(t, err)
+
+	// All errors of the imported file are reported and parsing of the importing file continues.
+	diagnostics := parseDiagnostics(t, "import (\n\tlib \""+filepath.ToSlash(lib)+"\"\n)\n\nlib.Foo()\nprint(c)")
+
+	require.Len(t, diagnostics, 3)
+	require.Equal(t, lib, diagnostics[0].Path())
+	require.Equal(t, "variable a has not been defined", diagnostics[0].Message())
+	require.Equal(t, lib, diagnostics[1].Path())
+	require.Equal(t, "variable b has not been defined", diagnostics[1].Message())
+	require.Equal(t, "test.tsh", diagnostics[2].Path())
+	require.Equal(t, "variable c has not been defined", diagnostics[2].Message())
+}
+
+func TestDiagnosticsImportAliasErrorSuccess(t *testing.T) {
+	lib := filepath.Join(t.TempDir(), "lib.tsh")
+	err := os.WriteFile(lib, []byte("func Foo() {\n}"), 0700)
+
+	require.Nil(t, err)
+
+	// Parsing continues after an alias error.
+	diagnostics := parseDiagnostics(t, "import (\n\t\""+filepath.ToSlash(lib)+"\"\n\tlib \""+filepath.ToSlash(lib)+"\"\n\tlib \""+filepath.ToSlash(lib)+"\"\n)\n\nprint(c)")
+
+	require.Len(t, diagnostics, 3)
+	require.Equal(t, fmt.Sprintf(`an alias must be provided for the local import "%s"`, filepath.ToSlash(lib)), diagnostics[0].Message())
+	require.Equal(t, 2, diagnostics[0].Row())
+	require.Equal(t, parser.DIAGNOSTIC_CODE_IMPORT, diagnostics[0].Code())
+	require.Equal(t, `import alias "lib" already exists`, diagnostics[1].Message())
+	require.Equal(t, 4, diagnostics[1].Row())
+	require.Equal(t, "variable c has not been defined", diagnostics[2].Message())
+}
+
+func TestDiagnosticsImportLimitSuccess(t *testing.T) {
+	lib := filepath.Join(t.TempDir(), "lib.tsh")
+	err := os.WriteFile(lib, []byte(strings.Repeat("print(a)\n", 8)), 0700)
+
+	require.Nil(t, err)
+	diagnostics := parseDiagnostics(t, "import (\n\tlib \""+filepath.ToSlash(lib)+"\"\n\tlib2 \""+filepath.ToSlash(lib)+"\"\n)")
+
+	require.Len(t, diagnostics, 10)
+}
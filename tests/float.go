@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testFloatDefinitionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		var a float64 = 1.50
+		var b float64
+		c := 0.125
+
+		print(a, b, c)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "1.5 0 0.125", output)
+	})
+}
+
+func testFloatArithmeticSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 1.5
+		b := 2.25
+
+		print(a + b, a - b, a * b, a / b)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "3.75 -0.75 3.375 0.666667", output)
+	})
+}
+
+func testFloatNegativeArithmeticSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 0.5 - 2.0
+		b := a * -1.25
+
+		print(a, b, b / a, a * 0.0)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "-1.5 1.875 -1.25 0", output)
+	})
+}
+
+func testFloatLargeArithmeticSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(12345.678 * 1000.5, 2000000.0 / 3.0, 1999999.999999 + 0.000001)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "12351850.839 666666.666667 2000000", output)
+	})
+}
+
+func testFloatPercentageSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		done := 37
+		total := 120
+		percentage := float64(done) * 100.0 / float64(total)
+
+		print(percentage, int(percentage))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "30.833333 30", output)
+	})
+}
+
+func testFloatAssignmentOperatorsSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 10.0
+		a /= 4.0
+		a += 0.25
+		a *= 2.0
+		a -= 1.0
+
+		print(a)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "4.5", output)
+	})
+}
+
+func testFloatComparisonSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(1.5 < 2.25, 1.5 == 1.50, 2.0 >= 2.25, 0.1 + 0.2 == 0.3, -1.5 <= -1.25, 1.5 != 1.5, 10.5 > 9.75)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true true false true true false true", output)
+	})
+}
+
+func testFloatToIntSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 2.75
+
+		print(int(a), int(0.0 - a), int(0.5))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 -2 0", output)
+	})
+}
+
+func testIntToFloatSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 3
+
+		print(float64(a) / 2.0, float64(0 - a))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "1.5 -3", output)
+	})
+}
+
+func testFtoaSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(ftoa(1.0 / 8.0) + "s")
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "0.125s", output)
+	})
+}
+
+func testFloatModuloFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(1.5 % 1.0)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), `expected valid float64 operator but got "%"`)
+	})
+}
+
+func testFloatIntMixFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(1.5 + 1)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected same binary operation types but got float64 and int")
+	})
+}
+
+func testStringConversionFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(int("1"))
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "cannot convert string to int")
+	})
+}
+
+func testFloatDivisionByZeroFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 0.0
+		print(1.5 / a)
+		print("got through")
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 1, exitCode(err))
+		require.Equal(t, "panic: division by zero at test.tsh:3:3", errorOutput(err))
+		require.Empty(t, output)
+	})
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterFloatDefinitionSuccess(t *testing.T) {
+	testFloatDefinitionSuccess(t, interpret)
+}
+
+func TestInterpreterFloatArithmeticSuccess(t *testing.T) {
+	testFloatArithmeticSuccess(t, interpret)
+}
+
+func TestInterpreterFloatNegativeArithmeticSuccess(t *testing.T) {
+	testFloatNegativeArithmeticSuccess(t, interpret)
+}
+
+func TestInterpreterFloatLargeArithmeticSuccess(t *testing.T) {
+	testFloatLargeArithmeticSuccess(t, interpret)
+}
+
+func TestInterpreterFloatPercentageSuccess(t *testing.T) {
+	testFloatPercentageSuccess(t, interpret)
+}
+
+func TestInterpreterFloatAssignmentOperatorsSuccess(t *testing.T) {
+	testFloatAssignmentOperatorsSuccess(t, interpret)
+}
+
+func TestInterpreterFloatComparisonSuccess(t *testing.T) {
+	testFloatComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterFloatToIntSuccess(t *testing.T) {
+	testFloatToIntSuccess(t, interpret)
+}
+
+func TestInterpreterIntToFloatSuccess(t *testing.T) {
+	testIntToFloatSuccess(t, interpret)
+}
+
+func TestInterpreterFtoaSuccess(t *testing.T) {
+	testFtoaSuccess(t, interpret)
+}
+
+func TestInterpreterFloatModuloFail(t *testing.T) {
+	testFloatModuloFail(t, interpret)
+}
+
+func TestInterpreterFloatIntMixFail(t *testing.T) {
+	testFloatIntMixFail(t, interpret)
+}
+
+func TestInterpreterStringConversionFail(t *testing.T) {
+	testStringConversionFail(t, interpret)
+}
+
+func TestInterpreterFloatDivisionByZeroFail(t *testing.T) {
+	testFloatDivisionByZeroFail(t, interpret)
+}
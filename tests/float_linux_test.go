@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestFloatDefinitionSuccess(t *testing.T) {
+	testFloatDefinitionSuccess(t, transpileBash)
+}
+
+func TestFloatArithmeticSuccess(t *testing.T) {
+	testFloatArithmeticSuccess(t, transpileBash)
+}
+
+func TestFloatNegativeArithmeticSuccess(t *testing.T) {
+	testFloatNegativeArithmeticSuccess(t, transpileBash)
+}
+
+func TestFloatLargeArithmeticSuccess(t *testing.T) {
+	testFloatLargeArithmeticSuccess(t, transpileBash)
+}
+
+func TestFloatPercentageSuccess(t *testing.T) {
+	testFloatPercentageSuccess(t, transpileBash)
+}
+
+func TestFloatAssignmentOperatorsSuccess(t *testing.T) {
+	testFloatAssignmentOperatorsSuccess(t, transpileBash)
+}
+
+func TestFloatComparisonSuccess(t *testing.T) {
+	testFloatComparisonSuccess(t, transpileBash)
+}
+
+func TestFloatToIntSuccess(t *testing.T) {
+	testFloatToIntSuccess(t, transpileBash)
+}
+
+func TestIntToFloatSuccess(t *testing.T) {
+	testIntToFloatSuccess(t, transpileBash)
+}
+
+func TestFtoaSuccess(t *testing.T) {
+	testFtoaSuccess(t, transpileBash)
+}
+
+func TestFloatModuloFail(t *testing.T) {
+	testFloatModuloFail(t, transpileBash)
+}
+
+func TestFloatIntMixFail(t *testing.T) {
+	testFloatIntMixFail(t, transpileBash)
+}
+
+func TestStringConversionFail(t *testing.T) {
+	testStringConversionFail(t, transpileBash)
+}
+
+func TestFloatDivisionByZeroFail(t *testing.T) {
+	testFloatDivisionByZeroFail(t, transpileBash)
+}
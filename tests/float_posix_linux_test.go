@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixFloatDefinitionSuccess(t *testing.T) {
+	testFloatDefinitionSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatArithmeticSuccess(t *testing.T) {
+	testFloatArithmeticSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatNegativeArithmeticSuccess(t *testing.T) {
+	testFloatNegativeArithmeticSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatLargeArithmeticSuccess(t *testing.T) {
+	testFloatLargeArithmeticSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatPercentageSuccess(t *testing.T) {
+	testFloatPercentageSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatAssignmentOperatorsSuccess(t *testing.T) {
+	testFloatAssignmentOperatorsSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatComparisonSuccess(t *testing.T) {
+	testFloatComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatToIntSuccess(t *testing.T) {
+	testFloatToIntSuccess(t, transpilePosix)
+}
+
+func TestPosixIntToFloatSuccess(t *testing.T) {
+	testIntToFloatSuccess(t, transpilePosix)
+}
+
+func TestPosixFtoaSuccess(t *testing.T) {
+	testFtoaSuccess(t, transpilePosix)
+}
+
+func TestPosixFloatModuloFail(t *testing.T) {
+	testFloatModuloFail(t, transpilePosix)
+}
+
+func TestPosixFloatIntMixFail(t *testing.T) {
+	testFloatIntMixFail(t, transpilePosix)
+}
+
+func TestPosixStringConversionFail(t *testing.T) {
+	testStringConversionFail(t, transpilePosix)
+}
+
+func TestPosixFloatDivisionByZeroFail(t *testing.T) {
+	testFloatDivisionByZeroFail(t, transpilePosix)
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellFloatDefinitionSuccess(t *testing.T) {
+	testFloatDefinitionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatArithmeticSuccess(t *testing.T) {
+	testFloatArithmeticSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatNegativeArithmeticSuccess(t *testing.T) {
+	testFloatNegativeArithmeticSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatLargeArithmeticSuccess(t *testing.T) {
+	testFloatLargeArithmeticSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatPercentageSuccess(t *testing.T) {
+	testFloatPercentageSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatAssignmentOperatorsSuccess(t *testing.T) {
+	testFloatAssignmentOperatorsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatComparisonSuccess(t *testing.T) {
+	testFloatComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatToIntSuccess(t *testing.T) {
+	testFloatToIntSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIntToFloatSuccess(t *testing.T) {
+	testIntToFloatSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFtoaSuccess(t *testing.T) {
+	testFtoaSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatModuloFail(t *testing.T) {
+	testFloatModuloFail(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatIntMixFail(t *testing.T) {
+	testFloatIntMixFail(t, transpilePowerShell)
+}
+
+func TestPowerShellStringConversionFail(t *testing.T) {
+	testStringConversionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellFloatDivisionByZeroFail(t *testing.T) {
+	testFloatDivisionByZeroFail(t, transpilePowerShell)
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestFloatDefinitionSuccess(t *testing.T) {
+	testFloatDefinitionSuccess(t, transpileBatch)
+}
+
+func TestFloatArithmeticSuccess(t *testing.T) {
+	testFloatArithmeticSuccess(t, transpileBatch)
+}
+
+func TestFloatNegativeArithmeticSuccess(t *testing.T) {
+	testFloatNegativeArithmeticSuccess(t, transpileBatch)
+}
+
+func TestFloatLargeArithmeticSuccess(t *testing.T) {
+	testFloatLargeArithmeticSuccess(t, transpileBatch)
+}
+
+func TestFloatPercentageSuccess(t *testing.T) {
+	testFloatPercentageSuccess(t, transpileBatch)
+}
+
+func TestFloatAssignmentOperatorsSuccess(t *testing.T) {
+	testFloatAssignmentOperatorsSuccess(t, transpileBatch)
+}
+
+func TestFloatComparisonSuccess(t *testing.T) {
+	testFloatComparisonSuccess(t, transpileBatch)
+}
+
+func TestFloatToIntSuccess(t *testing.T) {
+	testFloatToIntSuccess(t, transpileBatch)
+}
+
+func TestIntToFloatSuccess(t *testing.T) {
+	testIntToFloatSuccess(t, transpileBatch)
+}
+
+func TestFtoaSuccess(t *testing.T) {
+	testFtoaSuccess(t, transpileBatch)
+}
+
+func TestFloatModuloFail(t *testing.T) {
+	testFloatModuloFail(t, transpileBatch)
+}
+
+func TestFloatIntMixFail(t *testing.T) {
+	testFloatIntMixFail(t, transpileBatch)
+}
+
+func TestStringConversionFail(t *testing.T) {
+	testStringConversionFail(t, transpileBatch)
+}
+
+func TestFloatDivisionByZeroFail(t *testing.T) {
+	testFloatDivisionByZeroFail(t, transpileBatch)
+}
@@ -181,7 +181,7 @@ func testForRangeNonIterableFail(t *testing.T, transpilerFunc transpilerFunc) {
 			print(i, v)
 		}
 	`, func(output string, err error) {
-		require.EqualError(t, shortenError(err), "expected slice or string")
+		require.EqualError(t, shortenError(err), "expected slice, map or string")
 	})
 }
 
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterForComparisonSuccess(t *testing.T) {
+	testForComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterNonBoolForConditionFail(t *testing.T) {
+	testNonBoolForConditionFail(t, interpret)
+}
+
+func TestInterpreterForWithAndComparisonSuccess(t *testing.T) {
+	testForWithAndComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterForWithOrComparisonSuccess(t *testing.T) {
+	testForWithOrComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterForWithCountingVariableSuccess(t *testing.T) {
+	testForWithCountingVariableSuccess(t, interpret)
+}
+
+func TestInterpreterForWithSeparateCountingVariableSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableSuccess(t, interpret)
+}
+
+func TestInterpreterForWithSeparateCountingVariableAndSeparateIncrementSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSeparateIncrementSuccess(t, interpret)
+}
+
+func TestInterpreterForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementSuccess(t, interpret)
+}
+
+func TestInterpreterForWithNoConditionSuccess(t *testing.T) {
+	testForWithNoConditionSuccess(t, interpret)
+}
+
+func TestInterpreterForContinueSuccess(t *testing.T) {
+	testForContinueSuccess(t, interpret)
+}
+
+func TestInterpreterForRangeSliceSuccess(t *testing.T) {
+	testForRangeSliceSuccess(t, interpret)
+}
+
+func TestInterpreterForRangeStringSuccess(t *testing.T) {
+	testForRangeStringSuccess(t, interpret)
+}
+
+func TestInterpreterForRangeNonIterableFail(t *testing.T) {
+	testForRangeNonIterableFail(t, interpret)
+}
+
+func TestInterpreterForComparisonInFunctionSuccess(t *testing.T) {
+	testForComparisonInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterNonBoolForConditionInFunctionFail(t *testing.T) {
+	testNonBoolForConditionInFunctionFail(t, interpret)
+}
+
+func TestInterpreterForWithAndComparisonInFunctionSuccess(t *testing.T) {
+	testForWithAndComparisonInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForWithOrComparisonInFunctionSuccess(t *testing.T) {
+	testForWithOrComparisonInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForWithCountingVariableInFunctionSuccess(t *testing.T) {
+	testForWithCountingVariableInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForWithSeparateCountingVariableInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForWithSeparateCountingVariableAndSeparateIncrementInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSeparateIncrementInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForWithNoConditionInFunctionSuccess(t *testing.T) {
+	testForWithNoConditionInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForRangeSliceInFunctionSuccess(t *testing.T) {
+	testForRangeSliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterForRangeStringInFunctionSuccess(t *testing.T) {
+	testForRangeStringInFunctionSuccess(t, interpret)
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixForComparisonSuccess(t *testing.T) {
+	testForComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixNonBoolForConditionFail(t *testing.T) {
+	testNonBoolForConditionFail(t, transpilePosix)
+}
+
+func TestPosixForWithAndComparisonSuccess(t *testing.T) {
+	testForWithAndComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithOrComparisonSuccess(t *testing.T) {
+	testForWithOrComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithCountingVariableSuccess(t *testing.T) {
+	testForWithCountingVariableSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithSeparateCountingVariableSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithSeparateCountingVariableAndSeparateIncrementSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSeparateIncrementSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithNoConditionSuccess(t *testing.T) {
+	testForWithNoConditionSuccess(t, transpilePosix)
+}
+
+func TestPosixForContinueSuccess(t *testing.T) {
+	testForContinueSuccess(t, transpilePosix)
+}
+
+func TestPosixForRangeSliceSuccess(t *testing.T) {
+	testForRangeSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixForRangeStringSuccess(t *testing.T) {
+	testForRangeStringSuccess(t, transpilePosix)
+}
+
+func TestPosixForRangeNonIterableFail(t *testing.T) {
+	testForRangeNonIterableFail(t, transpilePosix)
+}
+
+func TestPosixForComparisonInFunctionSuccess(t *testing.T) {
+	testForComparisonInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixNonBoolForConditionInFunctionFail(t *testing.T) {
+	testNonBoolForConditionInFunctionFail(t, transpilePosix)
+}
+
+func TestPosixForWithAndComparisonInFunctionSuccess(t *testing.T) {
+	testForWithAndComparisonInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithOrComparisonInFunctionSuccess(t *testing.T) {
+	testForWithOrComparisonInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithCountingVariableInFunctionSuccess(t *testing.T) {
+	testForWithCountingVariableInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithSeparateCountingVariableInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithSeparateCountingVariableAndSeparateIncrementInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSeparateIncrementInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForWithNoConditionInFunctionSuccess(t *testing.T) {
+	testForWithNoConditionInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForRangeSliceInFunctionSuccess(t *testing.T) {
+	testForRangeSliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixForRangeStringInFunctionSuccess(t *testing.T) {
+	testForRangeStringInFunctionSuccess(t, transpilePosix)
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellForComparisonSuccess(t *testing.T) {
+	testForComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellNonBoolForConditionFail(t *testing.T) {
+	testNonBoolForConditionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithAndComparisonSuccess(t *testing.T) {
+	testForWithAndComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithOrComparisonSuccess(t *testing.T) {
+	testForWithOrComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithCountingVariableSuccess(t *testing.T) {
+	testForWithCountingVariableSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithSeparateCountingVariableSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithSeparateCountingVariableAndSeparateIncrementSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSeparateIncrementSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithNoConditionSuccess(t *testing.T) {
+	testForWithNoConditionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForContinueSuccess(t *testing.T) {
+	testForContinueSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForRangeSliceSuccess(t *testing.T) {
+	testForRangeSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForRangeStringSuccess(t *testing.T) {
+	testForRangeStringSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForRangeNonIterableFail(t *testing.T) {
+	testForRangeNonIterableFail(t, transpilePowerShell)
+}
+
+func TestPowerShellForComparisonInFunctionSuccess(t *testing.T) {
+	testForComparisonInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellNonBoolForConditionInFunctionFail(t *testing.T) {
+	testNonBoolForConditionInFunctionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithAndComparisonInFunctionSuccess(t *testing.T) {
+	testForWithAndComparisonInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithOrComparisonInFunctionSuccess(t *testing.T) {
+	testForWithOrComparisonInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithCountingVariableInFunctionSuccess(t *testing.T) {
+	testForWithCountingVariableInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithSeparateCountingVariableInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithSeparateCountingVariableAndSeparateIncrementInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSeparateIncrementInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementInFunctionSuccess(t *testing.T) {
+	testForWithSeparateCountingVariableAndSepareteConditionAndSeparateIncrementInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForWithNoConditionInFunctionSuccess(t *testing.T) {
+	testForWithNoConditionInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForRangeSliceInFunctionSuccess(t *testing.T) {
+	testForRangeSliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellForRangeStringInFunctionSuccess(t *testing.T) {
+	testForRangeStringInFunctionSuccess(t, transpilePowerShell)
+}
@@ -0,0 +1,217 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/monstermichl/typeshell/formatter"
+	"github.com/monstermichl/typeshell/lexer"
+	"github.com/stretchr/testify/require"
+)
+
+func requireFormatted(t *testing.T, source string, expected string) {
+	formatted, err := formatter.Format(source)
+
+	require.Nil(t, err)
+	require.Equal(t, expected, formatted)
+
+	// Formatting must be idempotent.
+	formattedAgain, err := formatter.Format(formatted)
+
+	require.Nil(t, err)
+	require.Equal(t, formatted, formattedAgain)
+}
+
+func TestTokenizeTriviaRoundTripSuccess(t *testing.T) {
+	source := "import \"strings\" // Comment.\n\n/* Block\n   comment */\ns := `raw\n\tstring` + \"\\n\"\t\n"
+	tokens, err := lexer.TokenizeTrivia(source)
+
+	require.Nil(t, err)
+
+	values := []string{}
+
+	for _, token := range tokens {
+		values = append(values, token.Value())
+	}
+	require.Equal(t, source, strings.Join(values, ""))
+}
+
+func TestFormatIndentationSuccess(t *testing.T) {
+	requireFormatted(t, `
+func test(a int) int {
+  if a > 0 {
+        for i := 0; i < a; i++ {
+    print(i)
+        }
+  } else {
+  return 1
+  }
+  return 0
+}
+`, "func test(a int) int {\n\tif a > 0 {\n\t\tfor i := 0; i < a; i++ {\n\t\t\tprint(i)\n\t\t}\n\t} else {\n\t\treturn 1\n\t}\n\treturn 0\n}\n")
+}
+
+func TestFormatSwitchSuccess(t *testing.T) {
+	requireFormatted(t, `
+switch a {
+    case 1:
+        print("one")
+    default:
+        print("other")
+}
+`, "switch a {\ncase 1:\n\tprint(\"one\")\ndefault:\n\tprint(\"other\")\n}\n")
+}
+
+func TestFormatSpacingSuccess(t *testing.T) {
+	requireFormatted(t, `
+s:=[]string{"a","b"}
+m:=map[string][]int{}
+a,b:=f( s... ) ,s[ 1: ]
+print(!true&&a==b, s[len(s) - 1], 2*-1)
+for ;a<5;a++{
+}
+out,_,code:=@ls("-1")|@grep( "x" )
+func f(prefix string,values ...string)(int,int){
+}
+`, `s := []string{"a", "b"}
+m := map[string][]int{}
+a, b := f(s...), s[1:]
+print(!true && a == b, s[len(s) - 1], 2 * -1)
+for ; a < 5; a++ {
+}
+out, _, code := @ls("-1") | @grep("x")
+func f(prefix string, values ...string) (int, int) {
+}
+`)
+}
+
+func TestFormatSingleLineBlockSuccess(t *testing.T) {
+	requireFormatted(t, `
+for i := 0; i < 2; i++ {print(i)}
+for _, v := range []int{1, 2} {print(v)}
+if a {
+    print(1)} else {
+    print(2)}
+func f() {}
+type p struct {a int}
+x := p{a: 1}
+`, "for i := 0; i < 2; i++ {\n\tprint(i)\n}\nfor _, v := range []int{1, 2} {\n\tprint(v)\n}\nif a {\n\tprint(1)\n} else {\n\tprint(2)\n}\nfunc f() {\n}\ntype p struct { a int }\nx := p{a: 1}\n")
+}
+
+func TestFormatBlockClosingBracketSuccess(t *testing.T) {
+	requireFormatted(t, `
+func f() int {
+	if true { return 1 } // comment
+	return 0 }
+`, "func f() int {\n\tif true {\n\t\treturn 1\n\t} // comment\n\treturn 0\n}\n")
+}
+
+func TestFormatMultiLineLiteralSuccess(t *testing.T) {
+	requireFormatted(t, `
+s := [][]int{
+[]int{1, 2},
+    []int{
+  3,
+    },
+}
+`, "s := [][]int{\n\t[]int{1, 2},\n\t[]int{\n\t\t3,\n\t},\n}\n")
+}
+
+func TestFormatAlignmentSuccess(t *testing.T) {
+	requireFormatted(t, `
+import (
+  "strings"
+    hp "helper.tsh"
+)
+
+type person struct {
+  name string // Name.
+  ages []int // Ages.
+  m map[string]int
+}
+p := person{
+  name: "a",
+  ages: []int{},
+  m: map[string]int{},
+}
+`, `import (
+	   "strings"
+	hp "helper.tsh"
+)
+
+type person struct {
+	name string // Name.
+	ages []int  // Ages.
+	m    map[string]int
+}
+p := person{
+	name: "a",
+	ages: []int{},
+	m:    map[string]int{},
+}
+`)
+}
+
+func TestFormatCommentsSuccess(t *testing.T) {
+	requireFormatted(t, `
+
+
+// Line comment.
+a := 1 // Trailing comment.
+bb := 2   // Another trailing comment.
+/* Block
+   comment */
+
+
+
+print(a /* inline */, bb)
+
+`, "// Line comment.\na := 1  // Trailing comment.\nbb := 2 // Another trailing comment.\n/* Block\n   comment */\n\nprint(a /* inline */, bb)\n")
+}
+
+func TestFormatStdSuccess(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "std", "*.tsh"))
+
+	require.Nil(t, err)
+	require.NotEmpty(t, files)
+
+	// The standard library is expected to be formatted.
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+
+		require.Nil(t, err)
+		requireFormatted(t, string(content), string(content))
+	}
+}
+
+func TestFormatDiffSuccess(t *testing.T) {
+	diff := formatter.Diff("test.tsh", "a := 1\nb:=2\nc := 3\nd := 4\ne := 5\nf := 6\ng := 7\nh := 8\ni := 9\nj:=10", "a := 1\nb := 2\nc := 3\nd := 4\ne := 5\nf := 6\ng := 7\nh := 8\ni := 9\nj := 10\n")
+
+	require.Equal(t, `diff test.tsh.orig test.tsh
+--- test.tsh.orig
++++ test.tsh
+@@ -1,5 +1,5 @@
+ a := 1
+-b:=2
++b := 2
+ c := 3
+ d := 4
+ e := 5
+@@ -7,4 +7,4 @@
+ g := 7
+ h := 8
+ i := 9
+-j:=10
+\ No newline at end of file
++j := 10
+`, diff)
+	require.Empty(t, formatter.Diff("test.tsh", "a := 1\n", "a := 1\n"))
+}
+
+func TestFormatUnterminatedStringFail(t *testing.T) {
+	_, err := formatter.Format(`a := "`)
+
+	require.EqualError(t, err, "string has not been terminated at row 1, column 6")
+}
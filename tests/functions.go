@@ -102,3 +102,224 @@ func testCallFunctionFromFunctionSuccess(t *testing.T, transpilerFunc transpiler
 		require.Equal(t, "2 3", output)
 	})
 }
+
+func testRecursiveFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func fib(n int) int {
+			if n < 2 {
+				return n
+			}
+			return fib(n - 1) + fib(n - 2)
+		}
+		print(fib(10))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "55", output)
+	})
+}
+
+func testRecursiveFunctionLocalsSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func sum(n int, s []int) int {
+			if n == 0 {
+				return 0
+			}
+			a := n
+			l := []int{n}
+			b := sum(n - 1, s)
+
+			for i := 0; i < len(l); i++ {
+				s[len(s)] = l[i]
+			}
+			return a + b
+		}
+		s := []int{}
+		print(sum(4, s))
+		print(s[0], s[1], s[2], s[3])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "10\n1 2 3 4", output)
+	})
+}
+
+func testFunctionWithMoreThanNineParamsSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func last(a int, b int, c int, d int, e int, f int, g int, h int, i int, j int, k int) int {
+			return j + k
+		}
+		print(last(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "21", output)
+	})
+}
+
+func testMainFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func main() {
+			print("main")
+		}
+		print("top level")
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "top level\nmain", output)
+	})
+}
+
+func testMainFunctionReturnCodeSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func check() bool {
+			return false
+		}
+
+		func main() int {
+			if !check() {
+				print("check failed")
+				return 2
+			}
+			return 0
+		}
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 2, exitCode(err))
+		require.Equal(t, "check failed", output)
+	})
+}
+
+func testMainFunctionWithParamsFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func main(a int) {
+			print(a)
+		}
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "function main must not have parameters")
+	})
+}
+
+func testMainFunctionWithStringReturnFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func main() string {
+			return "a"
+		}
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "function main must not return a value or must return an int")
+	})
+}
+
+func testVariadicFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(prefix string, values ...int) {
+			print(prefix, len(values))
+
+			for i := 0; i < len(values); i++ {
+				print(values[i])
+			}
+		}
+		test("a")
+		test("b", 1)
+		test("c", 2, 3)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a 0\nb 1\n1\nc 2\n2\n3", output)
+	})
+}
+
+func testVariadicFunctionSpreadSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(values ...string) {
+			values[0] = "z"
+			print(len(values))
+		}
+		s := []string{"a", "b"}
+		test(s...)
+		print(s[0], s[1])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2\nz b", output)
+	})
+}
+
+func testVariadicStructFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type person struct {
+			name string
+		}
+
+		func test(persons ...person) {
+			for i := 0; i < len(persons); i++ {
+				print(persons[i].name)
+			}
+		}
+		test(person{name: "a"}, person{name: "b"})
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a\nb", output)
+	})
+}
+
+func testVariadicFunctionNotEnoughArgsFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(prefix string, values ...int) {
+			print(len(values))
+		}
+		test()
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "function test expects at least 1 parameters but got 0")
+	})
+}
+
+func testVariadicFunctionWrongTypeFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(values ...int) {
+			print(len(values))
+		}
+		test(1, "a")
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected parameter int (values) but got string")
+	})
+}
+
+func testVariadicParamNotFinalFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(values ...int, prefix string) {
+			print(len(values))
+		}
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "can only use ... with final parameter")
+	})
+}
+
+func testSpreadNonVariadicFunctionFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(values []int) {
+			print(len(values))
+		}
+		s := []int{}
+		test(s...)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "cannot use ... in call to non-variadic function test")
+	})
+}
+
+func testSpreadWithAdditionalArgsFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(values ...int) {
+			print(len(values))
+		}
+		s := []int{}
+		test(1, s...)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "cannot use ... with additional arguments for parameter values")
+	})
+}
+
+func testSpreadNoSliceFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func test(values ...int) {
+			print(len(values))
+		}
+		test(1...)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected slice but got int")
+	})
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterVoidFunctionSuccess(t *testing.T) {
+	testVoidFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSingleReturnValueFunctionSuccess(t *testing.T) {
+	testSingleReturnValueFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterMultiReturnValueFunctionSuccess(t *testing.T) {
+	testMultiReturnValueFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSingleParamFunctionSuccess(t *testing.T) {
+	testSingleParamFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterMultiParamFunctionSuccess(t *testing.T) {
+	testMultiParamFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSliceParamFunctionSuccess(t *testing.T) {
+	testSliceParamFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterCallFunctionFromFunctionSuccess(t *testing.T) {
+	testCallFunctionFromFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterRecursiveFunctionSuccess(t *testing.T) {
+	testRecursiveFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterRecursiveFunctionLocalsSuccess(t *testing.T) {
+	testRecursiveFunctionLocalsSuccess(t, interpret)
+}
+
+func TestInterpreterFunctionWithMoreThanNineParamsSuccess(t *testing.T) {
+	testFunctionWithMoreThanNineParamsSuccess(t, interpret)
+}
+
+func TestInterpreterMainFunctionSuccess(t *testing.T) {
+	testMainFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterMainFunctionReturnCodeSuccess(t *testing.T) {
+	testMainFunctionReturnCodeSuccess(t, interpret)
+}
+
+func TestInterpreterMainFunctionWithParamsFail(t *testing.T) {
+	testMainFunctionWithParamsFail(t, interpret)
+}
+
+func TestInterpreterMainFunctionWithStringReturnFail(t *testing.T) {
+	testMainFunctionWithStringReturnFail(t, interpret)
+}
+
+func TestInterpreterVariadicFunctionSuccess(t *testing.T) {
+	testVariadicFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterVariadicFunctionSpreadSuccess(t *testing.T) {
+	testVariadicFunctionSpreadSuccess(t, interpret)
+}
+
+func TestInterpreterVariadicStructFunctionSuccess(t *testing.T) {
+	testVariadicStructFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterVariadicFunctionNotEnoughArgsFail(t *testing.T) {
+	testVariadicFunctionNotEnoughArgsFail(t, interpret)
+}
+
+func TestInterpreterVariadicFunctionWrongTypeFail(t *testing.T) {
+	testVariadicFunctionWrongTypeFail(t, interpret)
+}
+
+func TestInterpreterVariadicParamNotFinalFail(t *testing.T) {
+	testVariadicParamNotFinalFail(t, interpret)
+}
+
+func TestInterpreterSpreadNonVariadicFunctionFail(t *testing.T) {
+	testSpreadNonVariadicFunctionFail(t, interpret)
+}
+
+func TestInterpreterSpreadWithAdditionalArgsFail(t *testing.T) {
+	testSpreadWithAdditionalArgsFail(t, interpret)
+}
+
+func TestInterpreterSpreadNoSliceFail(t *testing.T) {
+	testSpreadNoSliceFail(t, interpret)
+}
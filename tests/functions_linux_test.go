@@ -31,3 +31,67 @@ func TestSliceParamFunctionSuccess(t *testing.T) {
 func TestCallFunctionFromFunctionSuccess(t *testing.T) {
 	testCallFunctionFromFunctionSuccess(t, transpileBash)
 }
+
+func TestRecursiveFunctionSuccess(t *testing.T) {
+	testRecursiveFunctionSuccess(t, transpileBash)
+}
+
+func TestRecursiveFunctionLocalsSuccess(t *testing.T) {
+	testRecursiveFunctionLocalsSuccess(t, transpileBash)
+}
+
+func TestFunctionWithMoreThanNineParamsSuccess(t *testing.T) {
+	testFunctionWithMoreThanNineParamsSuccess(t, transpileBash)
+}
+
+func TestMainFunctionSuccess(t *testing.T) {
+	testMainFunctionSuccess(t, transpileBash)
+}
+
+func TestMainFunctionReturnCodeSuccess(t *testing.T) {
+	testMainFunctionReturnCodeSuccess(t, transpileBash)
+}
+
+func TestMainFunctionWithParamsFail(t *testing.T) {
+	testMainFunctionWithParamsFail(t, transpileBash)
+}
+
+func TestMainFunctionWithStringReturnFail(t *testing.T) {
+	testMainFunctionWithStringReturnFail(t, transpileBash)
+}
+
+func TestVariadicFunctionSuccess(t *testing.T) {
+	testVariadicFunctionSuccess(t, transpileBash)
+}
+
+func TestVariadicFunctionSpreadSuccess(t *testing.T) {
+	testVariadicFunctionSpreadSuccess(t, transpileBash)
+}
+
+func TestVariadicStructFunctionSuccess(t *testing.T) {
+	testVariadicStructFunctionSuccess(t, transpileBash)
+}
+
+func TestVariadicFunctionNotEnoughArgsFail(t *testing.T) {
+	testVariadicFunctionNotEnoughArgsFail(t, transpileBash)
+}
+
+func TestVariadicFunctionWrongTypeFail(t *testing.T) {
+	testVariadicFunctionWrongTypeFail(t, transpileBash)
+}
+
+func TestVariadicParamNotFinalFail(t *testing.T) {
+	testVariadicParamNotFinalFail(t, transpileBash)
+}
+
+func TestSpreadNonVariadicFunctionFail(t *testing.T) {
+	testSpreadNonVariadicFunctionFail(t, transpileBash)
+}
+
+func TestSpreadWithAdditionalArgsFail(t *testing.T) {
+	testSpreadWithAdditionalArgsFail(t, transpileBash)
+}
+
+func TestSpreadNoSliceFail(t *testing.T) {
+	testSpreadNoSliceFail(t, transpileBash)
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixVoidFunctionSuccess(t *testing.T) {
+	testVoidFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSingleReturnValueFunctionSuccess(t *testing.T) {
+	testSingleReturnValueFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixMultiReturnValueFunctionSuccess(t *testing.T) {
+	testMultiReturnValueFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSingleParamFunctionSuccess(t *testing.T) {
+	testSingleParamFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixMultiParamFunctionSuccess(t *testing.T) {
+	testMultiParamFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceParamFunctionSuccess(t *testing.T) {
+	testSliceParamFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixCallFunctionFromFunctionSuccess(t *testing.T) {
+	testCallFunctionFromFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixRecursiveFunctionSuccess(t *testing.T) {
+	testRecursiveFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixRecursiveFunctionLocalsSuccess(t *testing.T) {
+	testRecursiveFunctionLocalsSuccess(t, transpilePosix)
+}
+
+func TestPosixFunctionWithMoreThanNineParamsSuccess(t *testing.T) {
+	testFunctionWithMoreThanNineParamsSuccess(t, transpilePosix)
+}
+
+func TestPosixMainFunctionSuccess(t *testing.T) {
+	testMainFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixMainFunctionReturnCodeSuccess(t *testing.T) {
+	testMainFunctionReturnCodeSuccess(t, transpilePosix)
+}
+
+func TestPosixMainFunctionWithParamsFail(t *testing.T) {
+	testMainFunctionWithParamsFail(t, transpilePosix)
+}
+
+func TestPosixMainFunctionWithStringReturnFail(t *testing.T) {
+	testMainFunctionWithStringReturnFail(t, transpilePosix)
+}
+
+func TestPosixVariadicFunctionSuccess(t *testing.T) {
+	testVariadicFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixVariadicFunctionSpreadSuccess(t *testing.T) {
+	testVariadicFunctionSpreadSuccess(t, transpilePosix)
+}
+
+func TestPosixVariadicStructFunctionSuccess(t *testing.T) {
+	testVariadicStructFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixVariadicFunctionNotEnoughArgsFail(t *testing.T) {
+	testVariadicFunctionNotEnoughArgsFail(t, transpilePosix)
+}
+
+func TestPosixVariadicFunctionWrongTypeFail(t *testing.T) {
+	testVariadicFunctionWrongTypeFail(t, transpilePosix)
+}
+
+func TestPosixVariadicParamNotFinalFail(t *testing.T) {
+	testVariadicParamNotFinalFail(t, transpilePosix)
+}
+
+func TestPosixSpreadNonVariadicFunctionFail(t *testing.T) {
+	testSpreadNonVariadicFunctionFail(t, transpilePosix)
+}
+
+func TestPosixSpreadWithAdditionalArgsFail(t *testing.T) {
+	testSpreadWithAdditionalArgsFail(t, transpilePosix)
+}
+
+func TestPosixSpreadNoSliceFail(t *testing.T) {
+	testSpreadNoSliceFail(t, transpilePosix)
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellVoidFunctionSuccess(t *testing.T) {
+	testVoidFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSingleReturnValueFunctionSuccess(t *testing.T) {
+	testSingleReturnValueFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMultiReturnValueFunctionSuccess(t *testing.T) {
+	testMultiReturnValueFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSingleParamFunctionSuccess(t *testing.T) {
+	testSingleParamFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMultiParamFunctionSuccess(t *testing.T) {
+	testMultiParamFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceParamFunctionSuccess(t *testing.T) {
+	testSliceParamFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCallFunctionFromFunctionSuccess(t *testing.T) {
+	testCallFunctionFromFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellRecursiveFunctionSuccess(t *testing.T) {
+	testRecursiveFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellRecursiveFunctionLocalsSuccess(t *testing.T) {
+	testRecursiveFunctionLocalsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellFunctionWithMoreThanNineParamsSuccess(t *testing.T) {
+	testFunctionWithMoreThanNineParamsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMainFunctionSuccess(t *testing.T) {
+	testMainFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMainFunctionReturnCodeSuccess(t *testing.T) {
+	testMainFunctionReturnCodeSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMainFunctionWithParamsFail(t *testing.T) {
+	testMainFunctionWithParamsFail(t, transpilePowerShell)
+}
+
+func TestPowerShellMainFunctionWithStringReturnFail(t *testing.T) {
+	testMainFunctionWithStringReturnFail(t, transpilePowerShell)
+}
+
+func TestPowerShellVariadicFunctionSuccess(t *testing.T) {
+	testVariadicFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellVariadicFunctionSpreadSuccess(t *testing.T) {
+	testVariadicFunctionSpreadSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellVariadicStructFunctionSuccess(t *testing.T) {
+	testVariadicStructFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellVariadicFunctionNotEnoughArgsFail(t *testing.T) {
+	testVariadicFunctionNotEnoughArgsFail(t, transpilePowerShell)
+}
+
+func TestPowerShellVariadicFunctionWrongTypeFail(t *testing.T) {
+	testVariadicFunctionWrongTypeFail(t, transpilePowerShell)
+}
+
+func TestPowerShellVariadicParamNotFinalFail(t *testing.T) {
+	testVariadicParamNotFinalFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSpreadNonVariadicFunctionFail(t *testing.T) {
+	testSpreadNonVariadicFunctionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSpreadWithAdditionalArgsFail(t *testing.T) {
+	testSpreadWithAdditionalArgsFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSpreadNoSliceFail(t *testing.T) {
+	testSpreadNoSliceFail(t, transpilePowerShell)
+}
@@ -31,3 +31,67 @@ func TestSliceParamFunctionSuccess(t *testing.T) {
 func TestCallFunctionFromFunctionSuccess(t *testing.T) {
 	testCallFunctionFromFunctionSuccess(t, transpileBatch)
 }
+
+func TestRecursiveFunctionSuccess(t *testing.T) {
+	testRecursiveFunctionSuccess(t, transpileBatch)
+}
+
+func TestRecursiveFunctionLocalsSuccess(t *testing.T) {
+	testRecursiveFunctionLocalsSuccess(t, transpileBatch)
+}
+
+func TestFunctionWithMoreThanNineParamsSuccess(t *testing.T) {
+	testFunctionWithMoreThanNineParamsSuccess(t, transpileBatch)
+}
+
+func TestMainFunctionSuccess(t *testing.T) {
+	testMainFunctionSuccess(t, transpileBatch)
+}
+
+func TestMainFunctionReturnCodeSuccess(t *testing.T) {
+	testMainFunctionReturnCodeSuccess(t, transpileBatch)
+}
+
+func TestMainFunctionWithParamsFail(t *testing.T) {
+	testMainFunctionWithParamsFail(t, transpileBatch)
+}
+
+func TestMainFunctionWithStringReturnFail(t *testing.T) {
+	testMainFunctionWithStringReturnFail(t, transpileBatch)
+}
+
+func TestVariadicFunctionSuccess(t *testing.T) {
+	testVariadicFunctionSuccess(t, transpileBatch)
+}
+
+func TestVariadicFunctionSpreadSuccess(t *testing.T) {
+	testVariadicFunctionSpreadSuccess(t, transpileBatch)
+}
+
+func TestVariadicStructFunctionSuccess(t *testing.T) {
+	testVariadicStructFunctionSuccess(t, transpileBatch)
+}
+
+func TestVariadicFunctionNotEnoughArgsFail(t *testing.T) {
+	testVariadicFunctionNotEnoughArgsFail(t, transpileBatch)
+}
+
+func TestVariadicFunctionWrongTypeFail(t *testing.T) {
+	testVariadicFunctionWrongTypeFail(t, transpileBatch)
+}
+
+func TestVariadicParamNotFinalFail(t *testing.T) {
+	testVariadicParamNotFinalFail(t, transpileBatch)
+}
+
+func TestSpreadNonVariadicFunctionFail(t *testing.T) {
+	testSpreadNonVariadicFunctionFail(t, transpileBatch)
+}
+
+func TestSpreadWithAdditionalArgsFail(t *testing.T) {
+	testSpreadWithAdditionalArgsFail(t, transpileBatch)
+}
+
+func TestSpreadNoSliceFail(t *testing.T) {
+	testSpreadNoSliceFail(t, transpileBatch)
+}
@@ -1,28 +1,41 @@
 package tests
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/monstermichl/typeshell/converters/bash"
 	"github.com/monstermichl/typeshell/converters/batch"
+	"github.com/monstermichl/typeshell/converters/posix"
+	"github.com/monstermichl/typeshell/converters/powershell"
+	"github.com/monstermichl/typeshell/interpreter"
+	"github.com/monstermichl/typeshell/parser"
 	"github.com/monstermichl/typeshell/transpiler"
 	"github.com/stretchr/testify/require"
 )
 
+const powerShellInterpreter = "pwsh"
+
 type sourceCallout func(dir string) (string, error)
 type compareCallout func(output string, err error)
 type transpilerFunc func(t *testing.T, source string, compare compareCallout)
 type transpilerCalloutFunc func(t *testing.T, callout sourceCallout, compare compareCallout)
+type transpilerArgsFunc func(t *testing.T, source string, args []string, compare compareCallout)
+
+// interpreterExitError adds stderr to the interpreter's exit error to make it comparable to exec.ExitError.
+type interpreterExitError struct {
+	interpreter.ExitError
+	stderr string
+}
 
-func transpileFunc(t *testing.T, source sourceCallout, targetFileName string, converter transpiler.Converter, compare compareCallout) {
+func transpileFunc(t *testing.T, source sourceCallout, targetFileName string, converter transpiler.Converter, compare compareCallout, args ...string) {
 	exe, err := os.Executable()
 	require.Nil(t, err)
 
@@ -57,7 +70,12 @@ func transpileFunc(t *testing.T, source sourceCallout, targetFileName string, co
 			err = os.WriteFile(targetFile, []byte(code), 0700)
 
 			require.Nil(t, err)
-			cmd := exec.Command(targetFile)
+			cmd := exec.Command(targetFile, args...)
+
+			// PowerShell scripts can't be executed directly on every platform.
+			if filepath.Ext(targetFile) == ".ps1" {
+				cmd = exec.Command(powerShellInterpreter, append([]string{"-NoProfile", "-NonInteractive", "-File", targetFile}, args...)...)
+			}
 			output, err = cmd.Output()
 		}
 		outputString = string(output)
@@ -67,16 +85,20 @@ func transpileFunc(t *testing.T, source sourceCallout, targetFileName string, co
 	compare(outputString, err)
 }
 
-func transpile(t *testing.T, source string, targetFileName string, converter transpiler.Converter, compare compareCallout) {
+func transpile(t *testing.T, source string, targetFileName string, converter transpiler.Converter, compare compareCallout, args ...string) {
 	transpileFunc(t, func(_ string) (string, error) {
 		return source, nil
-	}, targetFileName, converter, compare)
+	}, targetFileName, converter, compare, args...)
 }
 
 func transpileBash(t *testing.T, source string, compare compareCallout) {
 	transpile(t, source, "test.sh", bash.New(), compare)
 }
 
+func transpileBashArgs(t *testing.T, source string, args []string, compare compareCallout) {
+	transpile(t, source, "test.sh", bash.New(), compare, args...)
+}
+
 func transpileBashFunc(t *testing.T, source sourceCallout, compare compareCallout) {
 	transpileFunc(t, source, "test.sh", bash.New(), compare)
 }
@@ -85,18 +107,124 @@ func transpileBatch(t *testing.T, source string, compare compareCallout) {
 	transpile(t, source, "test.bat", batch.New(), compare)
 }
 
+func transpileBatchArgs(t *testing.T, source string, args []string, compare compareCallout) {
+	transpile(t, source, "test.bat", batch.New(), compare, args...)
+}
+
 func transpileBatchFunc(t *testing.T, source sourceCallout, compare compareCallout) {
 	transpileFunc(t, source, "test.bat", batch.New(), compare)
 }
 
-func shortenError(err error) error {
-	if err != nil {
-		s := err.Error()
+func transpilePosix(t *testing.T, source string, compare compareCallout) {
+	transpile(t, source, "test.sh", posix.New(), compare)
+}
+
+func transpilePosixArgs(t *testing.T, source string, args []string, compare compareCallout) {
+	transpile(t, source, "test.sh", posix.New(), compare, args...)
+}
+
+func transpilePosixFunc(t *testing.T, source sourceCallout, compare compareCallout) {
+	transpileFunc(t, source, "test.sh", posix.New(), compare)
+}
+
+func transpilePowerShell(t *testing.T, source string, compare compareCallout) {
+	skipIfNoPowerShell(t)
+	transpile(t, source, "test.ps1", powershell.New(), compare)
+}
+
+func transpilePowerShellArgs(t *testing.T, source string, args []string, compare compareCallout) {
+	skipIfNoPowerShell(t)
+	transpile(t, source, "test.ps1", powershell.New(), compare, args...)
+}
+
+func transpilePowerShellFunc(t *testing.T, source sourceCallout, compare compareCallout) {
+	skipIfNoPowerShell(t)
+	transpileFunc(t, source, "test.ps1", powershell.New(), compare)
+}
+
+// interpretFunc runs the source with the interpreter instead of transpiling it.
+func interpretFunc(t *testing.T, source sourceCallout, compare compareCallout) {
+	interpretFuncArgs(t, source, nil, compare)
+}
+
+func interpretFuncArgs(t *testing.T, source sourceCallout, args []string, compare compareCallout) {
+	exe, err := os.Executable()
+	require.Nil(t, err)
+
+	// Copy std to executable path.
+	err = copyStd(filepath.Dir(exe))
+	require.Nil(t, err)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.tsh")
+	outputString := ""
+	src, err := source(dir)
+
+	if err == nil {
+		var stdout, stderr bytes.Buffer
+
+		err = os.WriteFile(file, []byte(src), 0700)
+		require.Nil(t, err)
+
+		i := interpreter.New(strings.NewReader(""), &stdout, &stderr)
+		err = i.Run(file, args...)
+		outputString = strings.TrimSpace(stdout.String())
 
-		if matches := regexp.MustCompile(`(.+)\s+at row`).FindStringSubmatch(s); matches != nil {
-			s = matches[1]
+		if exitErr, ok := err.(interpreter.ExitError); ok {
+			err = interpreterExitError{exitErr, stderr.String()}
 		}
-		err = errors.New(s)
+	}
+	compare(outputString, err)
+}
+
+func interpret(t *testing.T, source string, compare compareCallout) {
+	interpretFunc(t, func(_ string) (string, error) {
+		return source, nil
+	}, compare)
+}
+
+func interpretArgs(t *testing.T, source string, args []string, compare compareCallout) {
+	interpretFuncArgs(t, func(_ string) (string, error) {
+		return source, nil
+	}, args, compare)
+}
+
+// exitCode returns the exit code of a failed script run or -1 if the error is not an exit error.
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	var interpreterErr interpreterExitError
+
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	} else if errors.As(err, &interpreterErr) {
+		return interpreterErr.Code()
+	}
+	return -1
+}
+
+// errorOutput returns the trimmed stderr output of a failed script run.
+func errorOutput(err error) string {
+	var exitErr *exec.ExitError
+	var interpreterErr interpreterExitError
+	output := ""
+
+	if errors.As(err, &exitErr) {
+		output = string(exitErr.Stderr)
+	} else if errors.As(err, &interpreterErr) {
+		output = interpreterErr.stderr
+	}
+	return strings.TrimSpace(strings.ReplaceAll(output, "\r\n", "\n"))
+}
+
+func skipIfNoPowerShell(t *testing.T) {
+	if _, err := exec.LookPath(powerShellInterpreter); err != nil {
+		t.Skipf("%s not found", powerShellInterpreter)
+	}
+}
+
+func shortenError(err error) error {
+	if err != nil {
+		err = errors.New(parser.ErrorDiagnostics("", err)[0].Message())
 	}
 	return err
 }
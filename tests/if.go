@@ -180,3 +180,23 @@ func testElseInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		require.Equal(t, "ok", output)
 	})
 }
+
+func testElseIfConditionEvaluationSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func check(name string, v bool) bool {
+			print(name)
+			return v
+		}
+
+		if check("a", false) {
+			print("nok")
+		} else if check("b", true) {
+			print("ok")
+		} else if check("c", true) {
+			print("nok")
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a\nb\nok", output)
+	})
+}
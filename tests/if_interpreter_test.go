@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterIfComparisonSuccess(t *testing.T) {
+	testIfComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterNonBoolIfConditionFail(t *testing.T) {
+	testNonBoolIfConditionFail(t, interpret)
+}
+
+func TestInterpreterIfWithAndComparisonSuccess(t *testing.T) {
+	testIfWithAndComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterIfWithOrComparisonSuccess(t *testing.T) {
+	testIfWithOrComparisonSuccess(t, interpret)
+}
+
+func TestInterpreterElseIfSuccess(t *testing.T) {
+	testElseIfSuccess(t, interpret)
+}
+
+func TestInterpreterElseSuccess(t *testing.T) {
+	testElseSuccess(t, interpret)
+}
+
+func TestInterpreterIfComparisonInFunctionSuccess(t *testing.T) {
+	testIfComparisonInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterIfWithAndComparisonInFunctionSuccess(t *testing.T) {
+	testIfWithAndComparisonInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterIfWithOrComparisonInFunctionSuccess(t *testing.T) {
+	testIfWithOrComparisonInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterElseIfInFunctionSuccess(t *testing.T) {
+	testElseIfInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterElseInFunctionSuccess(t *testing.T) {
+	testElseInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterElseIfConditionEvaluationSuccess(t *testing.T) {
+	testElseIfConditionEvaluationSuccess(t, interpret)
+}
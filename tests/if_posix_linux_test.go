@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixIfComparisonSuccess(t *testing.T) {
+	testIfComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixNonBoolIfConditionFail(t *testing.T) {
+	testNonBoolIfConditionFail(t, transpilePosix)
+}
+
+func TestPosixIfWithAndComparisonSuccess(t *testing.T) {
+	testIfWithAndComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixIfWithOrComparisonSuccess(t *testing.T) {
+	testIfWithOrComparisonSuccess(t, transpilePosix)
+}
+
+func TestPosixElseIfSuccess(t *testing.T) {
+	testElseIfSuccess(t, transpilePosix)
+}
+
+func TestPosixElseSuccess(t *testing.T) {
+	testElseSuccess(t, transpilePosix)
+}
+
+func TestPosixIfComparisonInFunctionSuccess(t *testing.T) {
+	testIfComparisonInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixIfWithAndComparisonInFunctionSuccess(t *testing.T) {
+	testIfWithAndComparisonInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixIfWithOrComparisonInFunctionSuccess(t *testing.T) {
+	testIfWithOrComparisonInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixElseIfInFunctionSuccess(t *testing.T) {
+	testElseIfInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixElseInFunctionSuccess(t *testing.T) {
+	testElseInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixElseIfConditionEvaluationSuccess(t *testing.T) {
+	testElseIfConditionEvaluationSuccess(t, transpilePosix)
+}
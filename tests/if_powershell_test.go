@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellIfComparisonSuccess(t *testing.T) {
+	testIfComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellNonBoolIfConditionFail(t *testing.T) {
+	testNonBoolIfConditionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellIfWithAndComparisonSuccess(t *testing.T) {
+	testIfWithAndComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIfWithOrComparisonSuccess(t *testing.T) {
+	testIfWithOrComparisonSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellElseIfSuccess(t *testing.T) {
+	testElseIfSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellElseSuccess(t *testing.T) {
+	testElseSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIfComparisonInFunctionSuccess(t *testing.T) {
+	testIfComparisonInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIfWithAndComparisonInFunctionSuccess(t *testing.T) {
+	testIfWithAndComparisonInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIfWithOrComparisonInFunctionSuccess(t *testing.T) {
+	testIfWithOrComparisonInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellElseIfInFunctionSuccess(t *testing.T) {
+	testElseIfInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellElseInFunctionSuccess(t *testing.T) {
+	testElseInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellElseIfConditionEvaluationSuccess(t *testing.T) {
+	testElseIfConditionEvaluationSuccess(t, transpilePowerShell)
+}
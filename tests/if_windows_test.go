@@ -47,3 +47,7 @@ func TestElseIfInFunctionSuccess(t *testing.T) {
 func TestElseInFunctionSuccess(t *testing.T) {
 	testElseInFunctionSuccess(t, transpileBatch)
 }
+
+func TestElseIfConditionEvaluationSuccess(t *testing.T) {
+	testElseIfConditionEvaluationSuccess(t, transpileBatch)
+}
@@ -1,6 +1,8 @@
 package tests
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -14,7 +16,7 @@ func testSingleImportSuccess(t *testing.T, transpilerFunc transpilerCalloutFunc)
 		`, nil
 	}, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -29,6 +31,30 @@ func testMultiImportSuccess(t *testing.T, transpilerFunc transpilerCalloutFunc)
 		`, nil
 	}, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1\n1", output)
+		require.Equal(t, "true\ntrue", output)
+	})
+}
+
+func testImportVariadicFunctionSuccess(t *testing.T, transpilerFunc transpilerCalloutFunc) {
+	transpilerFunc(t, func(dir string) (string, error) {
+		err := os.WriteFile(filepath.Join(dir, "helper.tsh"), []byte(`
+			func Count(prefix string, values ...string) string {
+				return prefix + itoa(len(values))
+			}
+		`), 0600)
+
+		if err != nil {
+			return "", err
+		}
+		return `
+			import (
+				hp "helper.tsh"
+			)
+			values := []string{"a", "b"}
+			print(hp.Count("n"), hp.Count("n", "a", "b", "c"), hp.Count("n", values...))
+		`, nil
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "n0 n3 n2", output)
 	})
 }
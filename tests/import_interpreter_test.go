@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterSingleImportSuccess(t *testing.T) {
+	testSingleImportSuccess(t, interpretFunc)
+}
+
+func TestInterpreterMultiImportSuccess(t *testing.T) {
+	testMultiImportSuccess(t, interpretFunc)
+}
+
+func TestInterpreterImportVariadicFunctionSuccess(t *testing.T) {
+	testImportVariadicFunctionSuccess(t, interpretFunc)
+}
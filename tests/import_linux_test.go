@@ -11,3 +11,7 @@ func TestSingleImportSuccess(t *testing.T) {
 func TestMultiImportSuccess(t *testing.T) {
 	testMultiImportSuccess(t, transpileBashFunc)
 }
+
+func TestImportVariadicFunctionSuccess(t *testing.T) {
+	testImportVariadicFunctionSuccess(t, transpileBashFunc)
+}
@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixSingleImportSuccess(t *testing.T) {
+	testSingleImportSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixMultiImportSuccess(t *testing.T) {
+	testMultiImportSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixImportVariadicFunctionSuccess(t *testing.T) {
+	testImportVariadicFunctionSuccess(t, transpilePosixFunc)
+}
@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellSingleImportSuccess(t *testing.T) {
+	testSingleImportSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellMultiImportSuccess(t *testing.T) {
+	testMultiImportSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellImportVariadicFunctionSuccess(t *testing.T) {
+	testImportVariadicFunctionSuccess(t, transpilePowerShellFunc)
+}
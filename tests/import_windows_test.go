@@ -11,3 +11,7 @@ func TestSingleImportSuccess(t *testing.T) {
 func TestMultiImportSuccess(t *testing.T) {
 	testMultiImportSuccess(t, transpileBatchFunc)
 }
+
+func TestImportVariadicFunctionSuccess(t *testing.T) {
+	testImportVariadicFunctionSuccess(t, transpileBatchFunc)
+}
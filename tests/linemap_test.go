@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/monstermichl/typeshell/converters/bash"
+	"github.com/monstermichl/typeshell/converters/batch"
+	"github.com/monstermichl/typeshell/converters/posix"
+	"github.com/monstermichl/typeshell/converters/powershell"
+	"github.com/monstermichl/typeshell/transpiler"
+	"github.com/stretchr/testify/require"
+)
+
+const lineMapSource = `func check(a int) {
+	if a > 1 {
+		panic("too big")
+	}
+}
+print("start")
+check(2)
+`
+
+func lineMapConverters() map[string]func() transpiler.Converter {
+	return map[string]func() transpiler.Converter{
+		"bash":       func() transpiler.Converter { return bash.New() },
+		"batch":      func() transpiler.Converter { return batch.New() },
+		"posix":      func() transpiler.Converter { return posix.New() },
+		"powershell": func() transpiler.Converter { return powershell.New() },
+	}
+}
+
+// findLine returns the 1-based line of the first line which contains the value.
+func findLine(t *testing.T, code string, value string) int {
+	for i, line := range strings.Split(code, "\n") {
+		if strings.Contains(line, value) {
+			return i + 1
+		}
+	}
+	require.Failf(t, "line not found", "%s not found in code", value)
+	return 0
+}
+
+func TestLineMapSuccess(t *testing.T) {
+	for name, newConverter := range lineMapConverters() {
+		t.Run(name, func(t *testing.T) {
+			trans := transpiler.New()
+			code, err := trans.TranspileSource("test.tsh", lineMapSource, newConverter())
+
+			require.Nil(t, err)
+			require.NotContains(t, code, "\x00")
+
+			lineMap := trans.LineMap()
+			expected := map[string][2]int{
+				"panic: too big at test.tsh:3:3": {3, 3},
+				"start":                          {6, 1},
+			}
+
+			for value, position := range expected {
+				mapping, found := lineMap.Find(findLine(t, code, value))
+
+				require.True(t, found, value)
+				require.Equal(t, "test.tsh", mapping.Path)
+				require.Equal(t, position[0], mapping.Row, value)
+				require.Equal(t, position[1], mapping.Column, value)
+			}
+		})
+	}
+}
+
+func TestLineMapUnmappedLinesSuccess(t *testing.T) {
+	trans := transpiler.New()
+	code, err := trans.TranspileSource("test.tsh", lineMapSource, bash.New())
+
+	require.Nil(t, err)
+
+	// The shebang doesn't belong to a statement.
+	_, found := trans.LineMap().Find(findLine(t, code, "#!/bin/bash"))
+	require.False(t, found)
+}
@@ -15,7 +15,7 @@ func testLogicalAndSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(c)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "0", output)
+		require.Equal(t, "false", output)
 	})
 }
 
@@ -28,7 +28,7 @@ func testLogicalOrSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		print(c)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
 	})
 }
 
@@ -39,6 +39,43 @@ func testComplexLogicalOperationSuccess(t *testing.T, transpilerFunc transpilerF
 		print(a)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "1", output)
+		require.Equal(t, "true", output)
+	})
+}
+
+func testLogicalAndShortCircuitSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func check(name string, v bool) bool {
+			print(name)
+			return v
+		}
+		s := []string{}
+		i := 0
+
+		print(false && check("a", true))
+		print(true && check("b", false))
+
+		if i < len(s) && s[i] == "x" {
+			print("nok")
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "false\nb\nfalse", output)
+	})
+}
+
+func testLogicalOrShortCircuitSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func check(name string, v bool) bool {
+			print(name)
+			return v
+		}
+
+		print(true || check("a", true))
+		print(false || check("b", true))
+		print(check("c", false) || check("d", false) || check("e", true))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true\nb\ntrue\nc\nd\ne\ntrue", output)
 	})
 }
@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterLogicalAndSuccess(t *testing.T) {
+	testLogicalAndSuccess(t, interpret)
+}
+
+func TestInterpreterLogicalOrSuccess(t *testing.T) {
+	testLogicalOrSuccess(t, interpret)
+}
+
+func TestInterpreterComplexLogicalOperationSuccess(t *testing.T) {
+	testComplexLogicalOperationSuccess(t, interpret)
+}
+
+func TestInterpreterLogicalAndShortCircuitSuccess(t *testing.T) {
+	testLogicalAndShortCircuitSuccess(t, interpret)
+}
+
+func TestInterpreterLogicalOrShortCircuitSuccess(t *testing.T) {
+	testLogicalOrShortCircuitSuccess(t, interpret)
+}
@@ -15,3 +15,11 @@ func TestLogicalOrSuccess(t *testing.T) {
 func TestComplexLogicalOperationSuccess(t *testing.T) {
 	testComplexLogicalOperationSuccess(t, transpileBash)
 }
+
+func TestLogicalAndShortCircuitSuccess(t *testing.T) {
+	testLogicalAndShortCircuitSuccess(t, transpileBash)
+}
+
+func TestLogicalOrShortCircuitSuccess(t *testing.T) {
+	testLogicalOrShortCircuitSuccess(t, transpileBash)
+}
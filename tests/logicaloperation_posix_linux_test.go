@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixLogicalAndSuccess(t *testing.T) {
+	testLogicalAndSuccess(t, transpilePosix)
+}
+
+func TestPosixLogicalOrSuccess(t *testing.T) {
+	testLogicalOrSuccess(t, transpilePosix)
+}
+
+func TestPosixComplexLogicalOperationSuccess(t *testing.T) {
+	testComplexLogicalOperationSuccess(t, transpilePosix)
+}
+
+func TestPosixLogicalAndShortCircuitSuccess(t *testing.T) {
+	testLogicalAndShortCircuitSuccess(t, transpilePosix)
+}
+
+func TestPosixLogicalOrShortCircuitSuccess(t *testing.T) {
+	testLogicalOrShortCircuitSuccess(t, transpilePosix)
+}
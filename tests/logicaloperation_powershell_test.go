@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellLogicalAndSuccess(t *testing.T) {
+	testLogicalAndSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellLogicalOrSuccess(t *testing.T) {
+	testLogicalOrSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellComplexLogicalOperationSuccess(t *testing.T) {
+	testComplexLogicalOperationSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellLogicalAndShortCircuitSuccess(t *testing.T) {
+	testLogicalAndShortCircuitSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellLogicalOrShortCircuitSuccess(t *testing.T) {
+	testLogicalOrShortCircuitSuccess(t, transpilePowerShell)
+}
@@ -15,3 +15,11 @@ func TestLogicalOrSuccess(t *testing.T) {
 func TestComplexLogicalOperationSuccess(t *testing.T) {
 	testComplexLogicalOperationSuccess(t, transpileBatch)
 }
+
+func TestLogicalAndShortCircuitSuccess(t *testing.T) {
+	testLogicalAndShortCircuitSuccess(t, transpileBatch)
+}
+
+func TestLogicalOrShortCircuitSuccess(t *testing.T) {
+	testLogicalOrShortCircuitSuccess(t, transpileBatch)
+}
@@ -0,0 +1,251 @@
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/monstermichl/typeshell/lsp"
+	"github.com/stretchr/testify/require"
+)
+
+type lspMessage map[string]any
+
+func lspRequest(id int, method string, params any) lspMessage {
+	return lspMessage{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+func lspNotification(method string, params any) lspMessage {
+	return lspMessage{"jsonrpc": "2.0", "method": method, "params": params}
+}
+
+func lspPosition(uri string, line int, character int) lspMessage {
+	return lspMessage{
+		"textDocument": lspMessage{"uri": uri},
+		"position":     lspMessage{"line": line, "character": character},
+	}
+}
+
+func lspDidOpen(uri string, text string) lspMessage {
+	return lspNotification("textDocument/didOpen", lspMessage{
+		"textDocument": lspMessage{"uri": uri, "languageId": "tsh", "version": 1, "text": text},
+	})
+}
+
+func lspURI(path string) string {
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(path)}
+	return u.String()
+}
+
+// runLanguageServer sends the messages (followed by shutdown and exit) to the language server and returns
+// the received messages.
+func runLanguageServer(t *testing.T, messages ...lspMessage) []lspMessage {
+	input := bytes.Buffer{}
+	output := bytes.Buffer{}
+	messages = append(messages, lspRequest(-1, "shutdown", nil), lspNotification("exit", nil))
+
+	for _, message := range messages {
+		content, err := json.Marshal(message)
+
+		require.Nil(t, err)
+		input.WriteString(fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(content), content))
+	}
+	s := lsp.New(&input, &output)
+	require.Nil(t, s.Run())
+
+	received := []lspMessage{}
+	reader := bufio.NewReader(&output)
+
+	for {
+		header, err := reader.ReadString('\n')
+
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, "Content-Length:")))
+
+		require.Nil(t, err)
+		_, err = reader.ReadString('\n') // Read empty line.
+
+		require.Nil(t, err)
+		content := make([]byte, length)
+		_, err = io.ReadFull(reader, content)
+
+		require.Nil(t, err)
+		message := lspMessage{}
+
+		require.Nil(t, json.Unmarshal(content, &message))
+		received = append(received, message)
+	}
+	return received
+}
+
+// lspResult returns the JSON encoded result of the response with the provided ID.
+func lspResult(t *testing.T, messages []lspMessage, id int) string {
+	for _, message := range messages {
+		if messageId, ok := message["id"].(float64); ok && int(messageId) == id {
+			require.NotContains(t, message, "error")
+			result, err := json.Marshal(message["result"])
+
+			require.Nil(t, err)
+			return string(result)
+		}
+	}
+	require.FailNow(t, fmt.Sprintf("no response with id %d", id))
+	return ""
+}
+
+// lspDiagnostics returns the JSON encoded diagnostics of all published diagnostics notifications.
+func lspDiagnostics(t *testing.T, messages []lspMessage) []string {
+	diagnostics := []string{}
+
+	for _, message := range messages {
+		if message["method"] == "textDocument/publishDiagnostics" {
+			params := message["params"].(map[string]any)
+			result, err := json.Marshal(params["diagnostics"])
+
+			require.Nil(t, err)
+			diagnostics = append(diagnostics, string(result))
+		}
+	}
+	return diagnostics
+}
+
+func writeLspHelper(t *testing.T, dir string) string {
+	helper := filepath.Join(dir, "helper.tsh")
+	err := os.WriteFile(helper, []byte("type Pair struct {\n\tKey string\n}\n\nfunc Count(prefix string, values ...string) (int, error) {\n\treturn len(values), nil\n}\n\nfunc hidden() {\n}\n"), 0600)
+
+	require.Nil(t, err)
+	return helper
+}
+
+func TestLspInitializeSuccess(t *testing.T) {
+	messages := runLanguageServer(t, lspRequest(1, "initialize", lspMessage{}), lspNotification("initialized", lspMessage{}))
+
+	require.JSONEq(t, `{
+		"capabilities": {
+			"textDocumentSync": 1,
+			"definitionProvider": true,
+			"hoverProvider": true,
+			"completionProvider": {"triggerCharacters": ["."]},
+			"documentSymbolProvider": true
+		},
+		"serverInfo": {"name": "tsh"}
+	}`, lspResult(t, messages, 1))
+	require.Equal(t, "null", lspResult(t, messages, -1))
+}
+
+func TestLspDiagnosticsSuccess(t *testing.T) {
+	uri := lspURI(filepath.Join(t.TempDir(), "test.tsh"))
+	messages := runLanguageServer(t,
+		lspDidOpen(uri, "a := 1\nprint(\"é\", b)\n"), // Characters are counted in UTF-16 code units.
+		lspNotification("textDocument/didChange", lspMessage{
+			"textDocument":   lspMessage{"uri": uri, "version": 2},
+			"contentChanges": []lspMessage{{"text": "a := 1\nprint(a)\n"}},
+		}),
+	)
+
+	require.Equal(t, []string{
+		`[{"code":"semantic","message":"variable b has not been defined","range":{"end":{"character":12,"line":1},"start":{"character":11,"line":1}},"severity":1,"source":"tsh"}]`,
+		`[]`,
+	}, lspDiagnostics(t, messages))
+}
+
+func TestLspDefinitionSuccess(t *testing.T) {
+	dir := t.TempDir()
+	helper := writeLspHelper(t, dir)
+	uri := lspURI(filepath.Join(dir, "test.tsh"))
+	messages := runLanguageServer(t,
+		lspDidOpen(uri, "import (\n\thp \"helper.tsh\"\n)\n\nfunc add(a int, b int) int {\n\treturn a + b\n}\nx := add(1, 2)\nc, _ := hp.Count(\"\", \"a\")\nprint(x, c)\n"),
+		lspRequest(1, "textDocument/definition", lspPosition(uri, 5, 8)),  // a in a + b.
+		lspRequest(2, "textDocument/definition", lspPosition(uri, 7, 6)),  // add.
+		lspRequest(3, "textDocument/definition", lspPosition(uri, 9, 6)),  // x.
+		lspRequest(4, "textDocument/definition", lspPosition(uri, 8, 9)),  // hp.
+		lspRequest(5, "textDocument/definition", lspPosition(uri, 8, 12)), // Count.
+		lspRequest(6, "textDocument/definition", lspPosition(uri, 1, 2)),  // hp in the import.
+		lspRequest(7, "textDocument/definition", lspPosition(uri, 9, 0)),  // print.
+	)
+
+	require.JSONEq(t, fmt.Sprintf(`{"uri":"%s","range":{"start":{"line":4,"character":9},"end":{"line":4,"character":10}}}`, uri), lspResult(t, messages, 1))
+	require.JSONEq(t, fmt.Sprintf(`{"uri":"%s","range":{"start":{"line":4,"character":5},"end":{"line":4,"character":8}}}`, uri), lspResult(t, messages, 2))
+	require.JSONEq(t, fmt.Sprintf(`{"uri":"%s","range":{"start":{"line":7,"character":0},"end":{"line":7,"character":1}}}`, uri), lspResult(t, messages, 3))
+	require.JSONEq(t, fmt.Sprintf(`{"uri":"%s","range":{"start":{"line":1,"character":1},"end":{"line":1,"character":3}}}`, uri), lspResult(t, messages, 4))
+	require.JSONEq(t, fmt.Sprintf(`{"uri":"%s","range":{"start":{"line":4,"character":5},"end":{"line":4,"character":10}}}`, lspURI(helper)), lspResult(t, messages, 5))
+	require.JSONEq(t, fmt.Sprintf(`{"uri":"%s","range":{"start":{"line":0,"character":0},"end":{"line":0,"character":0}}}`, lspURI(helper)), lspResult(t, messages, 6))
+	require.Equal(t, "null", lspResult(t, messages, 7))
+}
+
+func TestLspHoverSuccess(t *testing.T) {
+	dir := t.TempDir()
+	writeLspHelper(t, dir)
+	uri := lspURI(filepath.Join(dir, "test.tsh"))
+	messages := runLanguageServer(t,
+		lspDidOpen(uri, "import (\n\thp \"helper.tsh\"\n)\n\nm := map[string][]int{}\np := hp.Pair{}\nc, _ := hp.Count(\"\")\nfor k, v := range m {\n\tprint(k, v, p.Key, c)\n}\n"),
+		lspRequest(1, "textDocument/hover", lspPosition(uri, 4, 0)),  // m.
+		lspRequest(2, "textDocument/hover", lspPosition(uri, 6, 13)), // Count.
+		lspRequest(3, "textDocument/hover", lspPosition(uri, 5, 9)),  // Pair.
+		lspRequest(4, "textDocument/hover", lspPosition(uri, 8, 10)), // v.
+		lspRequest(5, "textDocument/hover", lspPosition(uri, 8, 1)),  // print.
+	)
+
+	require.JSONEq(t, `{"contents":{"kind":"markdown","value":"`+"```go\\nvar m map[string][]int\\n```"+`"},"range":{"start":{"line":4,"character":0},"end":{"line":4,"character":1}}}`, lspResult(t, messages, 1))
+	require.JSONEq(t, `{"contents":{"kind":"markdown","value":"`+"```go\\nfunc Count(prefix string, values ...string) (int, string)\\n```"+`"},"range":{"start":{"line":6,"character":11},"end":{"line":6,"character":16}}}`, lspResult(t, messages, 2))
+	require.JSONEq(t, `{"contents":{"kind":"markdown","value":"`+"```go\\ntype Pair struct {\\n\\tKey string\\n}\\n```"+`"},"range":{"start":{"line":5,"character":8},"end":{"line":5,"character":12}}}`, lspResult(t, messages, 3))
+	require.JSONEq(t, `{"contents":{"kind":"markdown","value":"`+"```go\\nvar v []int\\n```"+`"},"range":{"start":{"line":8,"character":10},"end":{"line":8,"character":11}}}`, lspResult(t, messages, 4))
+	require.Equal(t, "null", lspResult(t, messages, 5))
+}
+
+func TestLspCompletionSuccess(t *testing.T) {
+	dir := t.TempDir()
+	writeLspHelper(t, dir)
+	uri := lspURI(filepath.Join(dir, "test.tsh"))
+	messages := runLanguageServer(t,
+		// The document doesn't parse while typing, but the imports are still known.
+		lspDidOpen(uri, "import (\n\thp \"helper.tsh\"\n)\n\nhp.\nhp.Co\n"),
+		lspRequest(1, "textDocument/completion", lspPosition(uri, 4, 3)),
+		lspRequest(2, "textDocument/completion", lspPosition(uri, 5, 5)),
+		lspRequest(3, "textDocument/completion", lspPosition(uri, 4, 1)),
+	)
+
+	require.JSONEq(t, `[
+		{"label":"Pair","kind":22,"detail":"type Pair struct {\n\tKey string\n}"},
+		{"label":"Count","kind":3,"detail":"func Count(prefix string, values ...string) (int, string)"}
+	]`, lspResult(t, messages, 1))
+	require.JSONEq(t, `[{"label":"Count","kind":3,"detail":"func Count(prefix string, values ...string) (int, string)"}]`, lspResult(t, messages, 2))
+	require.JSONEq(t, fmt.Sprintf(`[{"label":"hp","kind":9,"detail":"import hp \"%s\""}]`, filepath.Join(dir, "helper.tsh")), lspResult(t, messages, 3))
+}
+
+func TestLspDocumentSymbolsSuccess(t *testing.T) {
+	uri := lspURI(filepath.Join(t.TempDir(), "test.tsh"))
+	messages := runLanguageServer(t,
+		lspDidOpen(uri, "type point struct {\n\tx int\n}\n\nfunc test(p point) {\n\tl := p.x\n\tprint(l)\n}\nvar g []string\n"),
+		lspRequest(1, "textDocument/documentSymbol", lspMessage{"textDocument": lspMessage{"uri": uri}}),
+	)
+
+	require.JSONEq(t, `[
+		{"name":"point","detail":"type point struct {\n\tx int\n}","kind":23,"range":{"start":{"line":0,"character":5},"end":{"line":0,"character":10}},"selectionRange":{"start":{"line":0,"character":5},"end":{"line":0,"character":10}}},
+		{"name":"test","detail":"func test(p point)","kind":12,"range":{"start":{"line":4,"character":5},"end":{"line":4,"character":9}},"selectionRange":{"start":{"line":4,"character":5},"end":{"line":4,"character":9}}},
+		{"name":"g","detail":"var g []string","kind":13,"range":{"start":{"line":8,"character":4},"end":{"line":8,"character":5}},"selectionRange":{"start":{"line":8,"character":4},"end":{"line":8,"character":5}}}
+	]`, lspResult(t, messages, 1))
+}
+
+func TestLspUnknownMethodFail(t *testing.T) {
+	messages := runLanguageServer(t, lspRequest(1, "textDocument/rename", lspMessage{}))
+
+	require.Equal(t, lspMessage{"jsonrpc": "2.0", "id": float64(1), "error": map[string]any{"code": float64(-32601), "message": "method textDocument/rename is not supported"}}, messages[0])
+}
+
+func TestLspExitWithoutShutdownFail(t *testing.T) {
+	s := lsp.New(strings.NewReader("Content-Length: 33\r\n\r\n{\"jsonrpc\":\"2.0\",\"method\":\"exit\"}"), io.Discard)
+
+	require.EqualError(t, s.Run(), "exit has been requested before shutdown")
+}
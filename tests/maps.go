@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testDefineMapSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[string]int{
+			"a": 1,
+			"b": 2,
+		}
+		var e map[int]string
+
+		print(m["a"], m["b"], len(m), len(e))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "1 2 2 0", output)
+	})
+}
+
+func testMapAssignSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[string]int{"a": 1}
+
+		m["b"] = 2
+		m["a"] += 10
+		m["b"]++
+		m[""] = 3
+
+		print(m["a"], m["b"], m[""], len(m))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "11 3 3 3", output)
+	})
+}
+
+func testMapMissingKeySuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[int]string{1: "a"}
+		n := map[string]bool{}
+
+		print(m[2] == "", n["x"], len(m))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true false 1", output)
+	})
+}
+
+func testMapLookupSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[string]int{"a": 0}
+
+		v, ok := m["a"]
+		print(v, ok)
+
+		v, ok = m["b"]
+		print(v, ok)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "0 true\n0 false", output)
+	})
+}
+
+func testMapDeleteSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+		delete(m, "a")
+		delete(m, "x")
+
+		v, ok := m["a"]
+		print(len(m), ok, v, m["b"], m["c"])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 false 0 2 3", output)
+	})
+}
+
+func testMapRangeSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[string]int{"a": 1, "b": 2, "c": 3}
+		keys := ""
+		sum := 0
+		count := 0
+
+		for k, v := range m {
+			keys += k
+			sum += v
+		}
+		for k := range m {
+			count++
+		}
+		print(len(keys), sum, count)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "3 6 3", output)
+	})
+}
+
+func testMapFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func count(words []string) map[string]int {
+			counts := map[string]int{}
+
+			for i, w := range words {
+				counts[w]++
+			}
+			return counts
+		}
+
+		func set(m map[string]int, k string) {
+			m[k] = 100
+		}
+
+		c := count([]string{"a", "b", "a"})
+		set(c, "z")
+
+		print(c["a"], c["b"], c["z"], len(c))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 1 100 3", output)
+	})
+}
+
+func testMapStructSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Name string
+			Port int
+		}
+
+		type Inventory struct {
+			Ports map[string]int
+		}
+
+		hs := map[string]Host{
+			"web": {"web", 80},
+		}
+		hs["db"] = Host{Name: "db", Port: 5432}
+
+		inv := Inventory{}
+		inv.Ports["ssh"] = 22
+
+		print(hs["web"].Port, hs["db"].Name, len(hs), hs["x"].Port, inv.Ports["ssh"])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "80 db 2 0 22", output)
+	})
+}
+
+func testMapInvalidKeyTypeFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		var m map[[]int]string
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "invalid map key type []int")
+	})
+}
+
+func testMapKeyTypeMismatchFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[string]int{}
+		m[1] = 2
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected string as key but got int")
+	})
+}
+
+func testMapValueTypeMismatchFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		m := map[string]int{"a": "b"}
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected int as value but got string")
+	})
+}
+
+func testDeleteNonMapFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		s := []int{}
+		delete(s, 0)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected map as first argument")
+	})
+}
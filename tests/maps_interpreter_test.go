@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterDefineMapSuccess(t *testing.T) {
+	testDefineMapSuccess(t, interpret)
+}
+
+func TestInterpreterMapAssignSuccess(t *testing.T) {
+	testMapAssignSuccess(t, interpret)
+}
+
+func TestInterpreterMapMissingKeySuccess(t *testing.T) {
+	testMapMissingKeySuccess(t, interpret)
+}
+
+func TestInterpreterMapLookupSuccess(t *testing.T) {
+	testMapLookupSuccess(t, interpret)
+}
+
+func TestInterpreterMapDeleteSuccess(t *testing.T) {
+	testMapDeleteSuccess(t, interpret)
+}
+
+func TestInterpreterMapRangeSuccess(t *testing.T) {
+	testMapRangeSuccess(t, interpret)
+}
+
+func TestInterpreterMapFunctionSuccess(t *testing.T) {
+	testMapFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterMapStructSuccess(t *testing.T) {
+	testMapStructSuccess(t, interpret)
+}
+
+func TestInterpreterMapInvalidKeyTypeFail(t *testing.T) {
+	testMapInvalidKeyTypeFail(t, interpret)
+}
+
+func TestInterpreterMapKeyTypeMismatchFail(t *testing.T) {
+	testMapKeyTypeMismatchFail(t, interpret)
+}
+
+func TestInterpreterMapValueTypeMismatchFail(t *testing.T) {
+	testMapValueTypeMismatchFail(t, interpret)
+}
+
+func TestInterpreterDeleteNonMapFail(t *testing.T) {
+	testDeleteNonMapFail(t, interpret)
+}
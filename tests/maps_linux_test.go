@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestDefineMapSuccess(t *testing.T) {
+	testDefineMapSuccess(t, transpileBash)
+}
+
+func TestMapAssignSuccess(t *testing.T) {
+	testMapAssignSuccess(t, transpileBash)
+}
+
+func TestMapMissingKeySuccess(t *testing.T) {
+	testMapMissingKeySuccess(t, transpileBash)
+}
+
+func TestMapLookupSuccess(t *testing.T) {
+	testMapLookupSuccess(t, transpileBash)
+}
+
+func TestMapDeleteSuccess(t *testing.T) {
+	testMapDeleteSuccess(t, transpileBash)
+}
+
+func TestMapRangeSuccess(t *testing.T) {
+	testMapRangeSuccess(t, transpileBash)
+}
+
+func TestMapFunctionSuccess(t *testing.T) {
+	testMapFunctionSuccess(t, transpileBash)
+}
+
+func TestMapStructSuccess(t *testing.T) {
+	testMapStructSuccess(t, transpileBash)
+}
+
+func TestMapInvalidKeyTypeFail(t *testing.T) {
+	testMapInvalidKeyTypeFail(t, transpileBash)
+}
+
+func TestMapKeyTypeMismatchFail(t *testing.T) {
+	testMapKeyTypeMismatchFail(t, transpileBash)
+}
+
+func TestMapValueTypeMismatchFail(t *testing.T) {
+	testMapValueTypeMismatchFail(t, transpileBash)
+}
+
+func TestDeleteNonMapFail(t *testing.T) {
+	testDeleteNonMapFail(t, transpileBash)
+}
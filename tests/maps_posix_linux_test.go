@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixDefineMapSuccess(t *testing.T) {
+	testDefineMapSuccess(t, transpilePosix)
+}
+
+func TestPosixMapAssignSuccess(t *testing.T) {
+	testMapAssignSuccess(t, transpilePosix)
+}
+
+func TestPosixMapMissingKeySuccess(t *testing.T) {
+	testMapMissingKeySuccess(t, transpilePosix)
+}
+
+func TestPosixMapLookupSuccess(t *testing.T) {
+	testMapLookupSuccess(t, transpilePosix)
+}
+
+func TestPosixMapDeleteSuccess(t *testing.T) {
+	testMapDeleteSuccess(t, transpilePosix)
+}
+
+func TestPosixMapRangeSuccess(t *testing.T) {
+	testMapRangeSuccess(t, transpilePosix)
+}
+
+func TestPosixMapFunctionSuccess(t *testing.T) {
+	testMapFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixMapStructSuccess(t *testing.T) {
+	testMapStructSuccess(t, transpilePosix)
+}
+
+func TestPosixMapInvalidKeyTypeFail(t *testing.T) {
+	testMapInvalidKeyTypeFail(t, transpilePosix)
+}
+
+func TestPosixMapKeyTypeMismatchFail(t *testing.T) {
+	testMapKeyTypeMismatchFail(t, transpilePosix)
+}
+
+func TestPosixMapValueTypeMismatchFail(t *testing.T) {
+	testMapValueTypeMismatchFail(t, transpilePosix)
+}
+
+func TestPosixDeleteNonMapFail(t *testing.T) {
+	testDeleteNonMapFail(t, transpilePosix)
+}
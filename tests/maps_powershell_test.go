@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellDefineMapSuccess(t *testing.T) {
+	testDefineMapSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapAssignSuccess(t *testing.T) {
+	testMapAssignSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapMissingKeySuccess(t *testing.T) {
+	testMapMissingKeySuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapLookupSuccess(t *testing.T) {
+	testMapLookupSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapDeleteSuccess(t *testing.T) {
+	testMapDeleteSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapRangeSuccess(t *testing.T) {
+	testMapRangeSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapFunctionSuccess(t *testing.T) {
+	testMapFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapStructSuccess(t *testing.T) {
+	testMapStructSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMapInvalidKeyTypeFail(t *testing.T) {
+	testMapInvalidKeyTypeFail(t, transpilePowerShell)
+}
+
+func TestPowerShellMapKeyTypeMismatchFail(t *testing.T) {
+	testMapKeyTypeMismatchFail(t, transpilePowerShell)
+}
+
+func TestPowerShellMapValueTypeMismatchFail(t *testing.T) {
+	testMapValueTypeMismatchFail(t, transpilePowerShell)
+}
+
+func TestPowerShellDeleteNonMapFail(t *testing.T) {
+	testDeleteNonMapFail(t, transpilePowerShell)
+}
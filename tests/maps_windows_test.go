@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestDefineMapSuccess(t *testing.T) {
+	testDefineMapSuccess(t, transpileBatch)
+}
+
+func TestMapAssignSuccess(t *testing.T) {
+	testMapAssignSuccess(t, transpileBatch)
+}
+
+func TestMapMissingKeySuccess(t *testing.T) {
+	testMapMissingKeySuccess(t, transpileBatch)
+}
+
+func TestMapLookupSuccess(t *testing.T) {
+	testMapLookupSuccess(t, transpileBatch)
+}
+
+func TestMapDeleteSuccess(t *testing.T) {
+	testMapDeleteSuccess(t, transpileBatch)
+}
+
+func TestMapRangeSuccess(t *testing.T) {
+	testMapRangeSuccess(t, transpileBatch)
+}
+
+func TestMapFunctionSuccess(t *testing.T) {
+	testMapFunctionSuccess(t, transpileBatch)
+}
+
+func TestMapStructSuccess(t *testing.T) {
+	testMapStructSuccess(t, transpileBatch)
+}
+
+func TestMapInvalidKeyTypeFail(t *testing.T) {
+	testMapInvalidKeyTypeFail(t, transpileBatch)
+}
+
+func TestMapKeyTypeMismatchFail(t *testing.T) {
+	testMapKeyTypeMismatchFail(t, transpileBatch)
+}
+
+func TestMapValueTypeMismatchFail(t *testing.T) {
+	testMapValueTypeMismatchFail(t, transpileBatch)
+}
+
+func TestDeleteNonMapFail(t *testing.T) {
+	testDeleteNonMapFail(t, transpileBatch)
+}
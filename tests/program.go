@@ -84,7 +84,7 @@ func testComplexProgram1Success(t *testing.T, transpilerFunc transpilerFunc) {
 		print("Constants:", constants[0], constants[1], constants[2])
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "Double 10: 20 Error: \nDouble -5: 0 Error: negative number\nStatus: OK: Ready OK: 1\nStatus: FAIL: Error OK: 0\nAll non-zero: 1\nAll non-zero: 0\nCount true: 3\nConstants: 1 2 3", output)
+		require.Equal(t, "Double 10: 20 Error: \nDouble -5: 0 Error: negative number\nStatus: OK: Ready OK: true\nStatus: FAIL: Error OK: false\nAll non-zero: true\nAll non-zero: false\nCount true: 3\nConstants: 1 2 3", output)
 	})
 }
 
@@ -313,7 +313,7 @@ func testComplexProgram3Success(t *testing.T, transpilerFunc transpilerFunc) {
 		print("ReportCard 3:", rep, "Error:", err)
 	`, func(output string, err error) {
 		require.Nil(t, err)
-		require.Equal(t, "Summary 1: OK: Math = 30 Success: 1\nSummary 2: FAIL: Science = 0 Success: 0\nLabels valid: 1 Message: all labels valid\nLabel 0 = Score_1\nLabel 1 = Score_2\nReportCard 1: OK: English = 15 Error: \nReportCard 2: FAIL: History = 0 Error: some scores were non-positive\nReportCard 3:  Error: inactive user", output)
+		require.Equal(t, "Summary 1: OK: Math = 30 Success: true\nSummary 2: FAIL: Science = 0 Success: false\nLabels valid: true Message: all labels valid\nLabel 0 = Score_1\nLabel 1 = Score_2\nReportCard 1: OK: English = 15 Error: \nReportCard 2: FAIL: History = 0 Error: some scores were non-positive\nReportCard 3:  Error: inactive user", output)
 	})
 }
 
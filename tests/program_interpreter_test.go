@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterComplexProgram1Success(t *testing.T) {
+	testComplexProgram1Success(t, interpret)
+}
+
+func TestInterpreterComplexProgram2Success(t *testing.T) {
+	testComplexProgram2Success(t, interpret)
+}
+
+func TestInterpreterComplexProgram3Success(t *testing.T) {
+	testComplexProgram3Success(t, interpret)
+}
+
+func TestInterpreterComplexProgram4Success(t *testing.T) {
+	testComplexProgram4Success(t, interpret)
+}
@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixComplexProgram1Success(t *testing.T) {
+	testComplexProgram1Success(t, transpilePosix)
+}
+
+func TestPosixComplexProgram2Success(t *testing.T) {
+	testComplexProgram2Success(t, transpilePosix)
+}
+
+func TestPosixComplexProgram3Success(t *testing.T) {
+	testComplexProgram3Success(t, transpilePosix)
+}
+
+func TestPosixComplexProgram4Success(t *testing.T) {
+	testComplexProgram4Success(t, transpilePosix)
+}
@@ -0,0 +1,21 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellComplexProgram1Success(t *testing.T) {
+	testComplexProgram1Success(t, transpilePowerShell)
+}
+
+func TestPowerShellComplexProgram2Success(t *testing.T) {
+	testComplexProgram2Success(t, transpilePowerShell)
+}
+
+func TestPowerShellComplexProgram3Success(t *testing.T) {
+	testComplexProgram3Success(t, transpilePowerShell)
+}
+
+func TestPowerShellComplexProgram4Success(t *testing.T) {
+	testComplexProgram4Success(t, transpilePowerShell)
+}
@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// quotingCorpus contains values which have a special meaning in Bash or Batch. All of them
+// must be passed through the transpiled code without being modified or evaluated.
+var quotingCorpus = []string{
+	`a"b`,
+	"c`d`e",
+	"$(echo hi)",
+	"${HOME}",
+	"$0",
+	`x\y`,
+	`\"`,
+	"*",
+	"-n",
+	"/?",
+	"]",
+	"it's",
+	"%PATH%",
+	"%%",
+	"!x!",
+	"a^b",
+	"^!",
+	"&|<>()",
+	"a;b",
+	"l1\nl2",
+	"äö",
+	"€ 日本",
+	"",
+	"end",
+}
+
+func quotingCorpusLiterals(values []string) []string {
+	literals := []string{}
+
+	for _, value := range values {
+		literals = append(literals, strconv.Quote(value))
+	}
+	return literals
+}
+
+func testQuotingPrintSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	source := ""
+
+	for _, literal := range quotingCorpusLiterals(quotingCorpus) {
+		source += fmt.Sprintf("print(%s)\n", literal)
+	}
+
+	transpilerFunc(t, source, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(quotingCorpus, "\n"), output)
+	})
+}
+
+func testQuotingValuesSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	literals := quotingCorpusLiterals(quotingCorpus)
+	comparisons := ""
+	expected := []string{}
+
+	for i, literal := range literals {
+		comparisons += fmt.Sprintf("print(values[%d] == %s)\n", i, literal)
+		expected = append(expected, "true")
+	}
+
+	for _, value := range quotingCorpus {
+		expected = append(expected, fmt.Sprintf("[%s] true %d", value, len(value)))
+	}
+
+	transpilerFunc(t, `
+		func id(s string) string {
+			return s
+		}
+
+		values := []string{`+strings.Join(literals, ", ")+`}
+		m := map[string]string{}
+
+		`+comparisons+`
+
+		for i, v := range values {
+			m[v] = v
+		}
+
+		for i := 0; i < len(values); i++ {
+			v := id(values[i])
+			print("[" + v + "]", m[v] == values[i], len(v))
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), output)
+	})
+}
+
+func testQuotingFileSuccess(t *testing.T, transpilerCalloutFunc transpilerCalloutFunc, values []string) {
+	transpilerCalloutFunc(t, func(dir string) (string, error) {
+		source := ""
+
+		for i, literal := range quotingCorpusLiterals(values) {
+			file := strconv.Quote(filepath.Join(dir, fmt.Sprintf("file%d.txt", i)))
+			source += fmt.Sprintf("write(%s, %s)\nprint(\"[\" + read(%s) + \"]\")\n", file, literal, file)
+		}
+		return source, nil
+	}, func(output string, err error) {
+		expected := []string{}
+
+		for _, value := range values {
+			expected = append(expected, fmt.Sprintf("[%s]", value))
+		}
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), output)
+	})
+}
@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterQuotingPrintSuccess(t *testing.T) {
+	testQuotingPrintSuccess(t, interpret)
+}
+
+func TestInterpreterQuotingValuesSuccess(t *testing.T) {
+	testQuotingValuesSuccess(t, interpret)
+}
+
+func TestInterpreterQuotingFileSuccess(t *testing.T) {
+	testQuotingFileSuccess(t, interpretFunc, quotingCorpus)
+}
@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestQuotingPrintSuccess(t *testing.T) {
+	testQuotingPrintSuccess(t, transpileBash)
+}
+
+func TestQuotingValuesSuccess(t *testing.T) {
+	testQuotingValuesSuccess(t, transpileBash)
+}
+
+func TestQuotingFileSuccess(t *testing.T) {
+	testQuotingFileSuccess(t, transpileBashFunc, quotingCorpus)
+}
@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixQuotingPrintSuccess(t *testing.T) {
+	testQuotingPrintSuccess(t, transpilePosix)
+}
+
+func TestPosixQuotingValuesSuccess(t *testing.T) {
+	testQuotingValuesSuccess(t, transpilePosix)
+}
+
+func TestPosixQuotingFileSuccess(t *testing.T) {
+	testQuotingFileSuccess(t, transpilePosixFunc, quotingCorpus)
+}
@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellQuotingPrintSuccess(t *testing.T) {
+	testQuotingPrintSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellQuotingValuesSuccess(t *testing.T) {
+	testQuotingValuesSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellQuotingFileSuccess(t *testing.T) {
+	testQuotingFileSuccess(t, transpilePowerShellFunc, quotingCorpus)
+}
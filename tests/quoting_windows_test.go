@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestQuotingPrintSuccess(t *testing.T) {
+	testQuotingPrintSuccess(t, transpileBatch)
+}
+
+func TestQuotingValuesSuccess(t *testing.T) {
+	testQuotingValuesSuccess(t, transpileBatch)
+}
+
+func TestQuotingFileSuccess(t *testing.T) {
+	testQuotingFileSuccess(t, transpileBatchFunc, quotingCorpus)
+}
@@ -103,6 +103,160 @@ func testCopySliceSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 	})
 }
 
+func testAppendSliceSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []int{1, 2}
+		b := append(a, 3, 4)
+		b[0] = 5
+
+		var c []string
+		c = append(c, "x")
+		c = append(c)
+
+		print(len(a), a[0], a[1])
+		print(len(b), b[0], b[1], b[2], b[3])
+		print(len(c), c[0])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 1 2\n4 5 2 3 4\n1 x", output)
+	})
+}
+
+func testAppendStructSliceSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type person struct {
+			name string
+			age  int
+		}
+		p := []person{person{name: "a", age: 1}}
+		p = append(p, person{name: "b", age: 2})
+
+		print(len(p), p[1].name, p[1].age)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 b 2", output)
+	})
+}
+
+func testAppendSpreadSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type person struct {
+			name string
+			age  int
+		}
+		a := []int{1, 2}
+		b := append(a, []int{3, 4}...)
+		a = append(a, a...)
+
+		p := []person{person{name: "a", age: 1}}
+		p = append(p, []person{person{name: "b", age: 2}}...)
+
+		print(len(a), a[2], a[3])
+		print(len(b), b[0], b[3])
+		print(len(p), p[1].name, p[1].age)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "4 1 2\n4 1 4\n2 b 2", output)
+	})
+}
+
+func testAppendInPlaceSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []int{1}
+		b := a
+		c := append(a, 2)
+		a = append(a, 3)
+
+		print(len(a), len(b), len(c), a[1], c[1])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 2 2 3 2", output)
+	})
+}
+
+func testAppendSpreadNoSliceFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []int{1}
+		a = append(a, 2...)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected []int but got int")
+	})
+}
+
+func testAppendSliceWrongTypeFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []int{1}
+		a = append(a, "2")
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected int but got string")
+	})
+}
+
+func testAppendNoSliceFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := 1
+		b := append(a, 2)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected slice as first argument")
+	})
+}
+
+func testSliceRangeSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []int{1, 2, 3, 4, 5}
+		b := a[1:3]
+		c := a[:2]
+		d := a[3:]
+		e := a[:]
+		b[0] = 6
+
+		print(len(b), b[0], b[1], a[1])
+		print(len(c), c[0], c[1])
+		print(len(d), d[0], d[1])
+		print(len(e), a[1:][0])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 6 3 2\n2 1 2\n2 4 5\n5 2", output)
+	})
+}
+
+func testSliceRangeOutOfBoundsSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []string{"a", "b", "c"}
+		b := a[1:10]
+		c := a[2:1]
+		d := a[5:]
+
+		print(len(b), b[0], b[1])
+		print(len(c), len(d))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 b c\n0 0", output)
+	})
+}
+
+func testSliceEvaluationOutOfBoundsSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []int{1}
+		b := []bool{true}
+		c := []string{"c"}
+
+		print(a[5], b[5], "-" + c[5] + "-", a[5] + 2)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "0 false -- 2", output)
+	})
+}
+
+func testSliceRangeWrongIndexTypeFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := []int{1, 2}
+		b := a[1:"2"]
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected int as stop-index but got string")
+	})
+}
+
 func testDefineSliceInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 	transpilerFunc(t, `
 		func test() {
@@ -221,6 +375,25 @@ func testCopySliceInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc)
 	})
 }
 
+func testAppendSliceInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func squares(n int) []int {
+			s := []int{}
+
+			for i := 0; i < n; i++ {
+				s = append(s, i*i)
+			}
+			return s[1:]
+		}
+		s := squares(4)
+
+		print(len(s), s[0], s[1], s[2])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "3 1 4 9", output)
+	})
+}
+
 func testSliceReturnedFromFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 	transpilerFunc(t, `
 		i := 0
@@ -274,3 +447,105 @@ func testComplexSliceOperationsSuccess(t *testing.T, transpilerFunc transpilerFu
 		require.Equal(t, "amount 1: 4\namount 2: 4\nhello world\nhello mars\n\nhello mum\nhello world", output)
 	})
 }
+
+func testDefineMultiDimensionalSliceSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := [][]string{{"a", "b"}, []string{"c"}}
+		var b [][][]int
+
+		print(len(a), len(a[0]), len(a[1]), len(b))
+		print(a[0][0], a[0][1], a[1][0])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 2 1 0\na b c", output)
+	})
+}
+
+func testMultiDimensionalSliceAssignValuesSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := [][]int{{1}, {2, 3}}
+		a[0][2] = 4
+		a[1] = []int{5}
+		a = append(a, []int{6})
+		a[2] = append(a[2], 7)
+
+		b := [][][]int{{{1}}}
+		b[0][0][1] = 2
+
+		print(len(a[0]), a[0][0], a[0][1], a[0][2])
+		print(len(a[1]), a[1][0])
+		print(len(a), a[2][0], a[2][1])
+		print(len(b[0][0]), b[0][0][1])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "3 1 0 4\n1 5\n3 6 7\n2 2", output)
+	})
+}
+
+func testIterateMultiDimensionalSliceSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := [][]string{{"a", "b"}, {"c"}}
+
+		for i, row := range a {
+			for j, cell := range row {
+				print(i, j, cell)
+			}
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "0 0 a\n0 1 b\n1 0 c", output)
+	})
+}
+
+func testMultiDimensionalSliceInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func split(lines []string) [][]string {
+			rows := [][]string{}
+
+			for i := 0; i < len(lines); i++ {
+				row := []string{}
+				cell := ""
+				line := lines[i]
+
+				for j := 0; j < len(line); j++ {
+					if line[j] == "," {
+						row = append(row, cell)
+						cell = ""
+					} else {
+						cell += line[j]
+					}
+				}
+				rows = append(rows, append(row, cell))
+			}
+			rows[0][0] = "id"
+			return rows
+		}
+		rows := split([]string{"a,b", "1,2,3"})
+
+		print(len(rows), len(rows[0]), len(rows[1]))
+		print(rows[0][0], rows[0][1], rows[1][2])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 2 3\nid b 3", output)
+	})
+}
+
+func testMultiDimensionalSliceAssignWrongTypeFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		a := [][]string{{"a"}}
+		a[0] = "b"
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected []string value but got string")
+	})
+}
+
+func testMultiDimensionalStructSliceFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type person struct {
+			name string
+		}
+		var a [][]person
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "multi-dimensional slices of person are not supported")
+	})
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterDefineSliceSuccess(t *testing.T) {
+	testDefineSliceSuccess(t, interpret)
+}
+
+func TestInterpreterSliceAssignValuesSuccess(t *testing.T) {
+	testSliceAssignValuesSuccess(t, interpret)
+}
+
+func TestInterpreterSliceAssignUndefinedSubscriptSuccess(t *testing.T) {
+	testSliceAssignUndefinedSubscriptSuccess(t, interpret)
+}
+
+func TestInterpreterSliceLengthSuccess(t *testing.T) {
+	testSliceLengthSuccess(t, interpret)
+}
+
+func TestInterpreterIterateSliceSuccess(t *testing.T) {
+	testIterateSliceSuccess(t, interpret)
+}
+
+func TestInterpreterReassignSliceSuccess(t *testing.T) {
+	testReassignSliceSuccess(t, interpret)
+}
+
+func TestInterpreterCopySliceSuccess(t *testing.T) {
+	testCopySliceSuccess(t, interpret)
+}
+
+func TestInterpreterDefineSliceInFunctionSuccess(t *testing.T) {
+	testDefineSliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSliceAssignValuesInFunctionSuccess(t *testing.T) {
+	testSliceAssignValuesInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSliceAssignUndefinedSubscriptInFunctionSuccess(t *testing.T) {
+	testSliceAssignUndefinedSubscriptInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSliceLengthInFunctionSuccess(t *testing.T) {
+	testSliceLengthInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterIterateSliceInFunctionSuccess(t *testing.T) {
+	testIterateSliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterReassignSliceInFunctionSuccess(t *testing.T) {
+	testReassignSliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterCopySliceInFunctionSuccess(t *testing.T) {
+	testCopySliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSliceReturnedFromFunctionSuccess(t *testing.T) {
+	testSliceReturnedFromFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterComplexSliceOperationsSuccess(t *testing.T) {
+	testComplexSliceOperationsSuccess(t, interpret)
+}
+
+func TestInterpreterAppendSliceSuccess(t *testing.T) {
+	testAppendSliceSuccess(t, interpret)
+}
+
+func TestInterpreterAppendStructSliceSuccess(t *testing.T) {
+	testAppendStructSliceSuccess(t, interpret)
+}
+
+func TestInterpreterAppendSpreadSuccess(t *testing.T) {
+	testAppendSpreadSuccess(t, interpret)
+}
+
+func TestInterpreterAppendInPlaceSuccess(t *testing.T) {
+	testAppendInPlaceSuccess(t, interpret)
+}
+
+func TestInterpreterAppendSpreadNoSliceFail(t *testing.T) {
+	testAppendSpreadNoSliceFail(t, interpret)
+}
+
+func TestInterpreterAppendSliceWrongTypeFail(t *testing.T) {
+	testAppendSliceWrongTypeFail(t, interpret)
+}
+
+func TestInterpreterAppendNoSliceFail(t *testing.T) {
+	testAppendNoSliceFail(t, interpret)
+}
+
+func TestInterpreterSliceRangeSuccess(t *testing.T) {
+	testSliceRangeSuccess(t, interpret)
+}
+
+func TestInterpreterSliceRangeOutOfBoundsSuccess(t *testing.T) {
+	testSliceRangeOutOfBoundsSuccess(t, interpret)
+}
+
+func TestInterpreterSliceEvaluationOutOfBoundsSuccess(t *testing.T) {
+	testSliceEvaluationOutOfBoundsSuccess(t, interpret)
+}
+
+func TestInterpreterSliceRangeWrongIndexTypeFail(t *testing.T) {
+	testSliceRangeWrongIndexTypeFail(t, interpret)
+}
+
+func TestInterpreterAppendSliceInFunctionSuccess(t *testing.T) {
+	testAppendSliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterDefineMultiDimensionalSliceSuccess(t *testing.T) {
+	testDefineMultiDimensionalSliceSuccess(t, interpret)
+}
+
+func TestInterpreterMultiDimensionalSliceAssignValuesSuccess(t *testing.T) {
+	testMultiDimensionalSliceAssignValuesSuccess(t, interpret)
+}
+
+func TestInterpreterIterateMultiDimensionalSliceSuccess(t *testing.T) {
+	testIterateMultiDimensionalSliceSuccess(t, interpret)
+}
+
+func TestInterpreterMultiDimensionalSliceInFunctionSuccess(t *testing.T) {
+	testMultiDimensionalSliceInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterMultiDimensionalSliceAssignWrongTypeFail(t *testing.T) {
+	testMultiDimensionalSliceAssignWrongTypeFail(t, interpret)
+}
+
+func TestInterpreterMultiDimensionalStructSliceFail(t *testing.T) {
+	testMultiDimensionalStructSliceFail(t, interpret)
+}
@@ -67,3 +67,75 @@ func TestSliceReturnedFromFunctionSuccess(t *testing.T) {
 func TestComplexSliceOperationsSuccess(t *testing.T) {
 	testComplexSliceOperationsSuccess(t, transpileBash)
 }
+
+func TestAppendSliceSuccess(t *testing.T) {
+	testAppendSliceSuccess(t, transpileBash)
+}
+
+func TestAppendStructSliceSuccess(t *testing.T) {
+	testAppendStructSliceSuccess(t, transpileBash)
+}
+
+func TestAppendSpreadSuccess(t *testing.T) {
+	testAppendSpreadSuccess(t, transpileBash)
+}
+
+func TestAppendInPlaceSuccess(t *testing.T) {
+	testAppendInPlaceSuccess(t, transpileBash)
+}
+
+func TestAppendSpreadNoSliceFail(t *testing.T) {
+	testAppendSpreadNoSliceFail(t, transpileBash)
+}
+
+func TestAppendSliceWrongTypeFail(t *testing.T) {
+	testAppendSliceWrongTypeFail(t, transpileBash)
+}
+
+func TestAppendNoSliceFail(t *testing.T) {
+	testAppendNoSliceFail(t, transpileBash)
+}
+
+func TestSliceRangeSuccess(t *testing.T) {
+	testSliceRangeSuccess(t, transpileBash)
+}
+
+func TestSliceRangeOutOfBoundsSuccess(t *testing.T) {
+	testSliceRangeOutOfBoundsSuccess(t, transpileBash)
+}
+
+func TestSliceEvaluationOutOfBoundsSuccess(t *testing.T) {
+	testSliceEvaluationOutOfBoundsSuccess(t, transpileBash)
+}
+
+func TestSliceRangeWrongIndexTypeFail(t *testing.T) {
+	testSliceRangeWrongIndexTypeFail(t, transpileBash)
+}
+
+func TestAppendSliceInFunctionSuccess(t *testing.T) {
+	testAppendSliceInFunctionSuccess(t, transpileBash)
+}
+
+func TestDefineMultiDimensionalSliceSuccess(t *testing.T) {
+	testDefineMultiDimensionalSliceSuccess(t, transpileBash)
+}
+
+func TestMultiDimensionalSliceAssignValuesSuccess(t *testing.T) {
+	testMultiDimensionalSliceAssignValuesSuccess(t, transpileBash)
+}
+
+func TestIterateMultiDimensionalSliceSuccess(t *testing.T) {
+	testIterateMultiDimensionalSliceSuccess(t, transpileBash)
+}
+
+func TestMultiDimensionalSliceInFunctionSuccess(t *testing.T) {
+	testMultiDimensionalSliceInFunctionSuccess(t, transpileBash)
+}
+
+func TestMultiDimensionalSliceAssignWrongTypeFail(t *testing.T) {
+	testMultiDimensionalSliceAssignWrongTypeFail(t, transpileBash)
+}
+
+func TestMultiDimensionalStructSliceFail(t *testing.T) {
+	testMultiDimensionalStructSliceFail(t, transpileBash)
+}
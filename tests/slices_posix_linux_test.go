@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixDefineSliceSuccess(t *testing.T) {
+	testDefineSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceAssignValuesSuccess(t *testing.T) {
+	testSliceAssignValuesSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceAssignUndefinedSubscriptSuccess(t *testing.T) {
+	testSliceAssignUndefinedSubscriptSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceLengthSuccess(t *testing.T) {
+	testSliceLengthSuccess(t, transpilePosix)
+}
+
+func TestPosixIterateSliceSuccess(t *testing.T) {
+	testIterateSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixReassignSliceSuccess(t *testing.T) {
+	testReassignSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixCopySliceSuccess(t *testing.T) {
+	testCopySliceSuccess(t, transpilePosix)
+}
+
+func TestPosixDefineSliceInFunctionSuccess(t *testing.T) {
+	testDefineSliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceAssignValuesInFunctionSuccess(t *testing.T) {
+	testSliceAssignValuesInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceAssignUndefinedSubscriptInFunctionSuccess(t *testing.T) {
+	testSliceAssignUndefinedSubscriptInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceLengthInFunctionSuccess(t *testing.T) {
+	testSliceLengthInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixIterateSliceInFunctionSuccess(t *testing.T) {
+	testIterateSliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixReassignSliceInFunctionSuccess(t *testing.T) {
+	testReassignSliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixCopySliceInFunctionSuccess(t *testing.T) {
+	testCopySliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceReturnedFromFunctionSuccess(t *testing.T) {
+	testSliceReturnedFromFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixComplexSliceOperationsSuccess(t *testing.T) {
+	testComplexSliceOperationsSuccess(t, transpilePosix)
+}
+
+func TestPosixAppendSliceSuccess(t *testing.T) {
+	testAppendSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixAppendStructSliceSuccess(t *testing.T) {
+	testAppendStructSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixAppendSpreadSuccess(t *testing.T) {
+	testAppendSpreadSuccess(t, transpilePosix)
+}
+
+func TestPosixAppendInPlaceSuccess(t *testing.T) {
+	testAppendInPlaceSuccess(t, transpilePosix)
+}
+
+func TestPosixAppendSpreadNoSliceFail(t *testing.T) {
+	testAppendSpreadNoSliceFail(t, transpilePosix)
+}
+
+func TestPosixAppendSliceWrongTypeFail(t *testing.T) {
+	testAppendSliceWrongTypeFail(t, transpilePosix)
+}
+
+func TestPosixAppendNoSliceFail(t *testing.T) {
+	testAppendNoSliceFail(t, transpilePosix)
+}
+
+func TestPosixSliceRangeSuccess(t *testing.T) {
+	testSliceRangeSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceRangeOutOfBoundsSuccess(t *testing.T) {
+	testSliceRangeOutOfBoundsSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceEvaluationOutOfBoundsSuccess(t *testing.T) {
+	testSliceEvaluationOutOfBoundsSuccess(t, transpilePosix)
+}
+
+func TestPosixSliceRangeWrongIndexTypeFail(t *testing.T) {
+	testSliceRangeWrongIndexTypeFail(t, transpilePosix)
+}
+
+func TestPosixAppendSliceInFunctionSuccess(t *testing.T) {
+	testAppendSliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixDefineMultiDimensionalSliceSuccess(t *testing.T) {
+	testDefineMultiDimensionalSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixMultiDimensionalSliceAssignValuesSuccess(t *testing.T) {
+	testMultiDimensionalSliceAssignValuesSuccess(t, transpilePosix)
+}
+
+func TestPosixIterateMultiDimensionalSliceSuccess(t *testing.T) {
+	testIterateMultiDimensionalSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixMultiDimensionalSliceInFunctionSuccess(t *testing.T) {
+	testMultiDimensionalSliceInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixMultiDimensionalSliceAssignWrongTypeFail(t *testing.T) {
+	testMultiDimensionalSliceAssignWrongTypeFail(t, transpilePosix)
+}
+
+func TestPosixMultiDimensionalStructSliceFail(t *testing.T) {
+	testMultiDimensionalStructSliceFail(t, transpilePosix)
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellDefineSliceSuccess(t *testing.T) {
+	testDefineSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceAssignValuesSuccess(t *testing.T) {
+	testSliceAssignValuesSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceAssignUndefinedSubscriptSuccess(t *testing.T) {
+	testSliceAssignUndefinedSubscriptSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceLengthSuccess(t *testing.T) {
+	testSliceLengthSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIterateSliceSuccess(t *testing.T) {
+	testIterateSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellReassignSliceSuccess(t *testing.T) {
+	testReassignSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCopySliceSuccess(t *testing.T) {
+	testCopySliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellDefineSliceInFunctionSuccess(t *testing.T) {
+	testDefineSliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceAssignValuesInFunctionSuccess(t *testing.T) {
+	testSliceAssignValuesInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceAssignUndefinedSubscriptInFunctionSuccess(t *testing.T) {
+	testSliceAssignUndefinedSubscriptInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceLengthInFunctionSuccess(t *testing.T) {
+	testSliceLengthInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIterateSliceInFunctionSuccess(t *testing.T) {
+	testIterateSliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellReassignSliceInFunctionSuccess(t *testing.T) {
+	testReassignSliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellCopySliceInFunctionSuccess(t *testing.T) {
+	testCopySliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceReturnedFromFunctionSuccess(t *testing.T) {
+	testSliceReturnedFromFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellComplexSliceOperationsSuccess(t *testing.T) {
+	testComplexSliceOperationsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendSliceSuccess(t *testing.T) {
+	testAppendSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendStructSliceSuccess(t *testing.T) {
+	testAppendStructSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendSpreadSuccess(t *testing.T) {
+	testAppendSpreadSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendInPlaceSuccess(t *testing.T) {
+	testAppendInPlaceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendSpreadNoSliceFail(t *testing.T) {
+	testAppendSpreadNoSliceFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendSliceWrongTypeFail(t *testing.T) {
+	testAppendSliceWrongTypeFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendNoSliceFail(t *testing.T) {
+	testAppendNoSliceFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceRangeSuccess(t *testing.T) {
+	testSliceRangeSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceRangeOutOfBoundsSuccess(t *testing.T) {
+	testSliceRangeOutOfBoundsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceEvaluationOutOfBoundsSuccess(t *testing.T) {
+	testSliceEvaluationOutOfBoundsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSliceRangeWrongIndexTypeFail(t *testing.T) {
+	testSliceRangeWrongIndexTypeFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAppendSliceInFunctionSuccess(t *testing.T) {
+	testAppendSliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellDefineMultiDimensionalSliceSuccess(t *testing.T) {
+	testDefineMultiDimensionalSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMultiDimensionalSliceAssignValuesSuccess(t *testing.T) {
+	testMultiDimensionalSliceAssignValuesSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellIterateMultiDimensionalSliceSuccess(t *testing.T) {
+	testIterateMultiDimensionalSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMultiDimensionalSliceInFunctionSuccess(t *testing.T) {
+	testMultiDimensionalSliceInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMultiDimensionalSliceAssignWrongTypeFail(t *testing.T) {
+	testMultiDimensionalSliceAssignWrongTypeFail(t, transpilePowerShell)
+}
+
+func TestPowerShellMultiDimensionalStructSliceFail(t *testing.T) {
+	testMultiDimensionalStructSliceFail(t, transpilePowerShell)
+}
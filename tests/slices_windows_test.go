@@ -67,3 +67,75 @@ func TestSliceReturnedFromFunctionSuccess(t *testing.T) {
 func TestComplexSliceOperationsSuccess(t *testing.T) {
 	testComplexSliceOperationsSuccess(t, transpileBatch)
 }
+
+func TestAppendSliceSuccess(t *testing.T) {
+	testAppendSliceSuccess(t, transpileBatch)
+}
+
+func TestAppendStructSliceSuccess(t *testing.T) {
+	testAppendStructSliceSuccess(t, transpileBatch)
+}
+
+func TestAppendSpreadSuccess(t *testing.T) {
+	testAppendSpreadSuccess(t, transpileBatch)
+}
+
+func TestAppendInPlaceSuccess(t *testing.T) {
+	testAppendInPlaceSuccess(t, transpileBatch)
+}
+
+func TestAppendSpreadNoSliceFail(t *testing.T) {
+	testAppendSpreadNoSliceFail(t, transpileBatch)
+}
+
+func TestAppendSliceWrongTypeFail(t *testing.T) {
+	testAppendSliceWrongTypeFail(t, transpileBatch)
+}
+
+func TestAppendNoSliceFail(t *testing.T) {
+	testAppendNoSliceFail(t, transpileBatch)
+}
+
+func TestSliceRangeSuccess(t *testing.T) {
+	testSliceRangeSuccess(t, transpileBatch)
+}
+
+func TestSliceRangeOutOfBoundsSuccess(t *testing.T) {
+	testSliceRangeOutOfBoundsSuccess(t, transpileBatch)
+}
+
+func TestSliceEvaluationOutOfBoundsSuccess(t *testing.T) {
+	testSliceEvaluationOutOfBoundsSuccess(t, transpileBatch)
+}
+
+func TestSliceRangeWrongIndexTypeFail(t *testing.T) {
+	testSliceRangeWrongIndexTypeFail(t, transpileBatch)
+}
+
+func TestAppendSliceInFunctionSuccess(t *testing.T) {
+	testAppendSliceInFunctionSuccess(t, transpileBatch)
+}
+
+func TestDefineMultiDimensionalSliceSuccess(t *testing.T) {
+	testDefineMultiDimensionalSliceSuccess(t, transpileBatch)
+}
+
+func TestMultiDimensionalSliceAssignValuesSuccess(t *testing.T) {
+	testMultiDimensionalSliceAssignValuesSuccess(t, transpileBatch)
+}
+
+func TestIterateMultiDimensionalSliceSuccess(t *testing.T) {
+	testIterateMultiDimensionalSliceSuccess(t, transpileBatch)
+}
+
+func TestMultiDimensionalSliceInFunctionSuccess(t *testing.T) {
+	testMultiDimensionalSliceInFunctionSuccess(t, transpileBatch)
+}
+
+func TestMultiDimensionalSliceAssignWrongTypeFail(t *testing.T) {
+	testMultiDimensionalSliceAssignWrongTypeFail(t, transpileBatch)
+}
+
+func TestMultiDimensionalStructSliceFail(t *testing.T) {
+	testMultiDimensionalStructSliceFail(t, transpileBatch)
+}
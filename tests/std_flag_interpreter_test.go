@@ -0,0 +1,27 @@
+package tests
+
+import "testing"
+
+func TestInterpreterStdFlagDefaultsSuccess(t *testing.T) {
+	testStdFlagDefaultsSuccess(t, interpretArgs)
+}
+
+func TestInterpreterStdFlagParseSuccess(t *testing.T) {
+	testStdFlagParseSuccess(t, interpretArgs)
+}
+
+func TestInterpreterStdFlagParseWithEqualSignSuccess(t *testing.T) {
+	testStdFlagParseWithEqualSignSuccess(t, interpretArgs)
+}
+
+func TestInterpreterStdFlagUndefinedFail(t *testing.T) {
+	testStdFlagUndefinedFail(t, interpretArgs)
+}
+
+func TestInterpreterStdFlagMissingValueFail(t *testing.T) {
+	testStdFlagMissingValueFail(t, interpretArgs)
+}
+
+func TestInterpreterStdFlagPrintDefaultsSuccess(t *testing.T) {
+	testStdFlagPrintDefaultsSuccess(t, interpretArgs)
+}
@@ -0,0 +1,27 @@
+package tests
+
+import "testing"
+
+func TestStdFlagDefaultsSuccess(t *testing.T) {
+	testStdFlagDefaultsSuccess(t, transpileBashArgs)
+}
+
+func TestStdFlagParseSuccess(t *testing.T) {
+	testStdFlagParseSuccess(t, transpileBashArgs)
+}
+
+func TestStdFlagParseWithEqualSignSuccess(t *testing.T) {
+	testStdFlagParseWithEqualSignSuccess(t, transpileBashArgs)
+}
+
+func TestStdFlagUndefinedFail(t *testing.T) {
+	testStdFlagUndefinedFail(t, transpileBashArgs)
+}
+
+func TestStdFlagMissingValueFail(t *testing.T) {
+	testStdFlagMissingValueFail(t, transpileBashArgs)
+}
+
+func TestStdFlagPrintDefaultsSuccess(t *testing.T) {
+	testStdFlagPrintDefaultsSuccess(t, transpileBashArgs)
+}
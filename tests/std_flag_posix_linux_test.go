@@ -0,0 +1,27 @@
+package tests
+
+import "testing"
+
+func TestPosixStdFlagDefaultsSuccess(t *testing.T) {
+	testStdFlagDefaultsSuccess(t, transpilePosixArgs)
+}
+
+func TestPosixStdFlagParseSuccess(t *testing.T) {
+	testStdFlagParseSuccess(t, transpilePosixArgs)
+}
+
+func TestPosixStdFlagParseWithEqualSignSuccess(t *testing.T) {
+	testStdFlagParseWithEqualSignSuccess(t, transpilePosixArgs)
+}
+
+func TestPosixStdFlagUndefinedFail(t *testing.T) {
+	testStdFlagUndefinedFail(t, transpilePosixArgs)
+}
+
+func TestPosixStdFlagMissingValueFail(t *testing.T) {
+	testStdFlagMissingValueFail(t, transpilePosixArgs)
+}
+
+func TestPosixStdFlagPrintDefaultsSuccess(t *testing.T) {
+	testStdFlagPrintDefaultsSuccess(t, transpilePosixArgs)
+}
@@ -0,0 +1,27 @@
+package tests
+
+import "testing"
+
+func TestPowerShellStdFlagDefaultsSuccess(t *testing.T) {
+	testStdFlagDefaultsSuccess(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellStdFlagParseSuccess(t *testing.T) {
+	testStdFlagParseSuccess(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellStdFlagParseWithEqualSignSuccess(t *testing.T) {
+	testStdFlagParseWithEqualSignSuccess(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellStdFlagUndefinedFail(t *testing.T) {
+	testStdFlagUndefinedFail(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellStdFlagMissingValueFail(t *testing.T) {
+	testStdFlagMissingValueFail(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellStdFlagPrintDefaultsSuccess(t *testing.T) {
+	testStdFlagPrintDefaultsSuccess(t, transpilePowerShellArgs)
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const flagTestSource = `
+	import (
+		"flag"
+		"os"
+	)
+
+	fs := flag.NewFlagSet("report")
+	flag.String(fs, "out", "report.txt", "Output file")
+	flag.Bool(fs, "verbose", false, "Verbose output")
+	err := flag.Parse(fs, os.Args())
+
+	if err != nil {
+		print(err)
+	} else {
+		rest := flag.Args(fs)
+
+		print(flag.GetString(fs, "out"), flag.GetBool(fs, "verbose"), len(rest))
+
+		for i := 0; i < len(rest); i++ {
+			print(rest[i])
+		}
+	}
+`
+
+func testStdFlagDefaultsSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, flagTestSource, []string{}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "report.txt false 0", output)
+	})
+}
+
+func testStdFlagParseSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, flagTestSource, []string{"--out", "a b.txt", "-verbose", "file1", "--file2"}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "a b.txt true 2\nfile1\n--file2", output)
+	})
+}
+
+func testStdFlagParseWithEqualSignSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, flagTestSource, []string{"--out=x=y", "--verbose=false", "--", "-c"}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "x=y false 1\n-c", output)
+	})
+}
+
+func testStdFlagUndefinedFail(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, flagTestSource, []string{"--nope"}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "flag provided but not defined: -nope", output)
+	})
+}
+
+func testStdFlagMissingValueFail(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, flagTestSource, []string{"--out"}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "flag needs an argument: -out", output)
+	})
+}
+
+func testStdFlagPrintDefaultsSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, `
+		import "flag"
+
+		fs := flag.NewFlagSet("report")
+		flag.String(fs, "out", "report.txt", "Output file")
+		flag.Bool(fs, "verbose", false, "Verbose output")
+		flag.PrintDefaults(fs)
+	`, []string{}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "Usage of report:\n  --out string\n      Output file (default \"report.txt\")\n  --verbose\n      Verbose output", output)
+	})
+}
@@ -0,0 +1,27 @@
+package tests
+
+import "testing"
+
+func TestStdFlagDefaultsSuccess(t *testing.T) {
+	testStdFlagDefaultsSuccess(t, transpileBatchArgs)
+}
+
+func TestStdFlagParseSuccess(t *testing.T) {
+	testStdFlagParseSuccess(t, transpileBatchArgs)
+}
+
+func TestStdFlagParseWithEqualSignSuccess(t *testing.T) {
+	testStdFlagParseWithEqualSignSuccess(t, transpileBatchArgs)
+}
+
+func TestStdFlagUndefinedFail(t *testing.T) {
+	testStdFlagUndefinedFail(t, transpileBatchArgs)
+}
+
+func TestStdFlagMissingValueFail(t *testing.T) {
+	testStdFlagMissingValueFail(t, transpileBatchArgs)
+}
+
+func TestStdFlagPrintDefaultsSuccess(t *testing.T) {
+	testStdFlagPrintDefaultsSuccess(t, transpileBatchArgs)
+}
@@ -0,0 +1,7 @@
+package tests
+
+import "testing"
+
+func TestInterpreterStdOsSetenvChildProcessSuccess(t *testing.T) {
+	testStdOsSetenvChildProcessSuccess(t, interpret, `@printenv("TSH_ENV_TEST")`, "child 0")
+}
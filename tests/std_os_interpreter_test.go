@@ -0,0 +1,35 @@
+package tests
+
+import "testing"
+
+func TestInterpreterStdOsArgsSuccess(t *testing.T) {
+	testStdOsArgsSuccess(t, interpretArgs)
+}
+
+func TestInterpreterStdOsSetenvSuccess(t *testing.T) {
+	testStdOsSetenvSuccess(t, interpret)
+}
+
+func TestInterpreterStdOsLookupEnvMissingSuccess(t *testing.T) {
+	testStdOsLookupEnvMissingSuccess(t, interpret)
+}
+
+func TestInterpreterStdOsUnsetenvSuccess(t *testing.T) {
+	testStdOsUnsetenvSuccess(t, interpret)
+}
+
+func TestInterpreterStdOsSetenvInvalidKeySuccess(t *testing.T) {
+	testStdOsSetenvInvalidKeySuccess(t, interpret)
+}
+
+func TestInterpreterStdOsEnvironSuccess(t *testing.T) {
+	testStdOsEnvironSuccess(t, interpret)
+}
+
+func TestInterpreterStdOsSetenvScriptVariablesSuccess(t *testing.T) {
+	testStdOsSetenvScriptVariablesSuccess(t, interpret)
+}
+
+func TestInterpreterStdOsExitSuccess(t *testing.T) {
+	testStdOsExitSuccess(t, interpret)
+}
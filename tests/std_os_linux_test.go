@@ -5,3 +5,39 @@ import "testing"
 func TestStdOsShellSuccess(t *testing.T) {
 	testStdOsShellSuccess(t, transpileBashFunc, "bash")
 }
+
+func TestStdOsArgsSuccess(t *testing.T) {
+	testStdOsArgsSuccess(t, transpileBashArgs)
+}
+
+func TestStdOsSetenvSuccess(t *testing.T) {
+	testStdOsSetenvSuccess(t, transpileBash)
+}
+
+func TestStdOsLookupEnvMissingSuccess(t *testing.T) {
+	testStdOsLookupEnvMissingSuccess(t, transpileBash)
+}
+
+func TestStdOsUnsetenvSuccess(t *testing.T) {
+	testStdOsUnsetenvSuccess(t, transpileBash)
+}
+
+func TestStdOsSetenvInvalidKeySuccess(t *testing.T) {
+	testStdOsSetenvInvalidKeySuccess(t, transpileBash)
+}
+
+func TestStdOsEnvironSuccess(t *testing.T) {
+	testStdOsEnvironSuccess(t, transpileBash)
+}
+
+func TestStdOsSetenvScriptVariablesSuccess(t *testing.T) {
+	testStdOsSetenvScriptVariablesSuccess(t, transpileBash)
+}
+
+func TestStdOsSetenvChildProcessSuccess(t *testing.T) {
+	testStdOsSetenvChildProcessSuccess(t, transpileBash, `@printenv("TSH_ENV_TEST")`, "child 0")
+}
+
+func TestStdOsExitSuccess(t *testing.T) {
+	testStdOsExitSuccess(t, transpileBash)
+}
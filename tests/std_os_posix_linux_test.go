@@ -0,0 +1,43 @@
+package tests
+
+import "testing"
+
+func TestPosixStdOsShellSuccess(t *testing.T) {
+	testStdOsShellSuccess(t, transpilePosixFunc, "sh")
+}
+
+func TestPosixStdOsArgsSuccess(t *testing.T) {
+	testStdOsArgsSuccess(t, transpilePosixArgs)
+}
+
+func TestPosixStdOsSetenvSuccess(t *testing.T) {
+	testStdOsSetenvSuccess(t, transpilePosix)
+}
+
+func TestPosixStdOsLookupEnvMissingSuccess(t *testing.T) {
+	testStdOsLookupEnvMissingSuccess(t, transpilePosix)
+}
+
+func TestPosixStdOsUnsetenvSuccess(t *testing.T) {
+	testStdOsUnsetenvSuccess(t, transpilePosix)
+}
+
+func TestPosixStdOsSetenvInvalidKeySuccess(t *testing.T) {
+	testStdOsSetenvInvalidKeySuccess(t, transpilePosix)
+}
+
+func TestPosixStdOsEnvironSuccess(t *testing.T) {
+	testStdOsEnvironSuccess(t, transpilePosix)
+}
+
+func TestPosixStdOsSetenvScriptVariablesSuccess(t *testing.T) {
+	testStdOsSetenvScriptVariablesSuccess(t, transpilePosix)
+}
+
+func TestPosixStdOsSetenvChildProcessSuccess(t *testing.T) {
+	testStdOsSetenvChildProcessSuccess(t, transpilePosix, `@printenv("TSH_ENV_TEST")`, "child 0")
+}
+
+func TestPosixStdOsExitSuccess(t *testing.T) {
+	testStdOsExitSuccess(t, transpilePosix)
+}
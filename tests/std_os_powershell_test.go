@@ -0,0 +1,43 @@
+package tests
+
+import "testing"
+
+func TestPowerShellStdOsShellSuccess(t *testing.T) {
+	testStdOsShellSuccess(t, transpilePowerShellFunc, "powershell")
+}
+
+func TestPowerShellStdOsArgsSuccess(t *testing.T) {
+	testStdOsArgsSuccess(t, transpilePowerShellArgs)
+}
+
+func TestPowerShellStdOsSetenvSuccess(t *testing.T) {
+	testStdOsSetenvSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdOsLookupEnvMissingSuccess(t *testing.T) {
+	testStdOsLookupEnvMissingSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdOsUnsetenvSuccess(t *testing.T) {
+	testStdOsUnsetenvSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdOsSetenvInvalidKeySuccess(t *testing.T) {
+	testStdOsSetenvInvalidKeySuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdOsEnvironSuccess(t *testing.T) {
+	testStdOsEnvironSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdOsSetenvScriptVariablesSuccess(t *testing.T) {
+	testStdOsSetenvScriptVariablesSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdOsSetenvChildProcessSuccess(t *testing.T) {
+	testStdOsSetenvChildProcessSuccess(t, transpilePowerShell, `@pwsh("-NoProfile", "-Command", "(Get-Item env:TSH_ENV_TEST).Value")`, "child 0")
+}
+
+func TestPowerShellStdOsExitSuccess(t *testing.T) {
+	testStdOsExitSuccess(t, transpilePowerShell)
+}
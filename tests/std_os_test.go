@@ -16,3 +16,141 @@ func testStdOsShellSuccess(t *testing.T, transpilerCalloutFunc transpilerCallout
 func testOsFunc(t *testing.T, transpilerCalloutFunc transpilerCalloutFunc, f string, args []string, quoteArgs bool, compare compareCallout) {
 	testStdFunc(t, transpilerCalloutFunc, "os", f, args, quoteArgs, compare)
 }
+
+func testStdOsArgsSuccess(t *testing.T, transpilerArgsFunc transpilerArgsFunc) {
+	transpilerArgsFunc(t, `
+		import "os"
+
+		a := os.Args()
+
+		print(len(a), a[1])
+	`, []string{"a", "b"}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "2 b", output)
+	})
+}
+
+func testStdOsSetenvSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "os"
+
+		err := os.Setenv("TSH_ENV_TEST", "hello world")
+		v, ok := os.LookupEnv("TSH_ENV_TEST")
+
+		print(err == nil, os.Getenv("TSH_ENV_TEST"), v, ok)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true hello world hello world true", output)
+	})
+}
+
+func testStdOsLookupEnvMissingSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "os"
+
+		v, ok := os.LookupEnv("TSH_ENV_MISSING")
+
+		print("["+v+"]", ok)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "[] false", output)
+	})
+}
+
+func testStdOsUnsetenvSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "os"
+
+		os.Setenv("TSH_ENV_TEST", "value")
+		err := os.Unsetenv("TSH_ENV_TEST")
+		v, ok := os.LookupEnv("TSH_ENV_TEST")
+
+		print(err == nil, "["+v+"]", ok)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true [] false", output)
+	})
+}
+
+func testStdOsSetenvInvalidKeySuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "os"
+
+		print(os.Setenv("A=B", "value"))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "setenv: invalid argument", output)
+	})
+}
+
+func testStdOsEnvironSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "os"
+
+		os.Setenv("TSH_ENV_TEST", "environ")
+		env := os.Environ()
+		found := false
+
+		for i := 0; i < len(env); i++ {
+			if env[i] == "TSH_ENV_TEST=environ" {
+				found = true
+			}
+		}
+		print(found)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true", output)
+	})
+}
+
+// testStdOsSetenvScriptVariablesSuccess checks that environment variables don't interfere with script
+// variables of the same name (Bash, POSIX sh and Batch share one namespace for both).
+func testStdOsSetenvScriptVariablesSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "os"
+
+		a := "local"
+		s := []int{1}
+
+		os.Setenv("a", "env")
+		os.Setenv("_dvc", "0")
+		os.Setenv("_tsh_a", "reserved")
+		s = append(s, 2)
+
+		print(a, os.Getenv("a"), len(s), s[1])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "local env 2 2", output)
+	})
+}
+
+// testStdOsSetenvChildProcessSuccess checks if a set variable is passed to a started program. The
+// app call depends on the platform, therefore it's provided by the caller.
+func testStdOsSetenvChildProcessSuccess(t *testing.T, transpilerFunc transpilerFunc, call string, expectation string) {
+	transpilerFunc(t, `
+		import "os"
+
+		os.Setenv("TSH_ENV_TEST", "child")
+		stdout, stderr, code := `+call+`
+
+		print(stdout, code)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, expectation, output)
+	})
+}
+
+func testStdOsExitSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "os"
+
+		func main() {
+			print("main")
+			os.Exit(5)
+		}
+	`, func(output string, err error) {
+		require.Error(t, err)
+		require.Equal(t, 5, exitCode(err))
+		require.Equal(t, "main", output)
+	})
+}
@@ -5,3 +5,39 @@ import "testing"
 func TestStdOsShellSuccess(t *testing.T) {
 	testStdOsShellSuccess(t, transpileBatchFunc, "batch")
 }
+
+func TestStdOsArgsSuccess(t *testing.T) {
+	testStdOsArgsSuccess(t, transpileBatchArgs)
+}
+
+func TestStdOsSetenvSuccess(t *testing.T) {
+	testStdOsSetenvSuccess(t, transpileBatch)
+}
+
+func TestStdOsLookupEnvMissingSuccess(t *testing.T) {
+	testStdOsLookupEnvMissingSuccess(t, transpileBatch)
+}
+
+func TestStdOsUnsetenvSuccess(t *testing.T) {
+	testStdOsUnsetenvSuccess(t, transpileBatch)
+}
+
+func TestStdOsSetenvInvalidKeySuccess(t *testing.T) {
+	testStdOsSetenvInvalidKeySuccess(t, transpileBatch)
+}
+
+func TestStdOsEnvironSuccess(t *testing.T) {
+	testStdOsEnvironSuccess(t, transpileBatch)
+}
+
+func TestStdOsSetenvScriptVariablesSuccess(t *testing.T) {
+	testStdOsSetenvScriptVariablesSuccess(t, transpileBatch)
+}
+
+func TestStdOsSetenvChildProcessSuccess(t *testing.T) {
+	testStdOsSetenvChildProcessSuccess(t, transpileBatch, `@cmd("/c", "set", "TSH_ENV_TEST")`, "TSH_ENV_TEST=child 0")
+}
+
+func TestStdOsExitSuccess(t *testing.T) {
+	testStdOsExitSuccess(t, transpileBatch)
+}
@@ -0,0 +1,19 @@
+package tests
+
+import "testing"
+
+func TestInterpreterStdStrconvItoaSuccess(t *testing.T) {
+	testStdStrconvItoaSuccess(t, interpret)
+}
+
+func TestInterpreterStdStrconvAtoiSuccess(t *testing.T) {
+	testStdStrconvAtoiSuccess(t, interpret)
+}
+
+func TestInterpreterStdStrconvParseBoolSuccess(t *testing.T) {
+	testStdStrconvParseBoolSuccess(t, interpret)
+}
+
+func TestInterpreterStdStrconvFormatBoolSuccess(t *testing.T) {
+	testStdStrconvFormatBoolSuccess(t, interpret)
+}
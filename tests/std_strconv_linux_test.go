@@ -0,0 +1,19 @@
+package tests
+
+import "testing"
+
+func TestStdStrconvItoaSuccess(t *testing.T) {
+	testStdStrconvItoaSuccess(t, transpileBash)
+}
+
+func TestStdStrconvAtoiSuccess(t *testing.T) {
+	testStdStrconvAtoiSuccess(t, transpileBash)
+}
+
+func TestStdStrconvParseBoolSuccess(t *testing.T) {
+	testStdStrconvParseBoolSuccess(t, transpileBash)
+}
+
+func TestStdStrconvFormatBoolSuccess(t *testing.T) {
+	testStdStrconvFormatBoolSuccess(t, transpileBash)
+}
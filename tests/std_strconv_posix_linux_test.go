@@ -0,0 +1,19 @@
+package tests
+
+import "testing"
+
+func TestPosixStdStrconvItoaSuccess(t *testing.T) {
+	testStdStrconvItoaSuccess(t, transpilePosix)
+}
+
+func TestPosixStdStrconvAtoiSuccess(t *testing.T) {
+	testStdStrconvAtoiSuccess(t, transpilePosix)
+}
+
+func TestPosixStdStrconvParseBoolSuccess(t *testing.T) {
+	testStdStrconvParseBoolSuccess(t, transpilePosix)
+}
+
+func TestPosixStdStrconvFormatBoolSuccess(t *testing.T) {
+	testStdStrconvFormatBoolSuccess(t, transpilePosix)
+}
@@ -0,0 +1,19 @@
+package tests
+
+import "testing"
+
+func TestPowerShellStdStrconvItoaSuccess(t *testing.T) {
+	testStdStrconvItoaSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdStrconvAtoiSuccess(t *testing.T) {
+	testStdStrconvAtoiSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdStrconvParseBoolSuccess(t *testing.T) {
+	testStdStrconvParseBoolSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdStrconvFormatBoolSuccess(t *testing.T) {
+	testStdStrconvFormatBoolSuccess(t, transpilePowerShell)
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testStdStrconvItoaSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "strconv"
+
+		print(strconv.Itoa(-42) + "!")
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "-42!", output)
+	})
+}
+
+func testStdStrconvAtoiSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "strconv"
+
+		n, err := strconv.Atoi("0042")
+		print(n*2, err == nil)
+
+		n, err = strconv.Atoi("abc")
+		print(n, err)
+	`, func(output string, err error) {
+		_, atoiErr := strconv.Atoi("abc")
+
+		require.Nil(t, err)
+		require.Equal(t, fmt.Sprintf("84 true\n0 %s", atoiErr.Error()), output)
+	})
+}
+
+func testStdStrconvParseBoolSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	values := []string{"1", "t", "T", "TRUE", "true", "True", "0", "f", "F", "FALSE", "false", "False", "yes", ""}
+	literals := []string{}
+	expected := []string{}
+
+	for _, value := range values {
+		b, err := strconv.ParseBool(value)
+		errString := ""
+
+		if err != nil {
+			errString = err.Error()
+		}
+		literals = append(literals, strconv.Quote(value))
+		expected = append(expected, strings.TrimSpace(fmt.Sprintf("%t %s", b, errString)))
+	}
+
+	transpilerFunc(t, fmt.Sprintf(`
+		import "strconv"
+
+		values := []string{%s}
+
+		for i := 0; i < len(values); i++ {
+			b, err := strconv.ParseBool(values[i])
+			print(b, err)
+		}
+	`, strings.Join(literals, ", ")), func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join(expected, "\n"), strings.ReplaceAll(output, " \n", "\n"))
+	})
+}
+
+func testStdStrconvFormatBoolSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		import "strconv"
+
+		print(strconv.FormatBool(true) + strconv.FormatBool(1 == 2))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "truefalse", output)
+	})
+}
@@ -0,0 +1,19 @@
+package tests
+
+import "testing"
+
+func TestStdStrconvItoaSuccess(t *testing.T) {
+	testStdStrconvItoaSuccess(t, transpileBatch)
+}
+
+func TestStdStrconvAtoiSuccess(t *testing.T) {
+	testStdStrconvAtoiSuccess(t, transpileBatch)
+}
+
+func TestStdStrconvParseBoolSuccess(t *testing.T) {
+	testStdStrconvParseBoolSuccess(t, transpileBatch)
+}
+
+func TestStdStrconvFormatBoolSuccess(t *testing.T) {
+	testStdStrconvFormatBoolSuccess(t, transpileBatch)
+}
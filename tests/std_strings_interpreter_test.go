@@ -0,0 +1,79 @@
+package tests
+
+import "testing"
+
+func TestInterpreterStdStringsIndexSuccess(t *testing.T) {
+	testStdStringsIndexSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsContainsSuccess(t *testing.T) {
+	testStdStringsContainsSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsJoinSuccess(t *testing.T) {
+	testStdStringsJoinSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsHasPrefixSuccess(t *testing.T) {
+	testStdStringsHasPrefixSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsHasSuffixSuccess(t *testing.T) {
+	testStdStringsHasSuffixSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsCountSuccess(t *testing.T) {
+	testStdStringsCountSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsSplitSuccess(t *testing.T) {
+	testStdStringsSplitSuccess(t, interpret)
+}
+
+func TestInterpreterStdStringsRepeatSuccess(t *testing.T) {
+	testStdStringsRepeatSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsReplaceSuccess(t *testing.T) {
+	testStdStringsReplaceSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsReplaceAllSuccess(t *testing.T) {
+	testStdStringsReplaceAllSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsCutPrefixSuccess(t *testing.T) {
+	testStdStringsCutPrefixSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsCutSuffixSuccess(t *testing.T) {
+	testStdStringsCutSuffixSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsCutSuccess(t *testing.T) {
+	testStdStringsCutSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsTrimPrefixSuccess(t *testing.T) {
+	testStdStringsTrimPrefixSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsTrimSuffixSuccess(t *testing.T) {
+	testStdStringsTrimSuffixSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsTrimLeftSuccess(t *testing.T) {
+	testStdStringsTrimLeftSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsTrimRightSuccess(t *testing.T) {
+	testStdStringsTrimRightSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsTrimSuccess(t *testing.T) {
+	testStdStringsTrimSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStdStringsTrimSpaceSuccess(t *testing.T) {
+	testStdStringsTrimSpaceSuccess(t, interpretFunc)
+}
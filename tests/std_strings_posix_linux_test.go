@@ -0,0 +1,79 @@
+package tests
+
+import "testing"
+
+func TestPosixStdStringsIndexSuccess(t *testing.T) {
+	testStdStringsIndexSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsContainsSuccess(t *testing.T) {
+	testStdStringsContainsSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsJoinSuccess(t *testing.T) {
+	testStdStringsJoinSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsHasPrefixSuccess(t *testing.T) {
+	testStdStringsHasPrefixSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsHasSuffixSuccess(t *testing.T) {
+	testStdStringsHasSuffixSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsCountSuccess(t *testing.T) {
+	testStdStringsCountSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsSplitSuccess(t *testing.T) {
+	testStdStringsSplitSuccess(t, transpilePosix)
+}
+
+func TestPosixStdStringsRepeatSuccess(t *testing.T) {
+	testStdStringsRepeatSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsReplaceSuccess(t *testing.T) {
+	testStdStringsReplaceSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsReplaceAllSuccess(t *testing.T) {
+	testStdStringsReplaceAllSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsCutPrefixSuccess(t *testing.T) {
+	testStdStringsCutPrefixSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsCutSuffixSuccess(t *testing.T) {
+	testStdStringsCutSuffixSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsCutSuccess(t *testing.T) {
+	testStdStringsCutSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsTrimPrefixSuccess(t *testing.T) {
+	testStdStringsTrimPrefixSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsTrimSuffixSuccess(t *testing.T) {
+	testStdStringsTrimSuffixSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsTrimLeftSuccess(t *testing.T) {
+	testStdStringsTrimLeftSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsTrimRightSuccess(t *testing.T) {
+	testStdStringsTrimRightSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsTrimSuccess(t *testing.T) {
+	testStdStringsTrimSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStdStringsTrimSpaceSuccess(t *testing.T) {
+	testStdStringsTrimSpaceSuccess(t, transpilePosixFunc)
+}
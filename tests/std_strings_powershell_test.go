@@ -0,0 +1,79 @@
+package tests
+
+import "testing"
+
+func TestPowerShellStdStringsIndexSuccess(t *testing.T) {
+	testStdStringsIndexSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsContainsSuccess(t *testing.T) {
+	testStdStringsContainsSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsJoinSuccess(t *testing.T) {
+	testStdStringsJoinSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsHasPrefixSuccess(t *testing.T) {
+	testStdStringsHasPrefixSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsHasSuffixSuccess(t *testing.T) {
+	testStdStringsHasSuffixSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsCountSuccess(t *testing.T) {
+	testStdStringsCountSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsSplitSuccess(t *testing.T) {
+	testStdStringsSplitSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStdStringsRepeatSuccess(t *testing.T) {
+	testStdStringsRepeatSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsReplaceSuccess(t *testing.T) {
+	testStdStringsReplaceSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsReplaceAllSuccess(t *testing.T) {
+	testStdStringsReplaceAllSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsCutPrefixSuccess(t *testing.T) {
+	testStdStringsCutPrefixSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsCutSuffixSuccess(t *testing.T) {
+	testStdStringsCutSuffixSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsCutSuccess(t *testing.T) {
+	testStdStringsCutSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsTrimPrefixSuccess(t *testing.T) {
+	testStdStringsTrimPrefixSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsTrimSuffixSuccess(t *testing.T) {
+	testStdStringsTrimSuffixSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsTrimLeftSuccess(t *testing.T) {
+	testStdStringsTrimLeftSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsTrimRightSuccess(t *testing.T) {
+	testStdStringsTrimRightSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsTrimSuccess(t *testing.T) {
+	testStdStringsTrimSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStdStringsTrimSpaceSuccess(t *testing.T) {
+	testStdStringsTrimSpaceSuccess(t, transpilePowerShellFunc)
+}
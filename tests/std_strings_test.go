@@ -26,7 +26,7 @@ func testStdStringsContainsSuccess(t *testing.T, transpilerCalloutFunc transpile
 	testStringsFunc(t, transpilerCalloutFunc, "Contains", []string{s, substr}, true, func(output string, err error) {
 		require.Nil(t, err)
 		require.EqualValues(t, true, strings.Contains(s, substr))
-		require.EqualValues(t, "1", output)
+		require.EqualValues(t, "true", output)
 	})
 }
 
@@ -46,7 +46,7 @@ func testStdStringsHasPrefixSuccess(t *testing.T, transpilerCalloutFunc transpil
 	testStringsFunc(t, transpilerCalloutFunc, "HasPrefix", []string{s, prefix}, true, func(output string, err error) {
 		require.Nil(t, err)
 		require.EqualValues(t, true, strings.HasPrefix(s, prefix))
-		require.EqualValues(t, "1", output)
+		require.EqualValues(t, "true", output)
 	})
 }
 
@@ -57,7 +57,7 @@ func testStdStringsHasSuffixSuccess(t *testing.T, transpilerCalloutFunc transpil
 	testStringsFunc(t, transpilerCalloutFunc, "HasSuffix", []string{s, suffix}, true, func(output string, err error) {
 		require.Nil(t, err)
 		require.EqualValues(t, true, strings.HasSuffix(s, suffix))
-		require.EqualValues(t, "1", output)
+		require.EqualValues(t, "true", output)
 	})
 }
 
@@ -135,7 +135,7 @@ func testStdStringsCutPrefixSuccess(t *testing.T, transpilerCalloutFunc transpil
 		require.Nil(t, err)
 		after, found := strings.CutPrefix(s, prefix)
 		require.Equal(t, true, found)
-		require.EqualValues(t, fmt.Sprintf("%s 1", after), output)
+		require.EqualValues(t, fmt.Sprintf("%s true", after), output)
 	})
 }
 
@@ -147,7 +147,7 @@ func testStdStringsCutSuffixSuccess(t *testing.T, transpilerCalloutFunc transpil
 		require.Nil(t, err)
 		after, found := strings.CutSuffix(s, suffix)
 		require.Equal(t, true, found)
-		require.EqualValues(t, fmt.Sprintf("%s 1", after), output)
+		require.EqualValues(t, fmt.Sprintf("%s true", after), output)
 	})
 }
 
@@ -159,7 +159,7 @@ func testStdStringsCutSuccess(t *testing.T, transpilerCalloutFunc transpilerCall
 		require.Nil(t, err)
 		before, after, found := strings.Cut(s, sep)
 		require.Equal(t, true, found)
-		require.EqualValues(t, fmt.Sprintf("%s %s 1", before, after), output)
+		require.EqualValues(t, fmt.Sprintf("%s %s true", before, after), output)
 	})
 	sep = "Hel"
 
@@ -167,7 +167,7 @@ func testStdStringsCutSuccess(t *testing.T, transpilerCalloutFunc transpilerCall
 		require.Nil(t, err)
 		_, after, found := strings.Cut(s, sep)
 		require.Equal(t, true, found)
-		require.EqualValues(t, fmt.Sprintf("%s 1", after), output)
+		require.EqualValues(t, fmt.Sprintf("%s true", after), output)
 	})
 	sep = "rld"
 
@@ -175,7 +175,7 @@ func testStdStringsCutSuccess(t *testing.T, transpilerCalloutFunc transpilerCall
 		require.Nil(t, err)
 		before, _, found := strings.Cut(s, sep)
 		require.Equal(t, true, found)
-		require.EqualValues(t, fmt.Sprintf("%s  1", before), output)
+		require.EqualValues(t, fmt.Sprintf("%s  true", before), output)
 	})
 	sep = "not included"
 
@@ -183,7 +183,7 @@ func testStdStringsCutSuccess(t *testing.T, transpilerCalloutFunc transpilerCall
 		require.Nil(t, err)
 		before, _, found := strings.Cut(s, sep)
 		require.Equal(t, false, found)
-		require.EqualValues(t, fmt.Sprintf("%s  0", before), output)
+		require.EqualValues(t, fmt.Sprintf("%s  false", before), output)
 	})
 }
 
@@ -135,3 +135,153 @@ func testItoaSuccess(t *testing.T, transpilerFunc transpilerFunc) {
 		require.Equal(t, "Hello World 24", output)
 	})
 }
+
+func testAtoiSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		values := []string{"42", "-17", "+8", "010", "-0", "2147483647", "-2147483648"}
+
+		for i := 0; i < len(values); i++ {
+			n, err := atoi(values[i])
+			print(n, err == nil)
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "42 true\n-17 true\n8 true\n10 true\n0 true\n2147483647 true\n-2147483648 true", output)
+	})
+}
+
+func testAtoi64BitSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		values := []string{"9223372036854775807", "-9223372036854775808", "9223372036854775808", "-9223372036854775809"}
+
+		for i := 0; i < len(values); i++ {
+			n, err := atoi(values[i])
+			print(n, err == nil, err)
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join([]string{
+			`9223372036854775807 true `,
+			`-9223372036854775808 true `,
+			`0 false strconv.Atoi: parsing "9223372036854775808": value out of range`,
+			`0 false strconv.Atoi: parsing "-9223372036854775809": value out of range`,
+		}, "\n"), output)
+	})
+}
+
+func testAtoiInvalidSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		values := []string{"", "-", "1a", " 1", "1.5", "99999999999999999999"}
+
+		for i := 0; i < len(values); i++ {
+			n, err := atoi(values[i])
+			print(n, err)
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join([]string{
+			`0 strconv.Atoi: parsing "": invalid syntax`,
+			`0 strconv.Atoi: parsing "-": invalid syntax`,
+			`0 strconv.Atoi: parsing "1a": invalid syntax`,
+			`0 strconv.Atoi: parsing " 1": invalid syntax`,
+			`0 strconv.Atoi: parsing "1.5": invalid syntax`,
+			`0 strconv.Atoi: parsing "99999999999999999999": value out of range`,
+		}, "\n"), output)
+	})
+}
+
+func testAtoiNonStringFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		n, err := atoi(1)
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected string")
+	})
+}
+
+func testSprintfSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Name string
+			Port int
+		}
+		name := "ab"
+		n := -42
+		h := Host{Name: "web", Port: 80}
+
+		print(sprintf("[%s][%-5s][%5s][%d][%05d][%3d]", name, name, name, n, n, 7))
+		print(sprintf("%t %v %v %v %v %v", true, false, n, 1.5, name, h))
+		print(sprintf("100%% %s", "done"))
+		print(sprintf("no verbs"))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, strings.Join([]string{
+			"[ab][ab   ][   ab][-42][-0042][  7]",
+			"true false -42 1.5 ab {web 80}",
+			"100% done",
+			"no verbs",
+		}, "\n"), output)
+	})
+}
+
+func testSprintfQuoteSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(sprintf("%q %q", "a\\b \"c\"\nd\te", ""))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, `"a\\b \"c\"\nd\te" ""`, output)
+	})
+}
+
+func testSprintfInFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func row(name string, count int, ok bool) string {
+			return sprintf("%-6s|%4d|%v", name, count, ok)
+		}
+		print(row("apple", 3, true))
+		print(row("kiwi", 12, false))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "apple |   3|true\nkiwi  |  12|false", output)
+	})
+}
+
+func testSprintfWrongTypeFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(sprintf("%d", "1"))
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "%d doesn't support string")
+	})
+}
+
+func testSprintfArgumentCountFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(sprintf("%s %s", "a"))
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected 2 arguments for format but got 1")
+	})
+}
+
+func testSprintfNonLiteralFormatFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		format := "%s"
+		print(sprintf(format, "a"))
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected format string literal")
+	})
+}
+
+func testSprintfUnsupportedVerbFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(sprintf("%x", 1))
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "unsupported verb %x")
+	})
+}
+
+func testSprintfZeroPaddedStringFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		print(sprintf("%05s", "a"))
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "zero padding is only supported for %d")
+	})
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterStringConcatSuccess(t *testing.T) {
+	testStringConcatSuccess(t, interpret)
+}
+
+func TestInterpreterStringLengthSuccess(t *testing.T) {
+	testStringLengthSuccess(t, interpret)
+}
+
+func TestInterpreterStringSingleSubscriptSuccess(t *testing.T) {
+	testStringSingleSubscriptSuccess(t, interpret)
+}
+
+func TestInterpreterStringStartSubscriptSuccess(t *testing.T) {
+	testStringStartSubscriptSuccess(t, interpret)
+}
+
+func TestInterpreterStringEndSubscriptSuccess(t *testing.T) {
+	testStringEndSubscriptSuccess(t, interpret)
+}
+
+func TestInterpreterStringRangeSubscriptSuccess(t *testing.T) {
+	testStringRangeSubscriptSuccess(t, interpret)
+}
+
+func TestInterpreterStringRangeNoIndicesSubscriptSuccess(t *testing.T) {
+	testStringRangeNoIndicesSubscriptSuccess(t, interpret)
+}
+
+func TestInterpreterStringWithNewlineSuccess(t *testing.T) {
+	testStringWithNewlineSuccess(t, interpret)
+}
+
+func TestInterpreterStringWithoutNewlineSuccess(t *testing.T) {
+	testStringWithoutNewlineSuccess(t, interpret)
+}
+
+func TestInterpreterMultilineStringSuccess(t *testing.T) {
+	testMultilineStringSuccess(t, interpret)
+}
+
+func TestInterpreterItoaSuccess(t *testing.T) {
+	testItoaSuccess(t, interpret)
+}
+
+func TestInterpreterSprintfSuccess(t *testing.T) {
+	testSprintfSuccess(t, interpret)
+}
+
+func TestInterpreterSprintfQuoteSuccess(t *testing.T) {
+	testSprintfQuoteSuccess(t, interpret)
+}
+
+func TestInterpreterSprintfInFunctionSuccess(t *testing.T) {
+	testSprintfInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSprintfWrongTypeFail(t *testing.T) {
+	testSprintfWrongTypeFail(t, interpret)
+}
+
+func TestInterpreterSprintfArgumentCountFail(t *testing.T) {
+	testSprintfArgumentCountFail(t, interpret)
+}
+
+func TestInterpreterSprintfNonLiteralFormatFail(t *testing.T) {
+	testSprintfNonLiteralFormatFail(t, interpret)
+}
+
+func TestInterpreterSprintfUnsupportedVerbFail(t *testing.T) {
+	testSprintfUnsupportedVerbFail(t, interpret)
+}
+
+func TestInterpreterSprintfZeroPaddedStringFail(t *testing.T) {
+	testSprintfZeroPaddedStringFail(t, interpret)
+}
+
+func TestInterpreterAtoiSuccess(t *testing.T) {
+	testAtoiSuccess(t, interpret)
+}
+
+func TestInterpreterAtoi64BitSuccess(t *testing.T) {
+	testAtoi64BitSuccess(t, interpret)
+}
+
+func TestInterpreterAtoiInvalidSuccess(t *testing.T) {
+	testAtoiInvalidSuccess(t, interpret)
+}
+
+func TestInterpreterAtoiNonStringFail(t *testing.T) {
+	testAtoiNonStringFail(t, interpret)
+}
@@ -47,3 +47,51 @@ func TestMultilineStringSuccess(t *testing.T) {
 func TestItoaSuccess(t *testing.T) {
 	testItoaSuccess(t, transpileBash)
 }
+
+func TestSprintfSuccess(t *testing.T) {
+	testSprintfSuccess(t, transpileBash)
+}
+
+func TestSprintfQuoteSuccess(t *testing.T) {
+	testSprintfQuoteSuccess(t, transpileBash)
+}
+
+func TestSprintfInFunctionSuccess(t *testing.T) {
+	testSprintfInFunctionSuccess(t, transpileBash)
+}
+
+func TestSprintfWrongTypeFail(t *testing.T) {
+	testSprintfWrongTypeFail(t, transpileBash)
+}
+
+func TestSprintfArgumentCountFail(t *testing.T) {
+	testSprintfArgumentCountFail(t, transpileBash)
+}
+
+func TestSprintfNonLiteralFormatFail(t *testing.T) {
+	testSprintfNonLiteralFormatFail(t, transpileBash)
+}
+
+func TestSprintfUnsupportedVerbFail(t *testing.T) {
+	testSprintfUnsupportedVerbFail(t, transpileBash)
+}
+
+func TestSprintfZeroPaddedStringFail(t *testing.T) {
+	testSprintfZeroPaddedStringFail(t, transpileBash)
+}
+
+func TestAtoiSuccess(t *testing.T) {
+	testAtoiSuccess(t, transpileBash)
+}
+
+func TestAtoi64BitSuccess(t *testing.T) {
+	testAtoi64BitSuccess(t, transpileBash)
+}
+
+func TestAtoiInvalidSuccess(t *testing.T) {
+	testAtoiInvalidSuccess(t, transpileBash)
+}
+
+func TestAtoiNonStringFail(t *testing.T) {
+	testAtoiNonStringFail(t, transpileBash)
+}
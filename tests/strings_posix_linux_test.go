@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixStringConcatSuccess(t *testing.T) {
+	testStringConcatSuccess(t, transpilePosix)
+}
+
+func TestPosixStringLengthSuccess(t *testing.T) {
+	testStringLengthSuccess(t, transpilePosix)
+}
+
+func TestPosixStringSingleSubscriptSuccess(t *testing.T) {
+	testStringSingleSubscriptSuccess(t, transpilePosix)
+}
+
+func TestPosixStringStartSubscriptSuccess(t *testing.T) {
+	testStringStartSubscriptSuccess(t, transpilePosix)
+}
+
+func TestPosixStringEndSubscriptSuccess(t *testing.T) {
+	testStringEndSubscriptSuccess(t, transpilePosix)
+}
+
+func TestPosixStringRangeSubscriptSuccess(t *testing.T) {
+	testStringRangeSubscriptSuccess(t, transpilePosix)
+}
+
+func TestPosixStringRangeNoIndicesSubscriptSuccess(t *testing.T) {
+	testStringRangeNoIndicesSubscriptSuccess(t, transpilePosix)
+}
+
+func TestPosixStringWithNewlineSuccess(t *testing.T) {
+	testStringWithNewlineSuccess(t, transpilePosix)
+}
+
+func TestPosixStringWithoutNewlineSuccess(t *testing.T) {
+	testStringWithoutNewlineSuccess(t, transpilePosix)
+}
+
+func TestPosixMultilineStringSuccess(t *testing.T) {
+	testMultilineStringSuccess(t, transpilePosix)
+}
+
+func TestPosixItoaSuccess(t *testing.T) {
+	testItoaSuccess(t, transpilePosix)
+}
+
+func TestPosixSprintfSuccess(t *testing.T) {
+	testSprintfSuccess(t, transpilePosix)
+}
+
+func TestPosixSprintfQuoteSuccess(t *testing.T) {
+	testSprintfQuoteSuccess(t, transpilePosix)
+}
+
+func TestPosixSprintfInFunctionSuccess(t *testing.T) {
+	testSprintfInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSprintfWrongTypeFail(t *testing.T) {
+	testSprintfWrongTypeFail(t, transpilePosix)
+}
+
+func TestPosixSprintfArgumentCountFail(t *testing.T) {
+	testSprintfArgumentCountFail(t, transpilePosix)
+}
+
+func TestPosixSprintfNonLiteralFormatFail(t *testing.T) {
+	testSprintfNonLiteralFormatFail(t, transpilePosix)
+}
+
+func TestPosixSprintfUnsupportedVerbFail(t *testing.T) {
+	testSprintfUnsupportedVerbFail(t, transpilePosix)
+}
+
+func TestPosixSprintfZeroPaddedStringFail(t *testing.T) {
+	testSprintfZeroPaddedStringFail(t, transpilePosix)
+}
+
+func TestPosixAtoiSuccess(t *testing.T) {
+	testAtoiSuccess(t, transpilePosix)
+}
+
+func TestPosixAtoi64BitSuccess(t *testing.T) {
+	testAtoi64BitSuccess(t, transpilePosix)
+}
+
+func TestPosixAtoiInvalidSuccess(t *testing.T) {
+	testAtoiInvalidSuccess(t, transpilePosix)
+}
+
+func TestPosixAtoiNonStringFail(t *testing.T) {
+	testAtoiNonStringFail(t, transpilePosix)
+}
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellStringConcatSuccess(t *testing.T) {
+	testStringConcatSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringLengthSuccess(t *testing.T) {
+	testStringLengthSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringSingleSubscriptSuccess(t *testing.T) {
+	testStringSingleSubscriptSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringStartSubscriptSuccess(t *testing.T) {
+	testStringStartSubscriptSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringEndSubscriptSuccess(t *testing.T) {
+	testStringEndSubscriptSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringRangeSubscriptSuccess(t *testing.T) {
+	testStringRangeSubscriptSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringRangeNoIndicesSubscriptSuccess(t *testing.T) {
+	testStringRangeNoIndicesSubscriptSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringWithNewlineSuccess(t *testing.T) {
+	testStringWithNewlineSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStringWithoutNewlineSuccess(t *testing.T) {
+	testStringWithoutNewlineSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellMultilineStringSuccess(t *testing.T) {
+	testMultilineStringSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellItoaSuccess(t *testing.T) {
+	testItoaSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfSuccess(t *testing.T) {
+	testSprintfSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfQuoteSuccess(t *testing.T) {
+	testSprintfQuoteSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfInFunctionSuccess(t *testing.T) {
+	testSprintfInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfWrongTypeFail(t *testing.T) {
+	testSprintfWrongTypeFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfArgumentCountFail(t *testing.T) {
+	testSprintfArgumentCountFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfNonLiteralFormatFail(t *testing.T) {
+	testSprintfNonLiteralFormatFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfUnsupportedVerbFail(t *testing.T) {
+	testSprintfUnsupportedVerbFail(t, transpilePowerShell)
+}
+
+func TestPowerShellSprintfZeroPaddedStringFail(t *testing.T) {
+	testSprintfZeroPaddedStringFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAtoiSuccess(t *testing.T) {
+	testAtoiSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAtoi64BitSuccess(t *testing.T) {
+	testAtoi64BitSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAtoiInvalidSuccess(t *testing.T) {
+	testAtoiInvalidSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellAtoiNonStringFail(t *testing.T) {
+	testAtoiNonStringFail(t, transpilePowerShell)
+}
@@ -47,3 +47,47 @@ func TestMultilineStringSuccess(t *testing.T) {
 func TestItoaSuccess(t *testing.T) {
 	testItoaSuccess(t, transpileBatch)
 }
+
+func TestSprintfSuccess(t *testing.T) {
+	testSprintfSuccess(t, transpileBatch)
+}
+
+func TestSprintfQuoteSuccess(t *testing.T) {
+	testSprintfQuoteSuccess(t, transpileBatch)
+}
+
+func TestSprintfInFunctionSuccess(t *testing.T) {
+	testSprintfInFunctionSuccess(t, transpileBatch)
+}
+
+func TestSprintfWrongTypeFail(t *testing.T) {
+	testSprintfWrongTypeFail(t, transpileBatch)
+}
+
+func TestSprintfArgumentCountFail(t *testing.T) {
+	testSprintfArgumentCountFail(t, transpileBatch)
+}
+
+func TestSprintfNonLiteralFormatFail(t *testing.T) {
+	testSprintfNonLiteralFormatFail(t, transpileBatch)
+}
+
+func TestSprintfUnsupportedVerbFail(t *testing.T) {
+	testSprintfUnsupportedVerbFail(t, transpileBatch)
+}
+
+func TestSprintfZeroPaddedStringFail(t *testing.T) {
+	testSprintfZeroPaddedStringFail(t, transpileBatch)
+}
+
+func TestAtoiSuccess(t *testing.T) {
+	testAtoiSuccess(t, transpileBatch)
+}
+
+func TestAtoiInvalidSuccess(t *testing.T) {
+	testAtoiInvalidSuccess(t, transpileBatch)
+}
+
+func TestAtoiNonStringFail(t *testing.T) {
+	testAtoiNonStringFail(t, transpileBatch)
+}
@@ -0,0 +1,242 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testDefineStructSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Name string
+			Port int
+		}
+
+		a := Host{Name: "web", Port: 80}
+		b := Host{"db", 5432}
+
+		print(a.Name, a.Port, b.Name, b.Port)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "web 80 db 5432", output)
+	})
+}
+
+func testStructDefaultValuesSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Name    string
+			Port    int
+			Enabled bool
+		}
+
+		var a Host
+		b := Host{Port: 22}
+
+		print(a.Name == "", a.Port, a.Enabled, b.Name == "", b.Port)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "true 0 false true 22", output)
+	})
+}
+
+func testStructAssignFieldsSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Address struct {
+			City string
+			Zip  int
+		}
+
+		type Host struct {
+			Name    string
+			Port    int
+			Address Address
+		}
+
+		h := Host{Name: "web"}
+
+		h.Port = 80
+		h.Port += 8000
+		h.Port++
+		h.Address.City = "Vienna"
+		h.Address = Address{h.Address.City, 1010}
+
+		print(h.Name, h.Port, h.Address.City, h.Address.Zip)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "web 8081 Vienna 1010", output)
+	})
+}
+
+func testStructSliceFieldSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Group struct {
+			Tags []string
+		}
+
+		g := Group{[]string{"a", "b"}}
+		g.Tags[2] = "c"
+
+		print(len(g.Tags), g.Tags[0], g.Tags[2])
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "3 a c", output)
+	})
+}
+
+func testStructFunctionSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Name string
+			Port int
+		}
+
+		func newHost(name string, port int) (Host, error) {
+			if port < 0 {
+				return Host{}, "invalid port"
+			}
+			return Host{name, port}, nil
+		}
+
+		func describe(h Host) string {
+			return h.Name + ":" + itoa(h.Port)
+		}
+
+		h, err := newHost("web", 80)
+		print(describe(h), err == nil)
+
+		h, err = newHost("db", -1)
+		print(err)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "web:80 true\ninvalid port", output)
+	})
+}
+
+func testStructSliceSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Name string
+			Port int
+		}
+
+		hs := []Host{
+			{Name: "web", Port: 80},
+			{"db", 5432},
+		}
+
+		hs[0].Port = 8080
+		hs[2] = Host{Name: "cache", Port: 6379}
+
+		for i, h := range hs {
+			print(i, h.Name, h.Port)
+		}
+		print(len(hs), hs[1].Name)
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "0 web 8080\n1 db 5432\n2 cache 6379\n3 db", output)
+	})
+}
+
+func testPrintStructSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Address struct {
+			City string
+			Zip  int
+		}
+
+		type Host struct {
+			Name    string
+			Address Address
+		}
+
+		print(Host{"web", Address{"Vienna", 1010}})
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "{web {Vienna 1010}}", output)
+	})
+}
+
+func testPrintStructBoolSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Flags struct {
+			Name    string
+			Enabled bool
+			Debug   bool
+		}
+
+		f := Flags{"web", true, false}
+
+		print(f)
+		print(sprintf("%v", f))
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "{web true false}\n{web true false}", output)
+	})
+}
+
+func testImportStructSuccess(t *testing.T, transpilerFunc transpilerCalloutFunc) {
+	transpilerFunc(t, func(dir string) (string, error) {
+		err := os.WriteFile(filepath.Join(dir, "geometry.tsh"), []byte(`
+			type Point struct {
+				X, Y int
+			}
+
+			func Origin() Point {
+				return Point{}
+			}
+		`), 0700)
+
+		return `
+			import geometry "./geometry.tsh"
+
+			p := geometry.Point{X: 1, Y: 2}
+			var o geometry.Point = geometry.Origin()
+
+			print(p.X, p.Y, o.X, o.Y)
+		`, err
+	}, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "1 2 0 0", output)
+	})
+}
+
+func testStructUnknownFieldFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Name string
+		}
+
+		h := Host{}
+		h.Port = 80
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "Host has no field Port")
+	})
+}
+
+func testStructFieldTypeMismatchFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Host struct {
+			Port int
+		}
+
+		h := Host{Port: "80"}
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "expected int but got string for field Port")
+	})
+}
+
+func testStructSliceContainingSliceFail(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		type Group struct {
+			Tags []string
+		}
+
+		gs := []Group{}
+	`, func(output string, err error) {
+		require.EqualError(t, shortenError(err), "slices of Group are not supported because it contains slices")
+	})
+}
@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterDefineStructSuccess(t *testing.T) {
+	testDefineStructSuccess(t, interpret)
+}
+
+func TestInterpreterStructDefaultValuesSuccess(t *testing.T) {
+	testStructDefaultValuesSuccess(t, interpret)
+}
+
+func TestInterpreterStructAssignFieldsSuccess(t *testing.T) {
+	testStructAssignFieldsSuccess(t, interpret)
+}
+
+func TestInterpreterStructSliceFieldSuccess(t *testing.T) {
+	testStructSliceFieldSuccess(t, interpret)
+}
+
+func TestInterpreterStructFunctionSuccess(t *testing.T) {
+	testStructFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterStructSliceSuccess(t *testing.T) {
+	testStructSliceSuccess(t, interpret)
+}
+
+func TestInterpreterPrintStructSuccess(t *testing.T) {
+	testPrintStructSuccess(t, interpret)
+}
+
+func TestInterpreterPrintStructBoolSuccess(t *testing.T) {
+	testPrintStructBoolSuccess(t, interpret)
+}
+
+func TestInterpreterImportStructSuccess(t *testing.T) {
+	testImportStructSuccess(t, interpretFunc)
+}
+
+func TestInterpreterStructUnknownFieldFail(t *testing.T) {
+	testStructUnknownFieldFail(t, interpret)
+}
+
+func TestInterpreterStructFieldTypeMismatchFail(t *testing.T) {
+	testStructFieldTypeMismatchFail(t, interpret)
+}
+
+func TestInterpreterStructSliceContainingSliceFail(t *testing.T) {
+	testStructSliceContainingSliceFail(t, interpret)
+}
@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestDefineStructSuccess(t *testing.T) {
+	testDefineStructSuccess(t, transpileBash)
+}
+
+func TestStructDefaultValuesSuccess(t *testing.T) {
+	testStructDefaultValuesSuccess(t, transpileBash)
+}
+
+func TestStructAssignFieldsSuccess(t *testing.T) {
+	testStructAssignFieldsSuccess(t, transpileBash)
+}
+
+func TestStructSliceFieldSuccess(t *testing.T) {
+	testStructSliceFieldSuccess(t, transpileBash)
+}
+
+func TestStructFunctionSuccess(t *testing.T) {
+	testStructFunctionSuccess(t, transpileBash)
+}
+
+func TestStructSliceSuccess(t *testing.T) {
+	testStructSliceSuccess(t, transpileBash)
+}
+
+func TestPrintStructSuccess(t *testing.T) {
+	testPrintStructSuccess(t, transpileBash)
+}
+
+func TestPrintStructBoolSuccess(t *testing.T) {
+	testPrintStructBoolSuccess(t, transpileBash)
+}
+
+func TestImportStructSuccess(t *testing.T) {
+	testImportStructSuccess(t, transpileBashFunc)
+}
+
+func TestStructUnknownFieldFail(t *testing.T) {
+	testStructUnknownFieldFail(t, transpileBash)
+}
+
+func TestStructFieldTypeMismatchFail(t *testing.T) {
+	testStructFieldTypeMismatchFail(t, transpileBash)
+}
+
+func TestStructSliceContainingSliceFail(t *testing.T) {
+	testStructSliceContainingSliceFail(t, transpileBash)
+}
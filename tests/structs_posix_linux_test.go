@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixDefineStructSuccess(t *testing.T) {
+	testDefineStructSuccess(t, transpilePosix)
+}
+
+func TestPosixStructDefaultValuesSuccess(t *testing.T) {
+	testStructDefaultValuesSuccess(t, transpilePosix)
+}
+
+func TestPosixStructAssignFieldsSuccess(t *testing.T) {
+	testStructAssignFieldsSuccess(t, transpilePosix)
+}
+
+func TestPosixStructSliceFieldSuccess(t *testing.T) {
+	testStructSliceFieldSuccess(t, transpilePosix)
+}
+
+func TestPosixStructFunctionSuccess(t *testing.T) {
+	testStructFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixStructSliceSuccess(t *testing.T) {
+	testStructSliceSuccess(t, transpilePosix)
+}
+
+func TestPosixPrintStructSuccess(t *testing.T) {
+	testPrintStructSuccess(t, transpilePosix)
+}
+
+func TestPosixPrintStructBoolSuccess(t *testing.T) {
+	testPrintStructBoolSuccess(t, transpilePosix)
+}
+
+func TestPosixImportStructSuccess(t *testing.T) {
+	testImportStructSuccess(t, transpilePosixFunc)
+}
+
+func TestPosixStructUnknownFieldFail(t *testing.T) {
+	testStructUnknownFieldFail(t, transpilePosix)
+}
+
+func TestPosixStructFieldTypeMismatchFail(t *testing.T) {
+	testStructFieldTypeMismatchFail(t, transpilePosix)
+}
+
+func TestPosixStructSliceContainingSliceFail(t *testing.T) {
+	testStructSliceContainingSliceFail(t, transpilePosix)
+}
@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellDefineStructSuccess(t *testing.T) {
+	testDefineStructSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStructDefaultValuesSuccess(t *testing.T) {
+	testStructDefaultValuesSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStructAssignFieldsSuccess(t *testing.T) {
+	testStructAssignFieldsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStructSliceFieldSuccess(t *testing.T) {
+	testStructSliceFieldSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStructFunctionSuccess(t *testing.T) {
+	testStructFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellStructSliceSuccess(t *testing.T) {
+	testStructSliceSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellPrintStructSuccess(t *testing.T) {
+	testPrintStructSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellPrintStructBoolSuccess(t *testing.T) {
+	testPrintStructBoolSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellImportStructSuccess(t *testing.T) {
+	testImportStructSuccess(t, transpilePowerShellFunc)
+}
+
+func TestPowerShellStructUnknownFieldFail(t *testing.T) {
+	testStructUnknownFieldFail(t, transpilePowerShell)
+}
+
+func TestPowerShellStructFieldTypeMismatchFail(t *testing.T) {
+	testStructFieldTypeMismatchFail(t, transpilePowerShell)
+}
+
+func TestPowerShellStructSliceContainingSliceFail(t *testing.T) {
+	testStructSliceContainingSliceFail(t, transpilePowerShell)
+}
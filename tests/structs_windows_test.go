@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestDefineStructSuccess(t *testing.T) {
+	testDefineStructSuccess(t, transpileBatch)
+}
+
+func TestStructDefaultValuesSuccess(t *testing.T) {
+	testStructDefaultValuesSuccess(t, transpileBatch)
+}
+
+func TestStructAssignFieldsSuccess(t *testing.T) {
+	testStructAssignFieldsSuccess(t, transpileBatch)
+}
+
+func TestStructSliceFieldSuccess(t *testing.T) {
+	testStructSliceFieldSuccess(t, transpileBatch)
+}
+
+func TestStructFunctionSuccess(t *testing.T) {
+	testStructFunctionSuccess(t, transpileBatch)
+}
+
+func TestStructSliceSuccess(t *testing.T) {
+	testStructSliceSuccess(t, transpileBatch)
+}
+
+func TestPrintStructSuccess(t *testing.T) {
+	testPrintStructSuccess(t, transpileBatch)
+}
+
+func TestPrintStructBoolSuccess(t *testing.T) {
+	testPrintStructBoolSuccess(t, transpileBatch)
+}
+
+func TestImportStructSuccess(t *testing.T) {
+	testImportStructSuccess(t, transpileBatchFunc)
+}
+
+func TestStructUnknownFieldFail(t *testing.T) {
+	testStructUnknownFieldFail(t, transpileBatch)
+}
+
+func TestStructFieldTypeMismatchFail(t *testing.T) {
+	testStructFieldTypeMismatchFail(t, transpileBatch)
+}
+
+func TestStructSliceContainingSliceFail(t *testing.T) {
+	testStructSliceContainingSliceFail(t, transpileBatch)
+}
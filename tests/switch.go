@@ -221,3 +221,24 @@ func testSwitchStringsInFunctionSuccess(t *testing.T, transpilerFunc transpilerF
 		require.Equal(t, "ok", output)
 	})
 }
+
+func testSwitchEvaluationOrderSuccess(t *testing.T, transpilerFunc transpilerFunc) {
+	transpilerFunc(t, `
+		func value(name string, v int) int {
+			print(name)
+			return v
+		}
+
+		switch value("switch", 2) {
+		case value("a", 1):
+			print("nok")
+		case value("b", 2):
+			print("ok")
+		case value("c", 2):
+			print("nok")
+		}
+	`, func(output string, err error) {
+		require.Nil(t, err)
+		require.Equal(t, "switch\na\nb\nok", output)
+	})
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterSwitchWithBoolSuccess(t *testing.T) {
+	testSwitchWithBoolSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchWithBoolDefaultSuccess(t *testing.T) {
+	testSwitchWithBoolDefaultSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchWithImplicitBoolSuccess(t *testing.T) {
+	testSwitchWithImplicitBoolSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchWithComparisonsSuccess(t *testing.T) {
+	testSwitchWithComparisonsSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchOnlyDefaultSuccess(t *testing.T) {
+	testSwitchOnlyDefaultSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchStringsSuccess(t *testing.T) {
+	testSwitchStringsSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchWithBoolInFunctionSuccess(t *testing.T) {
+	testSwitchWithBoolInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchWithBoolDefaultInFunctionSuccess(t *testing.T) {
+	testSwitchWithBoolDefaultInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchWithImplicitBoolInFunctionSuccess(t *testing.T) {
+	testSwitchWithImplicitBoolInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchWithComparisonsInFunctionSuccess(t *testing.T) {
+	testSwitchWithComparisonsInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchOnlyDefaultInFunctionSuccess(t *testing.T) {
+	testSwitchOnlyDefaultInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchStringsInFunctionSuccess(t *testing.T) {
+	testSwitchStringsInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterSwitchEvaluationOrderSuccess(t *testing.T) {
+	testSwitchEvaluationOrderSuccess(t, interpret)
+}
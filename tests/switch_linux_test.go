@@ -51,3 +51,7 @@ func TestSwitchOnlyDefaultInFunctionSuccess(t *testing.T) {
 func TestSwitchStringsInFunctionSuccess(t *testing.T) {
 	testSwitchStringsInFunctionSuccess(t, transpileBash)
 }
+
+func TestSwitchEvaluationOrderSuccess(t *testing.T) {
+	testSwitchEvaluationOrderSuccess(t, transpileBash)
+}
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixSwitchWithBoolSuccess(t *testing.T) {
+	testSwitchWithBoolSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchWithBoolDefaultSuccess(t *testing.T) {
+	testSwitchWithBoolDefaultSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchWithImplicitBoolSuccess(t *testing.T) {
+	testSwitchWithImplicitBoolSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchWithComparisonsSuccess(t *testing.T) {
+	testSwitchWithComparisonsSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchOnlyDefaultSuccess(t *testing.T) {
+	testSwitchOnlyDefaultSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchStringsSuccess(t *testing.T) {
+	testSwitchStringsSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchWithBoolInFunctionSuccess(t *testing.T) {
+	testSwitchWithBoolInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchWithBoolDefaultInFunctionSuccess(t *testing.T) {
+	testSwitchWithBoolDefaultInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchWithImplicitBoolInFunctionSuccess(t *testing.T) {
+	testSwitchWithImplicitBoolInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchWithComparisonsInFunctionSuccess(t *testing.T) {
+	testSwitchWithComparisonsInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchOnlyDefaultInFunctionSuccess(t *testing.T) {
+	testSwitchOnlyDefaultInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchStringsInFunctionSuccess(t *testing.T) {
+	testSwitchStringsInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixSwitchEvaluationOrderSuccess(t *testing.T) {
+	testSwitchEvaluationOrderSuccess(t, transpilePosix)
+}
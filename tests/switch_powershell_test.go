@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellSwitchWithBoolSuccess(t *testing.T) {
+	testSwitchWithBoolSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchWithBoolDefaultSuccess(t *testing.T) {
+	testSwitchWithBoolDefaultSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchWithImplicitBoolSuccess(t *testing.T) {
+	testSwitchWithImplicitBoolSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchWithComparisonsSuccess(t *testing.T) {
+	testSwitchWithComparisonsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchOnlyDefaultSuccess(t *testing.T) {
+	testSwitchOnlyDefaultSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchStringsSuccess(t *testing.T) {
+	testSwitchStringsSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchWithBoolInFunctionSuccess(t *testing.T) {
+	testSwitchWithBoolInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchWithBoolDefaultInFunctionSuccess(t *testing.T) {
+	testSwitchWithBoolDefaultInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchWithImplicitBoolInFunctionSuccess(t *testing.T) {
+	testSwitchWithImplicitBoolInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchWithComparisonsInFunctionSuccess(t *testing.T) {
+	testSwitchWithComparisonsInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchOnlyDefaultInFunctionSuccess(t *testing.T) {
+	testSwitchOnlyDefaultInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchStringsInFunctionSuccess(t *testing.T) {
+	testSwitchStringsInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellSwitchEvaluationOrderSuccess(t *testing.T) {
+	testSwitchEvaluationOrderSuccess(t, transpilePowerShell)
+}
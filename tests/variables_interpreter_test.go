@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestInterpreterDefineVariableSuccess(t *testing.T) {
+	testDefineVariablesSuccess(t, interpret)
+}
+
+func TestInterpreterDefineSameVariableFail(t *testing.T) {
+	testDefineSameVariableFail(t, interpret)
+}
+
+func TestInterpreterNoNewVariableFail(t *testing.T) {
+	testNoNewVariableFail(t, interpret)
+}
+
+func TestInterpreterAssignSuccessful(t *testing.T) {
+	testAssignSuccessful(t, interpret)
+}
+
+func TestInterpreterAssignToUndefinedFail(t *testing.T) {
+	testAssignToUndefinedFail(t, interpret)
+}
+
+func TestInterpreterAssignFromFunctionSuccessful(t *testing.T) {
+	testAssignFromFunctionSuccessful(t, interpret)
+}
+
+func TestInterpreterDefineVariableInFunctionSuccess(t *testing.T) {
+	testDefineVariablesInFunctionSuccess(t, interpret)
+}
+
+func TestInterpreterDefineSameVariableInFunctionFail(t *testing.T) {
+	testDefineSameVariableInFunctionFail(t, interpret)
+}
+
+func TestInterpreterNoNewVariableInFunctionFail(t *testing.T) {
+	testNoNewVariableInFunctionFail(t, interpret)
+}
+
+func TestInterpreterAssignInFunctionSuccessful(t *testing.T) {
+	testAssignInFunctionSuccessful(t, interpret)
+}
+
+func TestInterpreterAssignToUndefinedInFunctionFail(t *testing.T) {
+	testAssignToUndefinedInFunctionFail(t, interpret)
+}
+
+func TestInterpreterAssignFromFunctionInFunctionSuccessful(t *testing.T) {
+	testAssignFromFunctionInFunctionSuccessful(t, interpret)
+}
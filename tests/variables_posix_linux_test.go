@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPosixDefineVariableSuccess(t *testing.T) {
+	testDefineVariablesSuccess(t, transpilePosix)
+}
+
+func TestPosixDefineSameVariableFail(t *testing.T) {
+	testDefineSameVariableFail(t, transpilePosix)
+}
+
+func TestPosixNoNewVariableFail(t *testing.T) {
+	testNoNewVariableFail(t, transpilePosix)
+}
+
+func TestPosixAssignSuccessful(t *testing.T) {
+	testAssignSuccessful(t, transpilePosix)
+}
+
+func TestPosixAssignToUndefinedFail(t *testing.T) {
+	testAssignToUndefinedFail(t, transpilePosix)
+}
+
+func TestPosixAssignFromFunctionSuccessful(t *testing.T) {
+	testAssignFromFunctionSuccessful(t, transpilePosix)
+}
+
+func TestPosixDefineVariableInFunctionSuccess(t *testing.T) {
+	testDefineVariablesInFunctionSuccess(t, transpilePosix)
+}
+
+func TestPosixDefineSameVariableInFunctionFail(t *testing.T) {
+	testDefineSameVariableInFunctionFail(t, transpilePosix)
+}
+
+func TestPosixNoNewVariableInFunctionFail(t *testing.T) {
+	testNoNewVariableInFunctionFail(t, transpilePosix)
+}
+
+func TestPosixAssignInFunctionSuccessful(t *testing.T) {
+	testAssignInFunctionSuccessful(t, transpilePosix)
+}
+
+func TestPosixAssignToUndefinedInFunctionFail(t *testing.T) {
+	testAssignToUndefinedInFunctionFail(t, transpilePosix)
+}
+
+func TestPosixAssignFromFunctionInFunctionSuccessful(t *testing.T) {
+	testAssignFromFunctionInFunctionSuccessful(t, transpilePosix)
+}
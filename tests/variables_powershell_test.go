@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"testing"
+)
+
+func TestPowerShellDefineVariableSuccess(t *testing.T) {
+	testDefineVariablesSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellDefineSameVariableFail(t *testing.T) {
+	testDefineSameVariableFail(t, transpilePowerShell)
+}
+
+func TestPowerShellNoNewVariableFail(t *testing.T) {
+	testNoNewVariableFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAssignSuccessful(t *testing.T) {
+	testAssignSuccessful(t, transpilePowerShell)
+}
+
+func TestPowerShellAssignToUndefinedFail(t *testing.T) {
+	testAssignToUndefinedFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAssignFromFunctionSuccessful(t *testing.T) {
+	testAssignFromFunctionSuccessful(t, transpilePowerShell)
+}
+
+func TestPowerShellDefineVariableInFunctionSuccess(t *testing.T) {
+	testDefineVariablesInFunctionSuccess(t, transpilePowerShell)
+}
+
+func TestPowerShellDefineSameVariableInFunctionFail(t *testing.T) {
+	testDefineSameVariableInFunctionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellNoNewVariableInFunctionFail(t *testing.T) {
+	testNoNewVariableInFunctionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAssignInFunctionSuccessful(t *testing.T) {
+	testAssignInFunctionSuccessful(t, transpilePowerShell)
+}
+
+func TestPowerShellAssignToUndefinedInFunctionFail(t *testing.T) {
+	testAssignToUndefinedInFunctionFail(t, transpilePowerShell)
+}
+
+func TestPowerShellAssignFromFunctionInFunctionSuccessful(t *testing.T) {
+	testAssignFromFunctionInFunctionSuccessful(t, transpilePowerShell)
+}
@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/monstermichl/typeshell/parser"
+	"github.com/stretchr/testify/require"
+)
+
+// vetFinding is a compact representation of a finding to keep the expectations readable.
+type vetFinding struct {
+	row     int
+	column  int
+	rule    parser.VetRule
+	message string
+}
+
+func vetSource(t *testing.T, source string, rules ...parser.VetRule) []vetFinding {
+	p := parser.New()
+	_, err := p.ParseSource("test.tsh", source)
+
+	require.Nil(t, err)
+
+	if len(rules) == 0 {
+		rules = parser.VetRules()
+	}
+	findings := []vetFinding{}
+
+	for _, finding := range p.Vet(rules) {
+		require.Equal(t, parser.SEVERITY_WARNING, finding.Severity())
+		findings = append(findings, vetFinding{finding.Row(), finding.Column(), parser.VetRule(finding.Code()), finding.Message()})
+	}
+	return findings
+}
+
+// vetLib writes a file which can be imported by the vetted source and returns its path.
+func vetLib(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "lib.tsh")
+	err := os.WriteFile(path, []byte("func Foo() int {\n\treturn 1\n}\n"), 0700)
+
+	require.Nil(t, err)
+	return filepath.ToSlash(path)
+}
+
+func TestVetCleanSuccess(t *testing.T) {
+	require.Empty(t, vetSource(t, `func add(a int, b int) int {
+	c := a + b
+	return c
+}
+v, err := atoi("1")
+
+if err != nil {
+	panic(err)
+}
+print(add(v, 2))
+`))
+}
+
+func TestVetUnusedVariableSuccess(t *testing.T) {
+	require.Equal(t, []vetFinding{
+		{1, 8, parser.VET_RULE_UNUSED_VARIABLE, "parameter a is not used"},
+		{2, 2, parser.VET_RULE_UNUSED_VARIABLE, "variable b is not used"},
+		{6, 1, parser.VET_RULE_UNUSED_VARIABLE, "variable global is not used"},
+		{8, 4, parser.VET_RULE_UNUSED_VARIABLE, "variable _ is not used"},
+		{9, 7, parser.VET_RULE_UNUSED_VARIABLE, "variable c is not used"},
+	}, vetSource(t, `func f(a int) {
+	b := 1
+	c := 2
+	c = 3
+}
+global := 1
+Public := 1
+n, _ := atoi("1")
+o, e, c := @echo("a")
+f(n)
+print(o, e)
+`, parser.VET_RULE_UNUSED_VARIABLE))
+}
+
+func TestVetUnusedImportSuccess(t *testing.T) {
+	require.Equal(t, []vetFinding{
+		{3, 2, parser.VET_RULE_UNUSED_IMPORT, `import "unused" is not used`},
+	}, vetSource(t, fmt.Sprintf(`import (
+	used "%[1]s"
+	unused "%[1]s"
+)
+print(used.Foo())
+`, vetLib(t)), parser.VET_RULE_UNUSED_IMPORT))
+}
+
+func TestVetUnreachableCodeSuccess(t *testing.T) {
+	require.Equal(t, []vetFinding{
+		{4, 3, parser.VET_RULE_UNREACHABLE_CODE, "unreachable code"},
+		{9, 3, parser.VET_RULE_UNREACHABLE_CODE, "unreachable code"},
+		{14, 1, parser.VET_RULE_UNREACHABLE_CODE, "unreachable code"},
+	}, vetSource(t, `func f(a int) int {
+	if a > 1 {
+		return 1
+		print("a")
+		print("b")
+	}
+	for {
+		break
+		print("c")
+	}
+	return 0
+}
+panic("d")
+print(f(2))
+`, parser.VET_RULE_UNREACHABLE_CODE))
+}
+
+func TestVetShadowingSuccess(t *testing.T) {
+	require.Equal(t, []vetFinding{
+		{4, 8, parser.VET_RULE_SHADOWING, "parameter lib shadows the import defined at row 2"},
+		{5, 2, parser.VET_RULE_SHADOWING, "variable a shadows the variable defined at row 8"},
+	}, vetSource(t, fmt.Sprintf(`import (
+	lib "%s"
+)
+func f(lib string) {
+	a := lib
+	print(a)
+}
+a := 1
+f("x")
+print(a)
+`, vetLib(t)), parser.VET_RULE_SHADOWING))
+}
+
+func TestVetIgnoredErrorSuccess(t *testing.T) {
+	require.Equal(t, []vetFinding{
+		{5, 1, parser.VET_RULE_IGNORED_ERROR, "error result of f is not checked"},
+		{6, 1, parser.VET_RULE_IGNORED_ERROR, "error result of atoi is not checked"},
+	}, vetSource(t, `func f() (int, error) {
+	return 1, nil
+}
+v, err := f()
+f()
+atoi("1")
+w, _ := f()
+write("test.txt", "data")
+print(v, err, w)
+`, parser.VET_RULE_IGNORED_ERROR))
+}
+
+func TestVetIgnoredExitCodeSuccess(t *testing.T) {
+	require.Equal(t, []vetFinding{
+		{1, 1, parser.VET_RULE_IGNORED_EXIT_CODE, "exit code of @echo is not checked"},
+	}, vetSource(t, `@echo("a")
+out, _, _ := @echo("b") | @sort()
+out, _, code := @echo("c")
+print(out, code)
+`, parser.VET_RULE_IGNORED_EXIT_CODE))
+}
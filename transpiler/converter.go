@@ -3,8 +3,9 @@ package transpiler
 import "github.com/monstermichl/typeshell/parser"
 
 type AppCall struct {
-	name string
-	args []string
+	name   string
+	args   []string
+	spread bool
 }
 
 func (c AppCall) Name() string {
@@ -15,6 +16,11 @@ func (c AppCall) Args() []string {
 	return c.args
 }
 
+// Spread returns true if the last argument is a slice whose values must be passed as separate arguments.
+func (c AppCall) Spread() bool {
+	return c.spread
+}
+
 type Condition struct {
 	condition string
 	operator  parser.LogicalOperator
@@ -46,6 +52,21 @@ func (rv ReturnValue) ValueType() parser.ValueType {
 	return rv.valueType
 }
 
+// PrintValue is a value which is printed by Print. The value type is provided to allow converters
+// to print values according to their type (e.g. bools as true/false).
+type PrintValue struct {
+	value     string
+	valueType parser.ValueType
+}
+
+func (pv PrintValue) Value() string {
+	return pv.value
+}
+
+func (pv PrintValue) ValueType() parser.ValueType {
+	return pv.valueType
+}
+
 type Converter interface {
 	// Common methods
 	StringToString(value string) string
@@ -58,13 +79,13 @@ type Converter interface {
 	VarDefinition(name string, value string, global bool) error
 	VarAssignment(name string, value string, global bool) error
 	SliceAssignment(name string, index string, value string, defaultValue string, global bool) error
+	MapAssignment(name string, key string, value string, global bool) error
+	MapDelete(name string, key string) error
 	FuncStart(name string, params []string, returnTypes []parser.ValueType) error
 	FuncEnd() error
 	Return(values []ReturnValue) error
 	IfStart(condition string) error
 	IfEnd() error
-	ElseIfStart(condition string) error
-	ElseIfEnd() error
 	ElseStart() error
 	ElseEnd() error
 	ForStart() error
@@ -74,26 +95,45 @@ type Converter interface {
 	ForEnd() error
 	Break() error
 	Continue() error
-	Print(value []string) error
+	Print(values []PrintValue, stderr bool, newline bool) error
 	Panic(value string) error
+	Exit(code string) error
 	WriteFile(path string, content string, append string) error
+	EnvSet(name string, value string) error
+	EnvUnset(name string) error
 	Nop() error
+	Marker(marker string) error // Adds the marker as a separate line. Markers are removed by the transpiler after conversion.
 
 	// Expression methods
 	UnaryOperation(expr string, operator parser.UnaryOperator, valueType parser.ValueType, valueUsed bool) (string, error)
 	BinaryOperation(left string, operator parser.BinaryOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error)
 	Comparison(left string, operator parser.CompareOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error)
-	LogicalOperation(left string, operator parser.LogicalOperator, right string, valueType parser.ValueType, valueUsed bool) (string, error)
+	LogicalOperationStart(left string, operator parser.LogicalOperator) error // Starts the block which is only entered if the right side must be evaluated.
+	LogicalOperationEnd(right string, valueUsed bool) (string, error)
 	VarEvaluation(name string, valueUsed bool, global bool) (string, error)
 	SliceInstantiation(values []string, valueUsed bool) (string, error)
-	SliceEvaluation(name string, index string, valueUsed bool) (string, error)
+	SliceEvaluation(name string, index string, defaultValue string, valueUsed bool) (string, error) // Returns the default value if the index doesn't exist.
 	SliceLen(name string, valueUsed bool) (string, error)
+	SliceRange(name string, startIndex string, endIndex string, valueUsed bool) (string, error) // Returns a new slice which holds the values from start- to (excluding) end-index.
+	Append(name string, values []string, inPlace bool, valueUsed bool) (string, error)          // Returns a new slice which holds the slice values followed by the provided values. If inPlace is true, the values are appended to the passed slice instead.
+	AppendSlice(name string, source string, inPlace bool, valueUsed bool) (string, error)       // Same as Append but appends the values of the source slice.
+	MapInstantiation(keys []string, values []string, valueUsed bool) (string, error)
+	MapEvaluation(name string, key string, defaultValue string, valueUsed bool) (string, string, error) // Returns the value and if the key exists.
+	MapLen(name string, valueUsed bool) (string, error)
+	MapKeys(name string, valueUsed bool) (string, error)
 	StringSubscript(value string, startIndex string, endIndex string, valueUsed bool) (string, error)
 	StringLen(value string, valueUsed bool) (string, error)
+	FloatToInt(value string, valueUsed bool) (string, error)
+	Atoi(value string, valueUsed bool) (string, string, error)                                               // Returns the integer and the error.
+	Format(value string, valueType parser.ValueType, verb parser.FormatVerb, valueUsed bool) (string, error) // Formats a single sprintf value (bool conversion, quoting and padding).
 	Group(value string, valueUsed bool) (string, error)
 	FuncCall(name string, args []string, returnTypes []parser.ValueType, valueUsed bool) ([]string, error)
 	AppCall(calls []AppCall, valueUsed bool) ([]string, error)
 	Input(prompt string, valueUsed bool) (string, error)
+	Args(valueUsed bool) (string, error) // Returns the slice which holds the script arguments.
+	EnvGet(name string, valueUsed bool) (string, error)
+	EnvExists(name string, valueUsed bool) (string, error)
+	Environ(valueUsed bool) (string, error) // Returns a slice which holds all environment variables (name=value).
 	Copy(destination string, source string, valueUsed bool, global bool) (string, error)
 	Exists(path string, valueUsed bool) (string, error)
 	ReadFile(path string, valueUsed bool) (string, error)
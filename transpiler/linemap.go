@@ -0,0 +1,94 @@
+package transpiler
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/monstermichl/typeshell/parser"
+)
+
+// markerPrefix starts the lines which mark the beginning of a statement in the converted code. Markers
+// are removed after conversion. They can't collide with generated code as the lexer doesn't accept NUL.
+const markerPrefix = "\x00tsh-position:"
+
+// LineMapping maps a line of the converted script to the source position of the statement it was generated from.
+type LineMapping struct {
+	Line   int    `json:"line"` // 1-based line of the converted script.
+	Path   string `json:"path"`
+	Row    int    `json:"row"`
+	Column int    `json:"column"`
+}
+
+// LineMap maps the lines of a converted script back to the TypeShell sources. Lines which don't belong
+// to a statement (e.g. helper functions) are not mapped.
+type LineMap struct {
+	Mappings []LineMapping `json:"mappings"`
+}
+
+// Find returns the mapping of the 1-based line of the converted script.
+func (m LineMap) Find(line int) (LineMapping, bool) {
+	for _, mapping := range m.Mappings {
+		if mapping.Line == line {
+			return mapping, true
+		}
+	}
+	return LineMapping{}, false
+}
+
+// PositionToString returns the position as <file>:<row>:<column>. Only the file name is used to
+// keep messages short and independent of the build directory.
+func PositionToString(position parser.Position) string {
+	return fmt.Sprintf("%s:%d:%d", filepath.Base(position.Path()), position.Row(), position.Column())
+}
+
+// setPosition marks that the following converted code belongs to the statement at the provided position.
+func (t *transpiler) setPosition(position parser.Position) error {
+	t.position = position
+	t.positions = append(t.positions, position)
+
+	return t.converter.Marker(fmt.Sprintf("%s%d", markerPrefix, len(t.positions)-1))
+}
+
+// resetPosition marks that the following converted code doesn't belong to a statement.
+func (t *transpiler) resetPosition() error {
+	return t.converter.Marker(markerPrefix)
+}
+
+// resolveMarkers removes the markers from the converted code and maps the remaining lines to the
+// position of the marker which precedes them.
+func (t *transpiler) resolveMarkers(code string) (string, LineMap) {
+	lines := []string{}
+	lineMap := LineMap{
+		Mappings: []LineMapping{},
+	}
+	index := -1
+
+	for _, line := range strings.Split(code, "\n") {
+		// Markers are added as separate lines, therefore a trailing carriage return (Batch) belongs to the marker line.
+		marker, isMarker := strings.CutPrefix(strings.TrimSuffix(line, "\r"), markerPrefix)
+
+		if isMarker {
+			index = -1
+
+			if len(marker) > 0 {
+				index, _ = strconv.Atoi(marker)
+			}
+			continue
+		}
+		lines = append(lines, line)
+
+		if index >= 0 && index < len(t.positions) {
+			position := t.positions[index]
+
+			lineMap.Mappings = append(lineMap.Mappings, LineMapping{
+				Line:   len(lines),
+				Path:   position.Path(),
+				Row:    position.Row(),
+				Column: position.Column(),
+			})
+		}
+	}
+	return strings.Join(lines, "\n"), lineMap
+}
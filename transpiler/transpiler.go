@@ -3,6 +3,7 @@ package transpiler
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/monstermichl/typeshell/parser"
 )
@@ -32,6 +33,106 @@ func (r expressionResult) firstValue() string {
 	return r.values[0]
 }
 
+// leaf describes a single variable which is required to store a (struct) value.
+type leaf struct {
+	suffix    string
+	valueType parser.ValueType
+}
+
+// valueTypeLeaves flattens a value type into the variables required to store it. Non-struct
+// types only require one variable, struct types require one variable per (nested) field.
+// Slices and maps of structs are stored as one slice or map per field.
+func valueTypeLeaves(valueType parser.ValueType) []leaf {
+	if valueType.IsMap() {
+		keyValueType := valueType.MapKeyType()
+		leaves := []leaf{}
+
+		for _, valueLeaf := range valueTypeLeaves(valueType.MapValueType()) {
+			leafValueType := parser.NewMapValueType(keyValueType, valueLeaf.valueType)
+
+			if valueType.IsSlice() {
+				leafValueType = leafValueType.SliceType()
+			}
+			leaves = append(leaves, leaf{
+				suffix:    valueLeaf.suffix,
+				valueType: leafValueType,
+			})
+		}
+		return leaves
+	}
+	fields := valueType.Fields()
+
+	if len(fields) == 0 {
+		return []leaf{{valueType: valueType}}
+	}
+	leaves := []leaf{}
+
+	for _, field := range fields {
+		fieldValueType := field.ValueType()
+
+		if valueType.IsSlice() {
+			fieldValueType = fieldValueType.SliceType()
+		}
+
+		for _, fieldLeaf := range valueTypeLeaves(fieldValueType) {
+			leaves = append(leaves, leaf{
+				suffix:    fmt.Sprintf("_%s%s", field.Name(), fieldLeaf.suffix),
+				valueType: fieldLeaf.valueType,
+			})
+		}
+	}
+	return leaves
+}
+
+func valueTypesLeaves(valueTypes []parser.ValueType) []parser.ValueType {
+	leafTypes := []parser.ValueType{}
+
+	for _, valueType := range valueTypes {
+		for _, leaf := range valueTypeLeaves(valueType) {
+			leafTypes = append(leafTypes, leaf.valueType)
+		}
+	}
+	return leafTypes
+}
+
+func leafNames(name string, valueType parser.ValueType) []string {
+	names := []string{}
+
+	for _, leaf := range valueTypeLeaves(valueType) {
+		names = append(names, fmt.Sprintf("%s%s", name, leaf.suffix))
+	}
+	return names
+}
+
+// fieldsLeafRange returns the position and the amount of the leaves of a field path within
+// the leaves of the provided type. It also returns the type of the last field.
+func fieldsLeafRange(valueType parser.ValueType, fields []string) (int, int, parser.ValueType, error) {
+	start := 0
+	count := len(valueTypeLeaves(valueType))
+
+	for _, fieldName := range fields {
+		found := false
+
+		for _, field := range valueType.Fields() {
+			fieldValueType := field.ValueType()
+			fieldCount := len(valueTypeLeaves(fieldValueType))
+
+			if field.Name() == fieldName {
+				valueType = fieldValueType
+				count = fieldCount
+				found = true
+				break
+			}
+			start += fieldCount
+		}
+
+		if !found {
+			return 0, 0, valueType, fmt.Errorf("%s has no field %s", valueType.String(), fieldName)
+		}
+	}
+	return start, count, valueType, nil
+}
+
 func BoolToString(b bool) string {
 	if b {
 		return "1"
@@ -43,8 +144,49 @@ func IntToString(i int) string {
 	return strconv.Itoa(i)
 }
 
+const FloatPrecision = 6 // Number of decimal places floats are rounded to after each operation.
+
+// VarPrefix is the prefix of all variables of shells which don't separate script variables from environment
+// variables (Bash, POSIX sh and Batch). Environment variables with this prefix are ignored by these shells.
+const VarPrefix = "_tsh_"
+
+// FloatToString formats a float with a maximum of six decimal places (e.g. 1.5 or 2). All
+// converters work with this representation, therefore an integer is a valid float as well.
+func FloatToString(f float64) string {
+	s := strconv.FormatFloat(f, 'f', FloatPrecision, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+
+	// Make sure small negative numbers are not represented as "-0".
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+// PrintfFormat returns the printf conversion (e.g. %-5s or %05d) which pads a value like the passed
+// sprintf verb. Values are already converted to strings, therefore all verbs except %d become %s.
+func PrintfFormat(verb parser.FormatVerb) string {
+	format := "%"
+
+	if verb.LeftAlign() {
+		format += "-"
+	} else if verb.ZeroPad() {
+		format += "0"
+	}
+	if verb.Width() > 0 {
+		format += strconv.Itoa(verb.Width())
+	}
+	if verb.Verb() == 'd' {
+		return format + "d"
+	}
+	return format + "s"
+}
+
 type transpiler struct {
 	converter Converter
+	position  parser.Position   // Position of the statement which is currently being transpiled.
+	positions []parser.Position // Positions which have been marked in the converted code.
+	lineMap   LineMap
 }
 
 func New() transpiler {
@@ -58,24 +200,62 @@ func (t *transpiler) Transpile(path string, converter Converter) (string, error)
 	if err != nil {
 		return "", err
 	}
+	return t.transpileProgram(ast, converter)
+}
+
+// TranspileSource transpiles the provided source. The path is used to resolve relative imports and to report errors.
+func (t *transpiler) TranspileSource(path string, source string, converter Converter) (string, error) {
+	p := parser.New()
+	ast, err := p.ParseSource(path, source)
+
+	if err != nil {
+		return "", err
+	}
+	return t.transpileProgram(ast, converter)
+}
+
+// LineMap returns the line map of the last transpilation.
+func (t transpiler) LineMap() LineMap {
+	return t.lineMap
+}
+
+func (t *transpiler) transpileProgram(ast parser.Program, converter Converter) (string, error) {
 	t.converter = converter
-	err = t.evaluate(ast)
+	t.position = parser.Position{}
+	t.positions = []parser.Position{}
+	t.lineMap = LineMap{}
+	err := t.evaluate(ast)
+
+	if err != nil {
+		return "", err
+	}
+	code, err := t.converter.Dump()
 
 	if err != nil {
 		return "", err
 	}
-	return t.converter.Dump()
+	code, t.lineMap = t.resolveMarkers(code)
+	return code, nil
 }
 
 func (t *transpiler) evaluateValueTypeDefaultValue(valueType parser.ValueType) (string, error) {
 	var defaultValue string
 	conv := t.converter
 
+	// Slices and maps are references, therefore a new one is created.
+	if valueType.IsSlice() {
+		return conv.SliceInstantiation([]string{}, true)
+	} else if valueType.IsMap() {
+		return conv.MapInstantiation([]string{}, []string{}, true)
+	}
+
 	switch valueType.DataType() {
 	case parser.DATA_TYPE_BOOLEAN:
 		defaultValue = BoolToString(false)
 	case parser.DATA_TYPE_INTEGER:
 		defaultValue = IntToString(0)
+	case parser.DATA_TYPE_FLOAT:
+		defaultValue = FloatToString(0)
 	case parser.DATA_TYPE_STRING:
 		defaultValue = conv.StringToString("")
 	default:
@@ -88,22 +268,137 @@ func (t *transpiler) evaluateIndex(index parser.Expression, valueUsed bool) (exp
 	return t.evaluateExpression(index, true)
 }
 
-func (t *transpiler) evaluateProgram(program parser.Program) error {
-	var err error
-	t.converter.ProgramStart()
+// evaluateLeafValues evaluates an expression and makes sure it returns a value for each leaf of its type.
+func (t *transpiler) evaluateLeafValues(expr parser.Expression) ([]string, error) {
+	result, err := t.evaluateExpression(expr, true)
+
+	if err != nil {
+		return nil, err
+	}
+	count := len(valueTypeLeaves(expr.ValueType()))
+	valuesLen := len(result.values)
+
+	if valuesLen < count {
+		return nil, fmt.Errorf("expected %d values but got %d", count, valuesLen)
+	}
+	return result.values[:count], nil
+}
+
+func (t *transpiler) assignVariable(variable parser.Variable, values []string) error {
+	names := leafNames(variable.Name(), variable.ValueType())
+	namesLen := len(names)
+	valuesLen := len(values)
+
+	if valuesLen != namesLen {
+		return fmt.Errorf("require %d values but got %d", namesLen, valuesLen)
+	}
+
+	for i, name := range names {
+		err := t.converter.VarDefinition(name, values[i], variable.Global())
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *transpiler) assignVariables(variables []parser.Variable, values []string) error {
+	valuesLen := len(values)
+	offset := 0
 
-	for _, statementTemp := range program.Body() {
-		err = t.evaluate(statementTemp)
+	for _, variable := range variables {
+		count := len(valueTypeLeaves(variable.ValueType()))
+
+		if offset+count > valuesLen {
+			return fmt.Errorf("require at least %d values but got %d", offset+count, valuesLen)
+		}
+		err := t.assignVariable(variable, values[offset:offset+count])
 
 		if err != nil {
 			return err
 		}
+		offset += count
+	}
+
+	if offset != valuesLen {
+		return fmt.Errorf("require %d values but got %d", offset, valuesLen)
+	}
+	return nil
+}
+
+func (t *transpiler) structToString(values []string, valueType parser.ValueType) (string, error) {
+	conv := t.converter
+	parts := []string{conv.StringToString("{")}
+	offset := 0
+
+	for i, field := range valueType.Fields() {
+		fieldValueType := field.ValueType()
+		count := len(valueTypeLeaves(fieldValueType))
+		fieldValues := values[offset : offset+count]
+		fieldString := fieldValues[0]
+		offset += count
+
+		if i > 0 {
+			parts = append(parts, conv.StringToString(" "))
+		}
+
+		if fieldValueType.IsStruct() {
+			s, err := t.structToString(fieldValues, fieldValueType)
+
+			if err != nil {
+				return "", err
+			}
+			fieldString = s
+		} else if fieldValueType.IsBool() {
+			// Bools are stored as 1 and 0 in some shells, therefore they are converted to true and false.
+			s, err := conv.Format(fieldString, fieldValueType, parser.FormatVerb{}, true)
+
+			if err != nil {
+				return "", err
+			}
+			fieldString = s
+		}
+		parts = append(parts, fieldString)
+	}
+	parts = append(parts, conv.StringToString("}"))
+	return t.concatStrings(parts)
+}
+
+// concatStrings concatenates the passed string values.
+func (t *transpiler) concatStrings(values []string) (string, error) {
+	conv := t.converter
+	stringValueType := parser.NewValueType(parser.DATA_TYPE_STRING, false)
+
+	if len(values) == 0 {
+		return conv.StringToString(""), nil
+	}
+	s := values[0]
+
+	for _, value := range values[1:] {
+		var err error
+		s, err = conv.BinaryOperation(s, parser.BINARY_OPERATOR_ADDITION, value, stringValueType, true)
+
+		if err != nil {
+			return "", err
+		}
+	}
+	return s, nil
+}
+
+func (t *transpiler) evaluateProgram(program parser.Program) error {
+	t.converter.ProgramStart()
+	err := t.evaluateStatements(program)
+
+	if err != nil {
+		return err
 	}
+	err = t.resetPosition()
 
-	if err == nil {
-		t.converter.ProgramEnd()
+	if err != nil {
+		return err
 	}
-	return err
+	return t.converter.ProgramEnd()
 }
 
 func (t *transpiler) evaluateBooleanLiteral(literal parser.BooleanLiteral, valueUsed bool) (expressionResult, error) {
@@ -114,6 +409,10 @@ func (t *transpiler) evaluateIntegerLiteral(literal parser.IntegerLiteral, value
 	return newExpressionResult(IntToString(literal.Value())), nil
 }
 
+func (t *transpiler) evaluateFloatLiteral(literal parser.FloatLiteral, valueUsed bool) (expressionResult, error) {
+	return newExpressionResult(FloatToString(literal.Value())), nil
+}
+
 func (t *transpiler) evaluateStringLiteral(literal parser.StringLiteral, valueUsed bool) (expressionResult, error) {
 	return newExpressionResult(t.converter.StringToString(literal.Value())), nil
 }
@@ -157,15 +456,89 @@ func (t *transpiler) evaluateUnaryOperation(operation parser.UnaryOperation, val
 }
 
 func (t *transpiler) evaluateBinaryOperation(operation parser.BinaryOperation, valueUsed bool) (expressionResult, error) {
-	return t.evaluateOperation(operation, t.converter.BinaryOperation, valueUsed)
+	conv := t.converter
+	operator := operation.Operator()
+
+	if operator != parser.BINARY_OPERATOR_DIVISION && operator != parser.BINARY_OPERATOR_MODULO {
+		return t.evaluateOperation(operation, conv.BinaryOperation, valueUsed)
+	}
+	return t.evaluateOperation(operation, func(left string, operator string, right string, valueType parser.ValueType, valueUsed bool) (string, error) {
+		if !isNonZeroLiteral(operation.Right()) {
+			err := t.evaluateDivisorCheck(right, valueType)
+
+			if err != nil {
+				return "", err
+			}
+		}
+		return conv.BinaryOperation(left, operator, right, valueType, valueUsed)
+	}, valueUsed)
+}
+
+// evaluateDivisorCheck panics if the divisor is zero. The shells handle divisions by zero differently (e.g.
+// Bash prints an error and continues, awk returns inf), therefore it's checked before the operation.
+func (t *transpiler) evaluateDivisorCheck(divisor string, valueType parser.ValueType) error {
+	conv := t.converter
+	zero := IntToString(0)
+
+	if valueType.IsFloat() {
+		zero = FloatToString(0)
+	}
+	condition, err := conv.Comparison(divisor, parser.COMPARE_OPERATOR_EQUAL, zero, valueType, true)
+
+	if err != nil {
+		return err
+	}
+	err = conv.IfStart(condition)
+
+	if err != nil {
+		return err
+	}
+	err = conv.Panic(fmt.Sprintf("panic: %s at %s", conv.StringToString("division by zero"), conv.StringToString(PositionToString(t.position))))
+
+	if err != nil {
+		return err
+	}
+	return conv.IfEnd()
+}
+
+func isNonZeroLiteral(expression parser.Expression) bool {
+	switch literal := expression.(type) {
+	case parser.IntegerLiteral:
+		return literal.Value() != 0
+	case parser.FloatLiteral:
+		return literal.Value() != 0
+	}
+	return false
 }
 
 func (t *transpiler) evaluateCompareOperation(operation parser.Comparison, valueUsed bool) (expressionResult, error) {
 	return t.evaluateOperation(operation, t.converter.Comparison, valueUsed)
 }
 
+// evaluateLogicalOperation evaluates the right side only if it's required to get the result (short-circuit evaluation).
 func (t *transpiler) evaluateLogicalOperation(operation parser.LogicalOperation, valueUsed bool) (expressionResult, error) {
-	return t.evaluateOperation(operation, t.converter.LogicalOperation, valueUsed)
+	conv := t.converter
+	left, err := t.evaluateExpression(operation.Left(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	err = conv.LogicalOperationStart(left.firstValue(), operation.Operator())
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	right, err := t.evaluateExpression(operation.Right(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	s, err := conv.LogicalOperationEnd(right.firstValue(), valueUsed)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(s), nil
 }
 
 func (t *transpiler) evaluateBreak() error {
@@ -177,17 +550,44 @@ func (t *transpiler) evaluateContinue() error {
 }
 
 func (t *transpiler) evaluatePrint(print parser.Print) error {
-	values := []string{}
+	values := []PrintValue{}
+	stringValueType := parser.NewValueType(parser.DATA_TYPE_STRING, false)
 
 	for _, expr := range print.Expressions() {
+		valueType := expr.ValueType()
+
+		// Structs are printed like in Go (e.g. {a 1}).
+		if valueType.IsStruct() {
+			leafValues, err := t.evaluateLeafValues(expr)
+
+			if err != nil {
+				return err
+			}
+			s, err := t.structToString(leafValues, valueType)
+
+			if err != nil {
+				return err
+			}
+			values = append(values, PrintValue{value: s, valueType: stringValueType})
+			continue
+		}
 		result, err := t.evaluateExpression(expr, true)
 
 		if err != nil {
 			return err
 		}
-		values = append(values, result.values...)
+		valueTypes := []parser.ValueType{valueType}
+
+		// Calls print all of their return values.
+		if call, ok := expr.(parser.Call); ok {
+			valueTypes = call.ReturnTypes()
+		}
+
+		for i, value := range result.values {
+			values = append(values, PrintValue{value: value, valueType: valueTypes[min(i, len(valueTypes)-1)]})
+		}
 	}
-	return t.converter.Print(values)
+	return t.converter.Print(values, print.Stderr(), print.Newline())
 }
 
 func (t *transpiler) evaluatePanic(panic parser.Panic) error {
@@ -196,7 +596,40 @@ func (t *transpiler) evaluatePanic(panic parser.Panic) error {
 	if err != nil {
 		return err
 	}
-	return t.converter.Panic(fmt.Sprintf("panic: %s", result.firstValue()))
+	conv := t.converter
+	return conv.Panic(fmt.Sprintf("panic: %s at %s", result.firstValue(), conv.StringToString(PositionToString(t.position))))
+}
+
+func (t *transpiler) evaluateExit(exit parser.Exit) error {
+	result, err := t.evaluateExpression(exit.Code(), true)
+
+	if err != nil {
+		return err
+	}
+	return t.converter.Exit(result.firstValue())
+}
+
+func (t *transpiler) evaluateSetEnv(setEnv parser.SetEnv) error {
+	key, err := t.evaluateExpression(setEnv.Key(), true)
+
+	if err != nil {
+		return err
+	}
+	value, err := t.evaluateExpression(setEnv.Value(), true)
+
+	if err != nil {
+		return err
+	}
+	return t.converter.EnvSet(key.firstValue(), value.firstValue())
+}
+
+func (t *transpiler) evaluateUnsetEnv(unsetEnv parser.UnsetEnv) error {
+	key, err := t.evaluateExpression(unsetEnv.Key(), true)
+
+	if err != nil {
+		return err
+	}
+	return t.converter.EnvUnset(key.firstValue())
 }
 
 func (t *transpiler) evaluateWrite(write parser.Write) error {
@@ -245,60 +678,53 @@ func (t *transpiler) evaluateWrite(write parser.Write) error {
 }
 
 func (t *transpiler) evaluateIf(ifStatement parser.If) error {
-	conv := t.converter
-	ifBranch := ifStatement.IfBranch()
-	result, err := t.evaluateExpression(ifBranch.Condition(), true)
-
-	if err != nil {
-		return err
-	}
-	elifConditions := []string{}
+	init := ifStatement.Init()
 
-	// Evaluate all conditions first to make sure they are added in front of the first if.
-	for _, branch := range ifStatement.ElseIfBranches() {
-		result, err := t.evaluateExpression(branch.Condition(), true)
+	if init != nil {
+		err := t.evaluate(init)
 
 		if err != nil {
 			return err
 		}
-		elifConditions = append(elifConditions, result.firstValue())
 	}
-	err = conv.IfStart(result.firstValue())
+	branches := append([]parser.IfBranch{ifStatement.IfBranch()}, ifStatement.ElseIfBranches()...)
+	return t.evaluateIfBranches(branches, ifStatement)
+}
+
+// evaluateIfBranches evaluates else-if branches as nested ifs in the else-branch of the previous one to
+// make sure a condition is only evaluated if all previous conditions were false.
+func (t *transpiler) evaluateIfBranches(branches []parser.IfBranch, ifStatement parser.If) error {
+	conv := t.converter
+	branch := branches[0]
+	result, err := t.evaluateExpression(branch.Condition(), true)
 
 	if err != nil {
 		return err
 	}
-	err = t.evaluateBlock(ifBranch)
+	err = conv.IfStart(result.firstValue())
 
 	if err != nil {
 		return err
 	}
+	err = t.evaluateBlock(branch)
 
-	for i, branch := range ifStatement.ElseIfBranches() {
-		err = conv.ElseIfStart(elifConditions[i])
-
-		if err != nil {
-			return err
-		}
-		err = t.evaluateBlock(branch)
-
-		if err != nil {
-			return err
-		}
-		err = conv.ElseIfEnd()
-
-		if err != nil {
-			return err
-		}
+	if err != nil {
+		return err
 	}
+	remainingBranches := branches[1:]
 
-	if ifStatement.HasElse() {
+	if len(remainingBranches) > 0 || ifStatement.HasElse() {
 		err = conv.ElseStart()
 
 		if err != nil {
 			return err
 		}
-		err = t.evaluateBlock(ifStatement.Else())
+
+		if len(remainingBranches) > 0 {
+			err = t.evaluateIfBranches(remainingBranches, ifStatement)
+		} else {
+			err = t.evaluateBlock(ifStatement.Else())
+		}
 
 		if err != nil {
 			return err
@@ -367,12 +793,12 @@ func (t *transpiler) evaluateFor(forStatement parser.For) error {
 
 func (t *transpiler) evaluateVarDefinition(definition parser.VariableDefinition) error {
 	for i, variable := range definition.Variables() {
-		result, err := t.evaluateExpression(definition.Values()[i], true)
+		values, err := t.evaluateLeafValues(definition.Values()[i])
 
 		if err != nil {
 			return err
 		}
-		err = t.converter.VarDefinition(variable.Name(), result.firstValue(), variable.Global())
+		err = t.assignVariable(variable, values)
 
 		if err != nil {
 			return err
@@ -387,33 +813,17 @@ func (t *transpiler) evaluateVarDefinitionCallAssignment(definition parser.Varia
 	if err != nil {
 		return err
 	}
-	variables := definition.Variables()
-	variablesLen := len(variables)
-	values := result.values
-	valuesLen := len(values)
-
-	if valuesLen != variablesLen {
-		return fmt.Errorf("require %d values but got %d", variablesLen, valuesLen)
-	}
-
-	for i, variable := range variables {
-		err = t.converter.VarDefinition(variable.Name(), values[i], variable.Global())
-
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return t.assignVariables(definition.Variables(), result.values)
 }
 
 func (t *transpiler) evaluateVarAssignment(assignment parser.VariableAssignment) error {
 	for i, variable := range assignment.Variables() {
-		result, err := t.evaluateExpression(assignment.Values()[i], true)
+		values, err := t.evaluateLeafValues(assignment.Values()[i])
 
 		if err != nil {
 			return err
 		}
-		err = t.converter.VarDefinition(variable.Name(), result.firstValue(), variable.Global())
+		err = t.assignVariable(variable, values)
 
 		if err != nil {
 			return err
@@ -428,74 +838,380 @@ func (t *transpiler) evaluateVarAssignmentCallAssignment(assignment parser.Varia
 	if err != nil {
 		return err
 	}
-	variables := assignment.Variables()
-	variablesLen := len(variables)
-	values := result.values
-	valuesLen := len(values)
-
-	if valuesLen != variablesLen {
-		return fmt.Errorf("require %d values but got %d", variablesLen, valuesLen)
-	}
+	return t.assignVariables(assignment.Variables(), result.values)
+}
 
-	for i, variable := range variables {
-		err = t.converter.VarDefinition(variable.Name(), values[i], variable.Global())
+func (t *transpiler) evaluateSliceAssignment(assignment parser.SliceAssignment) error {
+	// If it's a nested slice, store the evaluated slice in the assignment variable first.
+	if slice := assignment.Slice(); slice != nil {
+		values, err := t.evaluateLeafValues(slice)
 
 		if err != nil {
 			return err
 		}
-	}
+		err = t.assignVariable(assignment.Variable, values)
+
+		if err != nil {
+			return err
+		}
+	}
+	return t.evaluateSlicesAssignment(leafNames(assignment.Name(), assignment.ValueType()), assignment.Index(), assignment.Value(), assignment.Global())
+}
+
+// evaluateSlicesAssignment assigns the leaf values of the value to the provided slices at the given index.
+func (t *transpiler) evaluateSlicesAssignment(names []string, index parser.Expression, value parser.Expression, global bool) error {
+	indexResult, err := t.evaluateIndex(index, true)
+
+	if err != nil {
+		return err
+	}
+	values, err := t.evaluateLeafValues(value)
+
+	if err != nil {
+		return err
+	}
+	leaves := valueTypeLeaves(value.ValueType())
+	namesLen := len(names)
+
+	if len(leaves) != namesLen {
+		return fmt.Errorf("require %d values but got %d", namesLen, len(leaves))
+	}
+
+	for i, name := range names {
+		defaultValue, err := t.evaluateValueTypeDefaultValue(leaves[i].valueType)
+
+		if err != nil {
+			return err
+		}
+		err = t.converter.SliceAssignment(name, indexResult.firstValue(), values[i], defaultValue, global)
+
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (t *transpiler) evaluateSliceAssignment(assignment parser.SliceAssignment) error {
-	indexResult, err := t.evaluateIndex(assignment.Index(), true)
+func (t *transpiler) evaluateMapAssignment(assignment parser.MapAssignment) error {
+	return t.evaluateMapsAssignment(leafNames(assignment.Name(), assignment.ValueType()), assignment.Key(), assignment.Value(), assignment.Global())
+}
+
+// evaluateMapsAssignment assigns the leaf values of the value to the provided maps at the given key.
+func (t *transpiler) evaluateMapsAssignment(names []string, key parser.Expression, value parser.Expression, global bool) error {
+	keyResult, err := t.evaluateExpression(key, true)
 
 	if err != nil {
 		return err
 	}
-	value := assignment.Value()
-	valueResult, err := t.evaluateExpression(value, true)
+	values, err := t.evaluateLeafValues(value)
+
+	if err != nil {
+		return err
+	}
+	valuesLen := len(values)
+	namesLen := len(names)
+
+	if valuesLen != namesLen {
+		return fmt.Errorf("require %d values but got %d", namesLen, valuesLen)
+	}
+
+	for i, name := range names {
+		err = t.converter.MapAssignment(name, keyResult.firstValue(), values[i], global)
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *transpiler) evaluateDelete(delete parser.Delete) error {
+	maps, err := t.evaluateLeafValues(delete.Value())
 
 	if err != nil {
 		return err
 	}
-	valueType := value.ValueType()
-	defaultValue, err := t.evaluateValueTypeDefaultValue(valueType)
+	keyResult, err := t.evaluateExpression(delete.Key(), true)
 
 	if err != nil {
 		return err
 	}
-	return t.converter.SliceAssignment(assignment.Name(), indexResult.firstValue(), valueResult.firstValue(), defaultValue, assignment.Global())
+
+	// Maps of structs are stored as one map per field, therefore delete the key from each one.
+	for _, m := range maps {
+		err = t.converter.MapDelete(m, keyResult.firstValue())
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *transpiler) evaluateStructAssignment(assignment parser.StructAssignment) error {
+	valueType := assignment.ValueType()
+	names := leafNames(assignment.Name(), valueType)
+	start, count, fieldValueType, err := fieldsLeafRange(valueType, assignment.Fields())
+
+	if err != nil {
+		return err
+	}
+	names = names[start : start+count]
+	index := assignment.Index()
+	value := assignment.Value()
+
+	// If no index is provided, the field variables can be assigned directly.
+	if index == nil {
+		values, err := t.evaluateLeafValues(value)
+
+		if err != nil {
+			return err
+		}
+		valuesLen := len(values)
+
+		if valuesLen != count {
+			return fmt.Errorf("require %d values but got %d", count, valuesLen)
+		}
+
+		for i, name := range names {
+			err = t.converter.VarDefinition(name, values[i], assignment.Global())
+
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// If the field is a map, the index is the key (e.g. h.Ports["http"] = 80).
+	if fieldValueType.IsMap() {
+		return t.evaluateMapsAssignment(names, index, value, assignment.Global())
+	}
+	start, count, _, err = fieldsLeafRange(fieldValueType.ElementType(), assignment.ElementFields())
+
+	if err != nil {
+		return err
+	}
+	return t.evaluateSlicesAssignment(names[start:start+count], index, value, assignment.Global())
 }
 
 func (t *transpiler) evaluateVarEvaluation(evaluation parser.VariableEvaluation, valueUsed bool) (expressionResult, error) {
-	s, err := t.converter.VarEvaluation(evaluation.Name(), valueUsed, evaluation.Global())
+	values := []string{}
+
+	for _, name := range leafNames(evaluation.Name(), evaluation.ValueType()) {
+		s, err := t.converter.VarEvaluation(name, valueUsed, evaluation.Global())
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		values = append(values, s)
+	}
+	return newExpressionResult(values...), nil
+}
+
+func (t *transpiler) evaluateSliceEvaluation(evaluation parser.SliceEvaluation, valueUsed bool) (expressionResult, error) {
+	value := evaluation.Value()
+	sliceValues, err := t.evaluateLeafValues(value)
 
 	if err != nil {
 		return expressionResult{}, err
 	}
-	return newExpressionResult(s), nil
+	result, err := t.evaluateIndex(evaluation.Index(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	values := []string{}
+	leaves := valueTypeLeaves(evaluation.ValueType())
+
+	if len(leaves) != len(sliceValues) {
+		return expressionResult{}, fmt.Errorf("require %d values but got %d", len(sliceValues), len(leaves))
+	}
+
+	// Slices of structs are stored as one slice per field, therefore evaluate each one.
+	for i, sliceValue := range sliceValues {
+		defaultValue, err := t.evaluateValueTypeDefaultValue(leaves[i].valueType)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		s, err := t.converter.SliceEvaluation(sliceValue, result.firstValue(), defaultValue, valueUsed)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		values = append(values, s)
+	}
+	return newExpressionResult(values...), nil
 }
 
-func (t *transpiler) evaluateSliceEvaluation(evaluation parser.SliceEvaluation, valueUsed bool) (expressionResult, error) {
+func (t *transpiler) evaluateSliceRange(sliceRange parser.SliceRange, valueUsed bool) (expressionResult, error) {
+	sliceValues, err := t.evaluateLeafValues(sliceRange.Value())
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	startIndexResult, err := t.evaluateIndex(sliceRange.StartIndex(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	endIndexResult, err := t.evaluateIndex(sliceRange.EndIndex(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	slices := []string{}
+
+	// Slices of structs are stored as one slice per field, therefore create a range of each one.
+	for _, sliceValue := range sliceValues {
+		s, err := t.converter.SliceRange(sliceValue, startIndexResult.firstValue(), endIndexResult.firstValue(), valueUsed)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		slices = append(slices, s)
+	}
+	return newExpressionResult(slices...), nil
+}
+
+func (t *transpiler) evaluateMapInstantiation(instantiation parser.MapInstantiation, valueUsed bool) (expressionResult, error) {
+	leaves := valueTypeLeaves(instantiation.ValueType())
+	keys := []string{}
+	columns := make([][]string, len(leaves))
+
+	for _, expr := range instantiation.Keys() {
+		result, err := t.evaluateExpression(expr, true)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		keys = append(keys, result.firstValue())
+	}
+
+	// Maps of structs are stored as one map per field, therefore collect the values per field.
+	for _, expr := range instantiation.Values() {
+		values, err := t.evaluateLeafValues(expr)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+
+		for i, value := range values {
+			columns[i] = append(columns[i], value)
+		}
+	}
+	maps := []string{}
+
+	for _, column := range columns {
+		m, err := t.converter.MapInstantiation(keys, column, valueUsed)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		maps = append(maps, m)
+	}
+	return newExpressionResult(maps...), nil
+}
+
+// evaluateMapValues evaluates a map access and returns the value of each leaf and if the key exists.
+func (t *transpiler) evaluateMapValues(evaluation parser.MapEvaluation, valueUsed bool) ([]string, string, error) {
 	value := evaluation.Value()
-	result, err := t.evaluateExpression(value, true)
+	maps, err := t.evaluateLeafValues(value)
+
+	if err != nil {
+		return nil, "", err
+	}
+	keyResult, err := t.evaluateExpression(evaluation.Key(), true)
+
+	if err != nil {
+		return nil, "", err
+	}
+	leaves := valueTypeLeaves(value.ValueType())
+	values := []string{}
+	ok := ""
+
+	for i, m := range maps {
+		defaultValue, err := t.evaluateValueTypeDefaultValue(leaves[i].valueType.MapValueType())
+
+		if err != nil {
+			return nil, "", err
+		}
+		s, okTemp, err := t.converter.MapEvaluation(m, keyResult.firstValue(), defaultValue, valueUsed)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if i == 0 {
+			ok = okTemp
+		}
+		values = append(values, s)
+	}
+	return values, ok, nil
+}
+
+func (t *transpiler) evaluateMapEvaluation(evaluation parser.MapEvaluation, valueUsed bool) (expressionResult, error) {
+	values, _, err := t.evaluateMapValues(evaluation, valueUsed)
 
 	if err != nil {
 		return expressionResult{}, err
 	}
-	valueString := result.firstValue()
-	result, err = t.evaluateIndex(evaluation.Index(), true)
+	return newExpressionResult(values...), nil
+}
+
+func (t *transpiler) evaluateMapLookup(lookup parser.MapLookup, valueUsed bool) (expressionResult, error) {
+	values, ok, err := t.evaluateMapValues(lookup.MapEvaluation, valueUsed)
 
 	if err != nil {
 		return expressionResult{}, err
 	}
-	s, err := t.converter.SliceEvaluation(valueString, result.firstValue(), valueUsed)
+	return newExpressionResult(append(values, ok)...), nil
+}
+
+func (t *transpiler) evaluateMapKeys(keys parser.MapKeys, valueUsed bool) (expressionResult, error) {
+	maps, err := t.evaluateLeafValues(keys.Value())
 
 	if err != nil {
 		return expressionResult{}, err
 	}
-	return newExpressionResult(s), err
+
+	// All leaf maps hold the same keys, therefore the first one is sufficient.
+	s, err := t.converter.MapKeys(maps[0], valueUsed)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(s), nil
+}
+
+func (t *transpiler) evaluateStructInstantiation(instantiation parser.StructInstantiation, valueUsed bool) (expressionResult, error) {
+	values := []string{}
+
+	for _, expr := range instantiation.Values() {
+		leafValues, err := t.evaluateLeafValues(expr)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		values = append(values, leafValues...)
+	}
+	return newExpressionResult(values...), nil
+}
+
+func (t *transpiler) evaluateStructEvaluation(evaluation parser.StructEvaluation, valueUsed bool) (expressionResult, error) {
+	value := evaluation.Value()
+	values, err := t.evaluateLeafValues(value)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	start, count, _, err := fieldsLeafRange(value.ValueType(), []string{evaluation.Field()})
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(values[start : start+count]...), nil
 }
 
 func (t *transpiler) evaluateStringSubscript(subscript parser.StringSubscript, valueUsed bool) (expressionResult, error) {
@@ -528,20 +1244,33 @@ func (t *transpiler) evaluateGroup(group parser.Group, valueUsed bool) (expressi
 }
 
 func (t *transpiler) evaluateBlock(block parser.Block) error {
-	body := block.Body()
-	length := len(body)
-
-	if length == 0 {
+	if len(block.Body()) == 0 {
 		return t.converter.Nop()
 	}
-	for index, statement := range body {
-		err := t.evaluate(statement)
+	position := t.position
+	err := t.evaluateStatements(block)
+
+	if err != nil {
+		return err
+	}
+	// Code which follows the block (e.g. the end of an if) belongs to the enclosing statement.
+	return t.setPosition(position)
+}
+
+// evaluateStatements evaluates the block's statements and marks the position of each statement in the converted code.
+func (t *transpiler) evaluateStatements(block parser.Block) error {
+	positions := block.Positions()
+
+	for i, statement := range block.Body() {
+		err := t.setPosition(positions[i])
 
 		if err != nil {
 			return err
 		}
-		if index == length-1 {
-			return nil
+		err = t.evaluate(statement)
+
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -551,15 +1280,18 @@ func (t *transpiler) evaluateReturn(returnStatement parser.Return) error {
 	returnValues := []ReturnValue{}
 
 	for _, expr := range returnStatement.Values() {
-		result, err := t.evaluateExpression(expr, true)
+		values, err := t.evaluateLeafValues(expr)
 
 		if err != nil {
 			return err
 		}
-		returnValues = append(returnValues, ReturnValue{
-			value:     result.firstValue(),
-			valueType: expr.ValueType(),
-		})
+
+		for i, leaf := range valueTypeLeaves(expr.ValueType()) {
+			returnValues = append(returnValues, ReturnValue{
+				value:     values[i],
+				valueType: leaf.valueType,
+			})
+		}
 	}
 	return t.converter.Return(returnValues)
 }
@@ -569,16 +1301,27 @@ func (t *transpiler) evaluateFunctionDefinition(functionDefinition parser.Functi
 	params := []string{}
 
 	for _, param := range functionDefinition.Params() {
-		params = append(params, param.Name())
+		params = append(params, leafNames(param.Name(), param.ValueType())...)
 	}
 	conv := t.converter
-	err := conv.FuncStart(name, params, functionDefinition.ReturnTypes())
+	err := conv.FuncStart(name, params, valueTypesLeaves(functionDefinition.ReturnTypes()))
+
+	if err != nil {
+		return err
+	}
+	// Some converters write functions to a separate section, therefore the position is marked there as well.
+	err = t.setPosition(t.position)
 
 	if err != nil {
 		return err
 	}
 	err = t.evaluateBlock(functionDefinition)
 
+	if err != nil {
+		return err
+	}
+	err = t.resetPosition()
+
 	if err != nil {
 		return err
 	}
@@ -590,14 +1333,14 @@ func (t *transpiler) evaluateFunctionCall(functionCall parser.FunctionCall, valu
 	args := []string{}
 
 	for _, arg := range functionCall.Args() {
-		result, err := t.evaluateExpression(arg, true)
+		values, err := t.evaluateLeafValues(arg)
 
 		if err != nil {
 			return expressionResult{}, err
 		}
-		args = append(args, result.firstValue())
+		args = append(args, values...)
 	}
-	returnTypes := functionCall.ReturnTypes()
+	returnTypes := valueTypesLeaves(functionCall.ReturnTypes())
 	values, err := t.converter.FuncCall(name, args, returnTypes, valueUsed)
 
 	if err != nil {
@@ -629,8 +1372,9 @@ func (t *transpiler) evaluateAppCall(call parser.AppCall, valueUsed bool) (expre
 			args = append(args, result.firstValue())
 		}
 		convertedCalls = append(convertedCalls, AppCall{
-			name: name,
-			args: args,
+			name:   name,
+			args:   args,
+			spread: nextCall.Spread(),
 		})
 		nextCall = nextCall.Next()
 	}
@@ -643,22 +1387,32 @@ func (t *transpiler) evaluateAppCall(call parser.AppCall, valueUsed bool) (expre
 }
 
 func (t *transpiler) evaluateSliceInstantiation(instantiation parser.SliceInstantiation, valueUsed bool) (expressionResult, error) {
-	values := []string{}
+	leavesLen := len(valueTypeLeaves(instantiation.ValueType()))
+	columns := make([][]string, leavesLen)
 
+	// Slices of structs are stored as one slice per field, therefore collect the values per field.
 	for _, expr := range instantiation.Values() {
-		result, err := t.evaluateExpression(expr, true)
+		values, err := t.evaluateLeafValues(expr)
 
 		if err != nil {
 			return expressionResult{}, err
 		}
-		values = append(values, result.firstValue())
+
+		for i, value := range values {
+			columns[i] = append(columns[i], value)
+		}
 	}
-	s, err := t.converter.SliceInstantiation(values, valueUsed)
+	slices := []string{}
 
-	if err != nil {
-		return expressionResult{}, err
+	for _, column := range columns {
+		s, err := t.converter.SliceInstantiation(column, valueUsed)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		slices = append(slices, s)
 	}
-	return newExpressionResult(s), nil
+	return newExpressionResult(slices...), nil
 }
 
 func (t *transpiler) evaluateInput(input parser.Input, valueUsed bool) (expressionResult, error) {
@@ -681,18 +1435,126 @@ func (t *transpiler) evaluateInput(input parser.Input, valueUsed bool) (expressi
 	return newExpressionResult(s), nil
 }
 
-func (t *transpiler) evaluateCopy(copy parser.Copy, valueUsed bool) (expressionResult, error) {
-	expr, err := t.evaluateExpression(copy.Source(), true)
+func (t *transpiler) evaluateArgs(args parser.Args, valueUsed bool) (expressionResult, error) {
+	s, err := t.converter.Args(valueUsed)
 
 	if err != nil {
 		return expressionResult{}, err
 	}
-	destination := copy.Destination()
-	amount, err := t.converter.Copy(destination.Name(), expr.firstValue(), valueUsed, destination.Global())
+	return newExpressionResult(s), nil
+}
+
+func (t *transpiler) evaluateGetEnv(getEnv parser.GetEnv, valueUsed bool) (expressionResult, error) {
+	key, err := t.evaluateExpression(getEnv.Key(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	s, err := t.converter.EnvGet(key.firstValue(), valueUsed)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(s), nil
+}
+
+func (t *transpiler) evaluateHasEnv(hasEnv parser.HasEnv, valueUsed bool) (expressionResult, error) {
+	key, err := t.evaluateExpression(hasEnv.Key(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	s, err := t.converter.EnvExists(key.firstValue(), valueUsed)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(s), nil
+}
+
+func (t *transpiler) evaluateEnviron(environ parser.Environ, valueUsed bool) (expressionResult, error) {
+	s, err := t.converter.Environ(valueUsed)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(s), nil
+}
+
+func (t *transpiler) evaluateAppend(appendExpr parser.Append, valueUsed bool) (expressionResult, error) {
+	sliceValues, err := t.evaluateLeafValues(appendExpr.Value())
 
 	if err != nil {
 		return expressionResult{}, err
 	}
+	conv := t.converter
+	slices := []string{}
+
+	// Slices of structs are stored as one slice per field, therefore each field slice is appended separately.
+	if appendExpr.Spread() {
+		sources, err := t.evaluateLeafValues(appendExpr.Values()[0])
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+
+		for i, sliceValue := range sliceValues {
+			s, err := conv.AppendSlice(sliceValue, sources[i], appendExpr.InPlace(), valueUsed)
+
+			if err != nil {
+				return expressionResult{}, err
+			}
+			slices = append(slices, s)
+		}
+		return newExpressionResult(slices...), nil
+	}
+	columns := make([][]string, len(sliceValues))
+
+	// Collect the values per field.
+	for _, expr := range appendExpr.Values() {
+		values, err := t.evaluateLeafValues(expr)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+
+		for i, value := range values {
+			columns[i] = append(columns[i], value)
+		}
+	}
+
+	for i, sliceValue := range sliceValues {
+		s, err := conv.Append(sliceValue, columns[i], appendExpr.InPlace(), valueUsed)
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+		slices = append(slices, s)
+	}
+	return newExpressionResult(slices...), nil
+}
+
+func (t *transpiler) evaluateCopy(copy parser.Copy, valueUsed bool) (expressionResult, error) {
+	sources, err := t.evaluateLeafValues(copy.Source())
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	destination := copy.Destination()
+	amount := ""
+
+	// Slices of structs are stored as one slice per field, therefore copy each one.
+	for i, name := range leafNames(destination.Name(), destination.ValueType()) {
+		amountTemp, err := t.converter.Copy(name, sources[i], valueUsed, destination.Global())
+
+		if err != nil {
+			return expressionResult{}, err
+		}
+
+		if i == 0 {
+			amount = amountTemp
+		}
+	}
 	return expressionResult{
 		values: []string{amount},
 	}, nil
@@ -709,6 +1571,106 @@ func (t *transpiler) evaluateItoa(itoa parser.Itoa, valueUsed bool) (expressionR
 	}, nil
 }
 
+func (t *transpiler) evaluateSprintf(sprintf parser.Sprintf, valueUsed bool) (expressionResult, error) {
+	conv := t.converter
+	values := []string{}
+
+	for _, part := range sprintf.Parts() {
+		if part.IsText() {
+			values = append(values, conv.StringToString(part.Text()))
+			continue
+		}
+		expr := part.Value()
+		valueType := expr.ValueType()
+		value := ""
+
+		// Structs are formatted like in Go (e.g. {a 1}).
+		if valueType.IsStruct() {
+			leafValues, err := t.evaluateLeafValues(expr)
+
+			if err != nil {
+				return expressionResult{}, err
+			}
+			value, err = t.structToString(leafValues, valueType)
+
+			if err != nil {
+				return expressionResult{}, err
+			}
+			valueType = parser.NewValueType(parser.DATA_TYPE_STRING, false)
+		} else {
+			result, err := t.evaluateExpression(expr, true)
+
+			if err != nil {
+				return expressionResult{}, err
+			}
+			value = result.firstValue()
+		}
+		verb := part.Verb()
+
+		// Values which don't need to be converted, quoted or padded are used as they are.
+		if valueType.IsBool() || verb.Verb() == 'q' || verb.Width() > 0 {
+			var err error
+			value, err = conv.Format(value, valueType, verb, true)
+
+			if err != nil {
+				return expressionResult{}, err
+			}
+		}
+		values = append(values, value)
+	}
+	s, err := t.concatStrings(values)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(s), nil
+}
+
+func (t *transpiler) evaluateAtoi(atoi parser.Atoi, valueUsed bool) (expressionResult, error) {
+	result, err := t.evaluateExpression(atoi.Value(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	value, atoiErr, err := t.converter.Atoi(result.firstValue(), valueUsed)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(value, atoiErr), nil
+}
+
+func (t *transpiler) evaluateFtoa(ftoa parser.Ftoa, valueUsed bool) (expressionResult, error) {
+	result, err := t.evaluateExpression(ftoa.Value(), true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return expressionResult{
+		values: []string{result.firstValue()},
+	}, nil
+}
+
+func (t *transpiler) evaluateConversion(conversion parser.Conversion, valueUsed bool) (expressionResult, error) {
+	value := conversion.Value()
+	result, err := t.evaluateExpression(value, true)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+
+	// Integers are valid floats, therefore only the conversion from float to int requires the converter.
+	if !value.ValueType().IsFloat() || !conversion.ValueType().IsInt() {
+		return result, nil
+	}
+	s, err := t.converter.FloatToInt(result.firstValue(), valueUsed)
+
+	if err != nil {
+		return expressionResult{}, err
+	}
+	return newExpressionResult(s), nil
+}
+
 func (t *transpiler) evaluateExists(exists parser.Exists, valueUsed bool) (expressionResult, error) {
 	expr, err := t.evaluateExpression(exists.Path(), true)
 
@@ -737,6 +1699,8 @@ func (t *transpiler) evaluateLen(len parser.Len, valueUsed bool) (expressionResu
 
 	if valueType.IsString() {
 		s, err = t.converter.StringLen(result.firstValue(), valueUsed)
+	} else if valueType.IsMap() {
+		s, err = t.converter.MapLen(result.firstValue(), valueUsed)
 	} else {
 		s, err = t.converter.SliceLen(result.firstValue(), valueUsed)
 	}
@@ -783,6 +1747,14 @@ func (t *transpiler) evaluate(statement parser.Statement) error {
 		return t.evaluateVarAssignmentCallAssignment(statement.(parser.VariableAssignmentCallAssignment))
 	case parser.STATEMENT_TYPE_SLICE_ASSIGNMENT:
 		return t.evaluateSliceAssignment(statement.(parser.SliceAssignment))
+	case parser.STATEMENT_TYPE_STRUCT_DEFINITION:
+		return nil // Structs only exist at parse time and are lowered to variables.
+	case parser.STATEMENT_TYPE_STRUCT_ASSIGNMENT:
+		return t.evaluateStructAssignment(statement.(parser.StructAssignment))
+	case parser.STATEMENT_TYPE_MAP_ASSIGNMENT:
+		return t.evaluateMapAssignment(statement.(parser.MapAssignment))
+	case parser.STATEMENT_TYPE_DELETE:
+		return t.evaluateDelete(statement.(parser.Delete))
 	case parser.STATEMENT_TYPE_FUNCTION_DEFINITION:
 		return t.evaluateFunctionDefinition(statement.(parser.FunctionDefinition))
 	case parser.STATEMENT_TYPE_RETURN:
@@ -799,8 +1771,14 @@ func (t *transpiler) evaluate(statement parser.Statement) error {
 		return t.evaluatePrint(statement.(parser.Print))
 	case parser.STATEMENT_TYPE_PANIC:
 		return t.evaluatePanic(statement.(parser.Panic))
+	case parser.STATEMENT_TYPE_EXIT:
+		return t.evaluateExit(statement.(parser.Exit))
 	case parser.STATEMENT_TYPE_WRITE:
 		return t.evaluateWrite(statement.(parser.Write))
+	case parser.STATEMENT_TYPE_SETENV:
+		return t.evaluateSetEnv(statement.(parser.SetEnv))
+	case parser.STATEMENT_TYPE_UNSETENV:
+		return t.evaluateUnsetEnv(statement.(parser.UnsetEnv))
 	default:
 		expression, ok := statement.(parser.Expression)
 
@@ -820,6 +1798,8 @@ func (t *transpiler) evaluateExpression(expression parser.Expression, valueUsed
 		return t.evaluateBooleanLiteral(expression.(parser.BooleanLiteral), valueUsed)
 	case parser.STATEMENT_TYPE_INT_LITERAL:
 		return t.evaluateIntegerLiteral(expression.(parser.IntegerLiteral), valueUsed)
+	case parser.STATEMENT_TYPE_FLOAT_LITERAL:
+		return t.evaluateFloatLiteral(expression.(parser.FloatLiteral), valueUsed)
 	case parser.STATEMENT_TYPE_STRING_LITERAL:
 		return t.evaluateStringLiteral(expression.(parser.StringLiteral), valueUsed)
 	case parser.STATEMENT_TYPE_UNARY_OPERATION:
@@ -834,6 +1814,8 @@ func (t *transpiler) evaluateExpression(expression parser.Expression, valueUsed
 		return t.evaluateVarEvaluation(expression.(parser.VariableEvaluation), valueUsed)
 	case parser.STATEMENT_TYPE_SLICE_EVALUATION:
 		return t.evaluateSliceEvaluation(expression.(parser.SliceEvaluation), valueUsed)
+	case parser.STATEMENT_TYPE_SLICE_RANGE:
+		return t.evaluateSliceRange(expression.(parser.SliceRange), valueUsed)
 	case parser.STATEMENT_TYPE_STRING_SUBSCRIPT:
 		return t.evaluateStringSubscript(expression.(parser.StringSubscript), valueUsed)
 	case parser.STATEMENT_TYPE_GROUP:
@@ -844,14 +1826,44 @@ func (t *transpiler) evaluateExpression(expression parser.Expression, valueUsed
 		return t.evaluateAppCall(expression.(parser.AppCall), valueUsed)
 	case parser.STATEMENT_TYPE_SLICE_INSTANTIATION:
 		return t.evaluateSliceInstantiation(expression.(parser.SliceInstantiation), valueUsed)
+	case parser.STATEMENT_TYPE_STRUCT_INSTANTIATION:
+		return t.evaluateStructInstantiation(expression.(parser.StructInstantiation), valueUsed)
+	case parser.STATEMENT_TYPE_STRUCT_EVALUATION:
+		return t.evaluateStructEvaluation(expression.(parser.StructEvaluation), valueUsed)
+	case parser.STATEMENT_TYPE_MAP_INSTANTIATION:
+		return t.evaluateMapInstantiation(expression.(parser.MapInstantiation), valueUsed)
+	case parser.STATEMENT_TYPE_MAP_EVALUATION:
+		return t.evaluateMapEvaluation(expression.(parser.MapEvaluation), valueUsed)
+	case parser.STATEMENT_TYPE_MAP_LOOKUP:
+		return t.evaluateMapLookup(expression.(parser.MapLookup), valueUsed)
+	case parser.STATEMENT_TYPE_MAP_KEYS:
+		return t.evaluateMapKeys(expression.(parser.MapKeys), valueUsed)
 	case parser.STATEMENT_TYPE_INPUT:
 		return t.evaluateInput(expression.(parser.Input), valueUsed)
+	case parser.STATEMENT_TYPE_ARGS:
+		return t.evaluateArgs(expression.(parser.Args), valueUsed)
+	case parser.STATEMENT_TYPE_GETENV:
+		return t.evaluateGetEnv(expression.(parser.GetEnv), valueUsed)
+	case parser.STATEMENT_TYPE_HASENV:
+		return t.evaluateHasEnv(expression.(parser.HasEnv), valueUsed)
+	case parser.STATEMENT_TYPE_ENVIRON:
+		return t.evaluateEnviron(expression.(parser.Environ), valueUsed)
 	case parser.STATEMENT_TYPE_COPY:
 		return t.evaluateCopy(expression.(parser.Copy), valueUsed)
+	case parser.STATEMENT_TYPE_APPEND:
+		return t.evaluateAppend(expression.(parser.Append), valueUsed)
 	case parser.STATEMENT_TYPE_EXISTS:
 		return t.evaluateExists(expression.(parser.Exists), valueUsed)
 	case parser.STATEMENT_TYPE_ITOA:
 		return t.evaluateItoa(expression.(parser.Itoa), valueUsed)
+	case parser.STATEMENT_TYPE_ATOI:
+		return t.evaluateAtoi(expression.(parser.Atoi), valueUsed)
+	case parser.STATEMENT_TYPE_SPRINTF:
+		return t.evaluateSprintf(expression.(parser.Sprintf), valueUsed)
+	case parser.STATEMENT_TYPE_FTOA:
+		return t.evaluateFtoa(expression.(parser.Ftoa), valueUsed)
+	case parser.STATEMENT_TYPE_CONVERSION:
+		return t.evaluateConversion(expression.(parser.Conversion), valueUsed)
 	case parser.STATEMENT_TYPE_LEN:
 		return t.evaluateLen(expression.(parser.Len), valueUsed)
 	case parser.STATEMENT_TYPE_READ:
@@ -1,98 +1,746 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/monstermichl/typeshell/converters/bash"
 	"github.com/monstermichl/typeshell/converters/batch"
+	"github.com/monstermichl/typeshell/converters/posix"
+	"github.com/monstermichl/typeshell/converters/powershell"
+	"github.com/monstermichl/typeshell/formatter"
+	"github.com/monstermichl/typeshell/interpreter"
+	"github.com/monstermichl/typeshell/lsp"
+	"github.com/monstermichl/typeshell/parser"
 	"github.com/monstermichl/typeshell/transpiler"
 )
 
 const (
-	typeBatch string = "batch"
-	typeBash  string = "bash"
+	typeBatch      string = "batch"
+	typeBash       string = "bash"
+	typePowerShell string = "powershell"
+	typeSh         string = "sh"
 )
 
-var convMapping = map[string]transpiler.Converter{
-	typeBatch: batch.New(),
-	typeBash:  bash.New(),
+const (
+	exitCodeSuccess int = 0
+	exitCodeError   int = 1
+	exitCodeUsage   int = 2
+)
+
+const (
+	stdinArg  = "-"       // Used as input file to read from stdin and as output directory to write to stdout.
+	stdinPath = "<stdin>" // Used to report errors of sources read from stdin.
+)
+
+var version = "dev" // Set at build time (-ldflags "-X main.version=<version>").
+
+// Converters hold the converted code, therefore a new one is created for each conversion.
+var convMapping = map[string]func() transpiler.Converter{
+	typeBatch:      func() transpiler.Converter { return batch.New() },
+	typeBash:       func() transpiler.Converter { return bash.New() },
+	typePowerShell: func() transpiler.Converter { return powershell.New() },
+	typeSh:         func() transpiler.Converter { return posix.New() },
 }
 
-type options struct {
-	in         string
-	out        string
-	converters []transpiler.Converter
+const usage = `TypeShell transpiles Go-like code to Batch, Bash, POSIX sh or PowerShell.
+
+Usage:
+  tsh <command> [options] [files]
+
+Commands:
+  build    Transpile files to scripts.
+  run      Run a file directly without generating a script.
+  check    Check files for errors.
+  vet      Report suspicious code (e.g. unused variables).
+  fmt      Format files.
+  lsp      Start the language server.
+  version  Print the version.
+
+Files are read from stdin if no file or - is provided.
+Run "tsh <command> --help" for more information about a command.
+`
+
+const buildUsage = `Usage:
+  tsh build -t <type> [-t <type>...] [-o <dir>] [-m] [files]
+
+Options:
+  -t, --type <type>  Output type (%s). Can be provided multiple times.
+  -o, --out <dir>    Output directory or - to write a single script to stdout (default ".").
+  -m, --line-map     Write a line map (<script>.map) which maps the script lines to the source rows.
+`
+
+const runUsage = `Usage:
+  tsh run [file] [args...]
+
+All arguments after the file are passed to the program.
+`
+
+const checkUsage = `Usage:
+  tsh check [-j] [files]
+
+Options:
+  -j, --json  Print the diagnostics as JSON to stdout.
+`
+
+const vetUsage = `Usage:
+  tsh vet [-e <rule>...] [-d <rule>...] [-j] [files]
+
+Reports suspicious code which is valid but probably not intended.
+
+Options:
+  -e, --enable <rule>   Only run the rule. Can be provided multiple times.
+  -d, --disable <rule>  Don't run the rule. Can be provided multiple times.
+  -j, --json            Print the findings as JSON to stdout.
+
+Rules:
+  %s
+`
+
+const fmtUsage = `Usage:
+  tsh fmt [-w] [-d] [files]
+
+Prints the formatted files to stdout by default.
+
+Options:
+  -w, --write  Write the result to the files instead of stdout.
+  -d, --diff   Print a diff instead of the formatted files.
+`
+
+const lspUsage = `Usage:
+  tsh lsp [--stdio]
+
+Starts a Language Server Protocol server which communicates via stdin and stdout.
+
+Options:
+  --stdio  Use stdin and stdout (default, accepted for editor compatibility).
+`
+
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ", ")
 }
 
-func parseOptions() options {
-	args := os.Args
-	options := options{}
+func (f *stringsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+type source struct {
+	path    string
+	content string
+}
+
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func main() {
+	c := cli{
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+	}
+	os.Exit(c.run(os.Args[1:]))
+}
+
+func (c cli) run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(c.stderr, usage)
+		return exitCodeUsage
+	}
+	command := args[0]
+
+	switch command {
+	case "build":
+		return c.build(args[1:])
+	case "run":
+		return c.interpret(args[1:])
+	case "check":
+		return c.check(args[1:])
+	case "vet":
+		return c.vet(args[1:])
+	case "fmt":
+		return c.format(args[1:])
+	case "lsp":
+		return c.languageServer(args[1:])
+	case "version":
+		fmt.Fprintf(c.stdout, "tsh %s\n", version)
+		return exitCodeSuccess
+	case "help", "-h", "--help":
+		fmt.Fprint(c.stdout, usage)
+		return exitCodeSuccess
+	}
+
+	// Keep supporting the original invocation without a command (e.g. tsh -i file.tsh -t bash -o .).
+	if strings.HasPrefix(command, "-") && command != stdinArg {
+		return c.build(args)
+	}
+	fmt.Fprintf(c.stderr, "tsh: unknown command %s\n\n%s", command, usage)
+	return exitCodeUsage
+}
+
+func (c cli) build(args []string) int {
 	types := []string{}
+	inputs := stringsFlag{}
+	out := "."
+	lineMap := false
+	flags := c.newFlagSet()
+
+	for _, name := range []string{"t", "type"} {
+		flags.Var((*stringsFlag)(&types), name, "")
+	}
+	for _, name := range []string{"o", "out"} {
+		flags.StringVar(&out, name, out, "")
+	}
+	for _, name := range []string{"i", "in"} {
+		flags.Var(&inputs, name, "")
+	}
+	for _, name := range []string{"m", "line-map"} {
+		flags.BoolVar(&lineMap, name, lineMap, "")
+	}
+	allTypes := []string{}
 
 	for k := range convMapping {
-		types = append(types, k)
+		allTypes = append(allTypes, k)
+	}
+	slices.Sort(allTypes)
+	commandUsage := fmt.Sprintf(buildUsage, strings.Join(allTypes, ", "))
+	positional, code, ok := c.parseFlags(flags, args, commandUsage, true)
+
+	if !ok {
+		return code
 	}
+	inputs = append(inputs, positional...)
 
-	for i := 1; i < (len(args) - 1); i += 2 {
-		cSwitch := args[i]
-		cValue := args[i+1]
+	if len(types) == 0 {
+		return c.usageError("no output type provided (-t/--type)", commandUsage)
+	}
 
-		switch cSwitch {
-		case "-i", "--in":
-			// Make sure input file exists.
-			if stat, err := os.Stat(cValue); err != nil {
-				panic(fmt.Errorf("input file %s doesn't exist", cValue))
-			} else if stat.IsDir() {
-				panic(fmt.Errorf("input %s is not a file", cValue))
+	for _, t := range types {
+		if _, ok := convMapping[t]; !ok {
+			return c.usageError(fmt.Sprintf("unknown output type %s, allowed types are %s", t, strings.Join(allTypes, ", ")), commandUsage)
+		}
+	}
+
+	if lineMap && out == stdinArg {
+		return c.usageError("line maps cannot be written to stdout (-m/--line-map)", commandUsage)
+	}
+	inputCount := max(len(inputs), 1) // Without inputs, stdin is read.
+
+	// Multiple scripts written to stdout couldn't be told apart.
+	if out == stdinArg && inputCount*len(types) != 1 {
+		return c.usageError(fmt.Sprintf("only one script can be written to stdout (-o -) but %d would be written", inputCount*len(types)), commandUsage)
+	}
+	extensions := map[string]int{}
+
+	for _, t := range types {
+		extensions[convMapping[t]().Extension()]++
+	}
+	names := map[string]string{}
+
+	// Make sure the scripts of different inputs don't overwrite each other (e.g. a/test.tsh and b/test.tsh).
+	for _, input := range inputs {
+		name := scriptName(input)
+
+		if other, exists := names[name]; exists {
+			return c.usageError(fmt.Sprintf("inputs %s and %s both write %s scripts, build them separately", other, input, name), commandUsage)
+		}
+		names[name] = input
+	}
+
+	// Make sure output path exists.
+	if out != stdinArg {
+		if stat, err := os.Stat(out); err != nil || !stat.IsDir() {
+			fmt.Fprintf(c.stderr, "tsh: output path %s is not a directory\n", out)
+			return exitCodeError
+		}
+	}
+	sources, err := c.readSources(inputs)
+
+	if err != nil {
+		return c.error(err)
+	}
+	code = exitCodeSuccess
+
+	for _, src := range sources {
+		for _, t := range types {
+			conv := convMapping[t]()
+			tr := transpiler.New()
+			dump, err := tr.TranspileSource(src.path, src.content, conv)
+
+			if err != nil {
+				c.diagnostic(src, err)
+				code = exitCodeError
+				break // All types would report the same error.
 			}
-			options.in = cValue
-		case "-o", "--out":
-			// Make sure output path exists.
-			if stat, err := os.Stat(cValue); err != nil {
-				panic(fmt.Errorf("output path %s doesn't exist", cValue))
-			} else if !stat.IsDir() {
-				panic(fmt.Errorf("output path %s is not a directory", cValue))
+
+			if out == stdinArg {
+				_, err = io.WriteString(c.stdout, dump)
+			} else {
+				extension := conv.Extension()
+
+				// Make sure the scripts of types with the same extension don't overwrite each other (e.g. bash
+				// and sh write test.bash.sh and test.sh.sh).
+				if extensions[extension] > 1 {
+					extension = fmt.Sprintf("%s.%s", t, extension)
+				}
+				script := filepath.Join(out, fmt.Sprintf("%s.%s", scriptName(src.path), extension))
+				err = os.WriteFile(script, []byte(dump), 0777)
+
+				if err == nil && lineMap {
+					err = writeLineMap(fmt.Sprintf("%s.map", script), tr.LineMap())
+				}
 			}
-			options.out = cValue
-		case "-t", "--type":
-			conv, ok := convMapping[cValue]
 
-			if !ok {
-				panic(fmt.Errorf("unknown converter type %s. Allowed types are %s", cValue, strings.Join(types, ", ")))
+			if err != nil {
+				return c.error(err)
 			}
-			options.converters = append(options.converters, conv)
-		default:
-			panic(fmt.Errorf("unknown option %s", cSwitch))
 		}
 	}
+	return code
+}
 
-	if len(options.in) == 0 {
-		panic("no input file provided (-i/--in)")
-	} else if len(options.out) == 0 {
-		panic("no output directory provided (-o/--out)")
-	} else if len(options.converters) == 0 {
-		panic("no output type provided (-t/--type)")
+// scriptName returns the name of the script which is built from the provided input (without extension).
+func scriptName(input string) string {
+	if input == stdinArg || input == stdinPath {
+		return "stdin"
 	}
-	return options
+	name := filepath.Base(input)
+	return name[0 : len(name)-len(filepath.Ext(name))] // Remove extension.
 }
 
-func main() {
-	options := parseOptions()
-	t := transpiler.New()
+// writeLineMap writes the line map as JSON to the provided path.
+func writeLineMap(path string, lineMap transpiler.LineMap) error {
+	content, err := json.Marshal(lineMap)
+
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0666) // End with a newline like the scripts.
+}
+
+func (c cli) interpret(args []string) int {
+	// Flags after the file belong to the program, therefore they are not parsed.
+	positional, code, ok := c.parseFlags(c.newFlagSet(), args, runUsage, false)
+
+	if !ok {
+		return code
+	}
+	programArgs := []string{}
+
+	if len(positional) > 0 {
+		programArgs = positional[1:]
+		positional = positional[:1]
+	}
+	sources, err := c.readSources(positional)
+
+	if err != nil {
+		return c.error(err)
+	}
+	src := sources[0]
+	i := interpreter.New(c.stdin, c.stdout, c.stderr)
+	err = i.RunSource(src.path, src.content, programArgs...)
+
+	if exitErr, ok := err.(interpreter.ExitError); ok {
+		return exitErr.Code()
+	} else if err != nil {
+		c.diagnostic(src, err)
+		return exitCodeError
+	}
+	return exitCodeSuccess
+}
+
+func (c cli) check(args []string) int {
+	printJson := false
+	flags := c.newFlagSet()
+
+	for _, name := range []string{"j", "json"} {
+		flags.BoolVar(&printJson, name, printJson, "")
+	}
+	positional, code, ok := c.parseFlags(flags, args, checkUsage, true)
 
-	for _, conv := range options.converters {
-		in := options.in
-		dump, err := t.Transpile(in, conv)
+	if !ok {
+		return code
+	}
+	return c.report(positional, printJson, func(src source) error {
+		p := parser.New()
+		_, err := p.ParseSource(src.path, src.content)
+
+		return err
+	})
+}
+
+func (c cli) vet(args []string) int {
+	enabled := []string{}
+	disabled := []string{}
+	printJson := false
+	flags := c.newFlagSet()
+
+	for _, name := range []string{"e", "enable"} {
+		flags.Var((*stringsFlag)(&enabled), name, "")
+	}
+	for _, name := range []string{"d", "disable"} {
+		flags.Var((*stringsFlag)(&disabled), name, "")
+	}
+	for _, name := range []string{"j", "json"} {
+		flags.BoolVar(&printJson, name, printJson, "")
+	}
+	allRules := []string{}
+
+	for _, rule := range parser.VetRules() {
+		allRules = append(allRules, string(rule))
+	}
+	commandUsage := fmt.Sprintf(vetUsage, strings.Join(allRules, "\n  "))
+	positional, code, ok := c.parseFlags(flags, args, commandUsage, true)
+
+	if !ok {
+		return code
+	}
+
+	for _, rule := range slices.Concat(enabled, disabled) {
+		if !slices.Contains(allRules, rule) {
+			return c.usageError(fmt.Sprintf("unknown rule %s", rule), commandUsage)
+		}
+	}
+
+	// If rules have been enabled explicitly, only those are run.
+	if len(enabled) == 0 {
+		enabled = allRules
+	}
+	rules := []parser.VetRule{}
+
+	for _, rule := range enabled {
+		if !slices.Contains(disabled, rule) {
+			rules = append(rules, parser.VetRule(rule))
+		}
+	}
+	return c.report(positional, printJson, func(src source) error {
+		p := parser.New()
+		_, err := p.ParseSource(src.path, src.content)
+
+		if err != nil {
+			return err
+		}
+		findings := p.Vet(rules)
+
+		if len(findings) > 0 {
+			return findings
+		}
+		return nil
+	})
+}
+
+// report runs the analysis on all files to report as many diagnostics as possible. The diagnostics are
+// either printed to stderr or collected and printed as JSON to stdout.
+func (c cli) report(files []string, printJson bool, analyze func(src source) error) int {
+	sources, err := c.readSources(files)
+
+	if err != nil {
+		return c.error(err)
+	}
+	code := exitCodeSuccess
+	diagnostics := []jsonDiagnostic{}
+
+	for _, src := range sources {
+		err := analyze(src)
+
+		if err == nil {
+			continue
+		}
+		code = exitCodeError
+
+		if printJson {
+			diagnostics = append(diagnostics, toJsonDiagnostics(src.path, err)...)
+		} else {
+			c.diagnostic(src, err)
+		}
+	}
+
+	if printJson {
+		content, err := json.Marshal(diagnostics)
+
+		if err != nil {
+			return c.error(err)
+		}
+		fmt.Fprintf(c.stdout, "%s\n", content)
+	}
+	return code
+}
+
+func (c cli) format(args []string) int {
+	write := false
+	diff := false
+	flags := c.newFlagSet()
+
+	for _, name := range []string{"w", "write"} {
+		flags.BoolVar(&write, name, write, "")
+	}
+	for _, name := range []string{"d", "diff"} {
+		flags.BoolVar(&diff, name, diff, "")
+	}
+	positional, code, ok := c.parseFlags(flags, args, fmtUsage, true)
+
+	if !ok {
+		return code
+	}
+	if write && (len(positional) == 0 || slices.Contains(positional, stdinArg)) {
+		return c.usageError("stdin cannot be written (-w/--write)", fmtUsage)
+	}
+	sources, err := c.readSources(positional)
+
+	if err != nil {
+		return c.error(err)
+	}
+	code = exitCodeSuccess
+
+	// Format all files to report as many errors as possible.
+	for _, src := range sources {
+		formatted, err := formatter.Format(src.content)
+
+		if err != nil {
+			c.diagnostic(src, err)
+			code = exitCodeError
+			continue
+		}
+
+		if diff {
+			_, err = io.WriteString(c.stdout, formatter.Diff(src.path, src.content, formatted))
+		} else if !write {
+			_, err = io.WriteString(c.stdout, formatted)
+		}
+
+		// Only touch files which have changed.
+		if err == nil && write && formatted != src.content {
+			var stat os.FileInfo
+			stat, err = os.Stat(src.path)
+
+			if err == nil {
+				err = os.WriteFile(src.path, []byte(formatted), stat.Mode().Perm())
+			}
+		}
+
+		if err != nil {
+			return c.error(err)
+		}
+	}
+	return code
+}
+
+func (c cli) languageServer(args []string) int {
+	stdio := true
+	flags := c.newFlagSet()
+
+	flags.BoolVar(&stdio, "stdio", stdio, "")
+	positional, code, ok := c.parseFlags(flags, args, lspUsage, true)
+
+	if !ok {
+		return code
+	}
+	if len(positional) > 0 {
+		return c.usageError("lsp doesn't accept files", lspUsage)
+	}
+	s := lsp.New(c.stdin, c.stdout)
+	err := s.Run()
+
+	if err != nil {
+		return c.error(err)
+	}
+	return exitCodeSuccess
+}
+
+func (c cli) newFlagSet() *flag.FlagSet {
+	flags := flag.NewFlagSet("tsh", flag.ContinueOnError)
+
+	// Errors and usage are printed by the CLI itself.
+	flags.SetOutput(io.Discard)
+	flags.Usage = func() {}
+
+	return flags
+}
+
+// parseFlags parses the flags and returns the positional arguments. If interspersed is true, flags
+// and positional arguments can be mixed (e.g. tsh build file.tsh -t bash). Otherwise, parsing stops
+// at the first positional argument.
+func (c cli) parseFlags(flags *flag.FlagSet, args []string, commandUsage string, interspersed bool) ([]string, int, bool) {
+	positional := []string{}
+
+	for {
+		err := flags.Parse(args)
+
+		if errors.Is(err, flag.ErrHelp) {
+			fmt.Fprint(c.stdout, commandUsage)
+			return nil, exitCodeSuccess, false
+		} else if err != nil {
+			return nil, c.usageError(err.Error(), commandUsage), false
+		}
+		args = flags.Args()
+
+		if !interspersed {
+			positional = args
+			break
+		} else if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	return positional, exitCodeSuccess, true
+}
+
+// readSources reads the provided files. If no file is provided, stdin is read.
+func (c cli) readSources(files []string) ([]source, error) {
+	if len(files) == 0 {
+		files = []string{stdinArg}
+	}
+	sources := []source{}
+	stdinRead := false
+
+	for _, file := range files {
+		var content []byte
+		var err error
+		path := file
+
+		if file == stdinArg {
+			if stdinRead {
+				return nil, errors.New("stdin can only be read once")
+			}
+			content, err = io.ReadAll(c.stdin)
+			path = stdinPath
+			stdinRead = true
+		} else {
+			content, err = os.ReadFile(file)
+		}
 
 		if err != nil {
-			panic(err)
+			return nil, err
+		}
+		sources = append(sources, source{
+			path:    path,
+			content: string(content),
+		})
+	}
+	return sources, nil
+}
+
+func (c cli) usageError(message string, commandUsage string) int {
+	fmt.Fprintf(c.stderr, "tsh: %s\n\n%s", message, commandUsage)
+	return exitCodeUsage
+}
+
+func (c cli) error(err error) int {
+	fmt.Fprintf(c.stderr, "tsh: %s\n", err)
+	return exitCodeError
+}
+
+// diagnostic prints the diagnostics of the error in the form file:row:column: severity: message [code],
+// followed by an excerpt of the source which marks the affected code. If the error doesn't provide a
+// position, it's printed in the form file: severity: message [code].
+func (c cli) diagnostic(src source, err error) {
+	for _, d := range parser.ErrorDiagnostics(src.path, err) {
+		path := displayPath(d.Path())
+
+		if d.Row() == 0 {
+			fmt.Fprintf(c.stderr, "%s: %s: %s [%s]\n", path, d.Severity(), d.Message(), d.Code())
+			continue
+		}
+		fmt.Fprintf(c.stderr, "%s:%d:%d: %s: %s [%s]\n", path, d.Row(), d.Column(), d.Severity(), d.Message(), d.Code())
+		content := src.content
+
+		// Diagnostics of imported files refer to other files.
+		if filepath.Clean(d.Path()) != filepath.Clean(src.path) {
+			contentTemp, err := os.ReadFile(d.Path())
+
+			if err != nil {
+				continue
+			}
+			content = string(contentTemp)
 		}
-		file := filepath.Base(in)
-		file = file[0 : len(file)-len(filepath.Ext(in))] // Remove extension.
+		fmt.Fprint(c.stderr, excerpt(content, d))
+	}
+}
+
+// jsonDiagnostic is the JSON representation of a diagnostic.
+type jsonDiagnostic struct {
+	Path      string `json:"path"`
+	Row       int    `json:"row"`
+	Column    int    `json:"column"`
+	EndRow    int    `json:"endRow"`
+	EndColumn int    `json:"endColumn"`
+	Severity  string `json:"severity"`
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+}
 
-		os.WriteFile(filepath.Join(options.out, fmt.Sprintf("%s.%s", file, conv.Extension())), []byte(dump), 0777)
+func toJsonDiagnostics(path string, err error) []jsonDiagnostic {
+	diagnostics := []jsonDiagnostic{}
+
+	for _, d := range parser.ErrorDiagnostics(path, err) {
+		diagnostics = append(diagnostics, jsonDiagnostic{
+			Path:      d.Path(),
+			Row:       d.Row(),
+			Column:    d.Column(),
+			EndRow:    d.EndRow(),
+			EndColumn: d.EndColumn(),
+			Severity:  string(d.Severity()),
+			Code:      string(d.Code()),
+			Message:   d.Message(),
+		})
 	}
+	return diagnostics
+}
+
+// displayPath makes absolute paths (e.g. of imported files) relative to the working directory if possible.
+func displayPath(path string) string {
+	if filepath.IsAbs(path) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, path); err == nil && !strings.HasPrefix(rel, "..") {
+				return rel
+			}
+		}
+	}
+	return path
+}
+
+// excerpt returns the row of the diagnostic and a line which marks the span (e.g. ^~~). Tabs are kept
+// to make sure the marker is aligned with the code.
+func excerpt(content string, d parser.Diagnostic) string {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	row := d.Row()
+
+	if row < 1 || row > len(lines) {
+		return ""
+	}
+	line := lines[row-1]
+	start := min(max(d.Column()-1, 0), len(line))
+	end := len(line)
+
+	if d.EndRow() == row {
+		end = min(max(d.EndColumn()-1, start), len(line))
+	}
+	indent := ""
+
+	for _, r := range line[:start] {
+		if r == '\t' {
+			indent += "\t"
+		} else {
+			indent += " "
+		}
+	}
+	marker := "^" + strings.Repeat("~", max(utf8.RuneCountInString(line[start:end])-1, 0))
+	gutter := strings.Repeat(" ", len(strconv.Itoa(row)))
+
+	return fmt.Sprintf("%d | %s\n%s | %s%s\n", row, line, gutter, indent, marker)
 }
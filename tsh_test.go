@@ -0,0 +1,375 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/monstermichl/typeshell/transpiler"
+	"github.com/stretchr/testify/require"
+)
+
+func runTestCli(t *testing.T, stdin string, args ...string) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+
+	c := cli{
+		stdin:  strings.NewReader(stdin),
+		stdout: &stdout,
+		stderr: &stderr,
+	}
+	code := c.run(args)
+	return stdout.String(), stderr.String(), code
+}
+
+func writeTestFile(t *testing.T, name string, content string) string {
+	file := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(file, []byte(content), 0700)
+
+	require.Nil(t, err)
+	return file
+}
+
+func TestCliBuildToStdout(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", `print("Hello World")`)
+	stdout, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-o", "-", file)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Contains(t, stdout, "Hello World")
+}
+
+func TestCliBuildFromStdin(t *testing.T) {
+	stdout, stderr, code := runTestCli(t, `print("Hello World")`, "build", "-o", "-", "--type", "sh")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Contains(t, stdout, "Hello World")
+}
+
+func TestCliBuildMultipleFilesSuccess(t *testing.T) {
+	out := t.TempDir()
+	file1 := writeTestFile(t, "test1.tsh", `print("Hello")`)
+	file2 := writeTestFile(t, "test2.tsh", `print("World")`)
+	_, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-t", "batch", "-o", out, file1, file2)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+
+	for _, name := range []string{"test1.sh", "test1.bat", "test2.sh", "test2.bat"} {
+		require.FileExists(t, filepath.Join(out, name))
+	}
+}
+
+func TestCliBuildLegacyOptionsSuccess(t *testing.T) {
+	out := t.TempDir()
+	file := writeTestFile(t, "test.tsh", `print("Hello World")`)
+	_, stderr, code := runTestCli(t, "", "-i", file, "-t", "bash", "-o", out)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.FileExists(t, filepath.Join(out, "test.sh"))
+}
+
+func TestCliBuildLineMapSuccess(t *testing.T) {
+	out := t.TempDir()
+	file := writeTestFile(t, "test.tsh", "print(\"Hello\")\nprint(\"World\")")
+	_, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-m", "-o", out, file)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+
+	content, err := os.ReadFile(filepath.Join(out, "test.sh.map"))
+	require.Nil(t, err)
+	require.True(t, strings.HasSuffix(string(content), "}\n"))
+
+	lineMap := transpiler.LineMap{}
+	err = json.Unmarshal(content, &lineMap)
+
+	require.Nil(t, err)
+	require.Len(t, lineMap.Mappings, 2)
+	require.Equal(t, 2, lineMap.Mappings[1].Row)
+	require.Equal(t, file, lineMap.Mappings[1].Path)
+}
+
+func TestCliBuildLineMapToStdoutFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, `print("Hello World")`, "build", "-t", "bash", "-o", "-", "--line-map")
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "line maps cannot be written to stdout")
+}
+
+func TestCliBuildMultipleTypesSuccess(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", `print("Hello World")`)
+	out := t.TempDir()
+	_, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-t", "batch", "-t", "powershell", "-o", out, file)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+
+	for _, script := range []string{"test.sh", "test.bat", "test.ps1"} {
+		require.FileExists(t, filepath.Join(out, script))
+	}
+}
+
+func TestCliBuildSameExtensionSuccess(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", `print("Hello World")`)
+	out := t.TempDir()
+	_, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-t", "sh", "-t", "batch", "-o", out, file)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.NoFileExists(t, filepath.Join(out, "test.sh"))
+
+	for _, script := range []string{"test.bash.sh", "test.sh.sh", "test.bat"} {
+		require.FileExists(t, filepath.Join(out, script))
+	}
+	content, err := os.ReadFile(filepath.Join(out, "test.sh.sh"))
+
+	require.Nil(t, err)
+	require.True(t, strings.HasPrefix(string(content), "#!/bin/sh"))
+}
+
+func TestCliBuildSameNameFail(t *testing.T) {
+	file1 := writeTestFile(t, "test.tsh", `print("Hello")`)
+	file2 := writeTestFile(t, "test.tsh", `print("World")`)
+	out := t.TempDir()
+	_, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-o", out, file1, file2)
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "both write test scripts")
+	require.NoFileExists(t, filepath.Join(out, "test.sh"))
+}
+
+func TestCliBuildMultipleTypesToStdoutFail(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", `print("Hello World")`)
+	stdout, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-t", "batch", "-o", "-", file)
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "only one script can be written to stdout (-o -) but 2 would be written")
+	require.Empty(t, stdout)
+}
+
+func TestCliBuildMultipleFilesToStdoutFail(t *testing.T) {
+	file1 := writeTestFile(t, "test1.tsh", `print("Hello")`)
+	file2 := writeTestFile(t, "test2.tsh", `print("World")`)
+	stdout, stderr, code := runTestCli(t, "", "build", "-t", "bash", "-o", "-", file1, file2)
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "only one script can be written to stdout (-o -) but 2 would be written")
+	require.Empty(t, stdout)
+}
+
+func TestCliBuildUnknownTypeFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, `print("Hello World")`, "build", "-t", "cmd", "-o", "-")
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "unknown output type cmd")
+}
+
+func TestCliCheckDiagnosticFail(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", "print(\"Hello World\")\nvar a int = \"1\"")
+	_, stderr, code := runTestCli(t, "", "check", file)
+
+	require.Equal(t, exitCodeError, code)
+	require.Equal(t, file+":2:13: error: expected int but got string [semantic]\n2 | var a int = \"1\"\n  |             ^~~\n", stderr)
+}
+
+func TestCliCheckStdinDiagnosticFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, `a := 1 +`, "check", "-")
+
+	require.Equal(t, exitCodeError, code)
+	require.True(t, strings.HasPrefix(stderr, stdinPath+":1:"))
+}
+
+func TestCliCheckMultipleDiagnosticsFail(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", "a := b\nprint(c)\nprint(a)")
+	_, stderr, code := runTestCli(t, "", "check", file)
+
+	require.Equal(t, exitCodeError, code)
+	require.Equal(t, file+":1:6: error: variable b has not been defined [semantic]\n1 | a := b\n  |      ^\n"+
+		file+":2:7: error: variable c has not been defined [semantic]\n2 | print(c)\n  |       ^\n"+
+		file+":3:7: error: variable a has not been defined [semantic]\n3 | print(a)\n  |       ^\n", stderr)
+}
+
+func TestCliCheckJsonFail(t *testing.T) {
+	stdout, stderr, code := runTestCli(t, "a := 1\nprint(b)", "check", "--json", "-")
+
+	require.Equal(t, exitCodeError, code)
+	require.Empty(t, stderr)
+	require.JSONEq(t, `[{
+		"path": "<stdin>",
+		"row": 2,
+		"column": 7,
+		"endRow": 2,
+		"endColumn": 8,
+		"severity": "error",
+		"code": "semantic",
+		"message": "variable b has not been defined"
+	}]`, stdout)
+}
+
+func TestCliCheckImportDiagnosticFail(t *testing.T) {
+	lib := writeTestFile(t, "lib.tsh", "func Foo() {\n\tprint(b)\n}")
+	file := writeTestFile(t, "test.tsh", "import (\n\tlib \""+filepath.ToSlash(lib)+"\"\n)\n\nlib.Foo()")
+	_, stderr, code := runTestCli(t, "", "check", file)
+
+	require.Equal(t, exitCodeError, code)
+	require.Equal(t, lib+":2:8: error: variable b has not been defined [semantic]\n2 | \tprint(b)\n  | \t      ^\n", stderr)
+}
+
+func TestCliVetSuccess(t *testing.T) {
+	stdout, stderr, code := runTestCli(t, "a := 1\nprint(a)", "vet")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stdout)
+	require.Empty(t, stderr)
+}
+
+func TestCliVetFindingsFail(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", "func f() {\n\ta := 1\n}\nf()\n@echo(\"a\")")
+	_, stderr, code := runTestCli(t, "", "vet", file)
+
+	require.Equal(t, exitCodeError, code)
+	require.Equal(t, file+":2:2: warning: variable a is not used [unused-variable]\n2 | \ta := 1\n  | \t^\n"+
+		file+":5:1: warning: exit code of @echo is not checked [ignored-exit-code]\n5 | @echo(\"a\")\n  | ^\n", stderr)
+}
+
+func TestCliVetDisableSuccess(t *testing.T) {
+	_, stderr, code := runTestCli(t, "@echo(\"a\")", "vet", "-d", "ignored-exit-code")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+}
+
+func TestCliVetEnableJsonFail(t *testing.T) {
+	stdout, stderr, code := runTestCli(t, "func f() {\n\ta := 1\n}\nf()\n@echo(\"a\")", "vet", "-e", "unused-variable", "--json")
+
+	require.Equal(t, exitCodeError, code)
+	require.Empty(t, stderr)
+	require.JSONEq(t, `[{
+		"path": "<stdin>",
+		"row": 2,
+		"column": 2,
+		"endRow": 2,
+		"endColumn": 3,
+		"severity": "warning",
+		"code": "unused-variable",
+		"message": "variable a is not used"
+	}]`, stdout)
+}
+
+func TestCliVetUnknownRuleFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, "print(1)", "vet", "-d", "unknown")
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "unknown rule unknown")
+}
+
+func TestCliVetParseErrorFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, "print(a)", "vet")
+
+	require.Equal(t, exitCodeError, code)
+	require.Contains(t, stderr, "error: variable a has not been defined [semantic]")
+}
+
+func TestCliRunSuccess(t *testing.T) {
+	stdout, stderr, code := runTestCli(t, `print("Hello World")`, "run")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Equal(t, "Hello World\n", stdout)
+}
+
+func TestCliRunArgsSuccess(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", `print(len(args()), args()[1])`)
+	stdout, stderr, code := runTestCli(t, "", "run", file, "-a", "b")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Equal(t, "2 b\n", stdout)
+}
+
+func TestCliRunPanicFail(t *testing.T) {
+	_, _, code := runTestCli(t, `panic("error")`, "run", "-")
+
+	require.Equal(t, exitCodeError, code)
+}
+
+func TestCliFmtToStdoutSuccess(t *testing.T) {
+	stdout, stderr, code := runTestCli(t, "a:=1\nif a>0{\nprint(a)\n}", "fmt")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Equal(t, "a := 1\nif a > 0 {\n\tprint(a)\n}\n", stdout)
+}
+
+func TestCliFmtWriteSuccess(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", "a:=1\n")
+	stdout, stderr, code := runTestCli(t, "", "fmt", "-w", file)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Empty(t, stdout)
+
+	content, err := os.ReadFile(file)
+
+	require.Nil(t, err)
+	require.Equal(t, "a := 1\n", string(content))
+}
+
+func TestCliFmtDiffSuccess(t *testing.T) {
+	file := writeTestFile(t, "test.tsh", "a := 1\nb:=2\n")
+	stdout, stderr, code := runTestCli(t, "", "fmt", "--diff", file)
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Equal(t, "diff "+file+".orig "+file+"\n--- "+file+".orig\n+++ "+file+"\n@@ -1,2 +1,2 @@\n a := 1\n-b:=2\n+b := 2\n", stdout)
+}
+
+func TestCliFmtWriteStdinFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, "a:=1", "fmt", "-w")
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "stdin cannot be written")
+}
+
+func TestCliFmtDiagnosticFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, `a := "`, "fmt")
+
+	require.Equal(t, exitCodeError, code)
+	require.Equal(t, stdinPath+":1:6: error: string has not been terminated [lexical]\n1 | a := \"\n  |      ^\n", stderr)
+}
+
+func TestCliLspSuccess(t *testing.T) {
+	stdin := "Content-Length: 44\r\n\r\n{\"jsonrpc\":\"2.0\",\"id\":1,\"method\":\"shutdown\"}" +
+		"Content-Length: 33\r\n\r\n{\"jsonrpc\":\"2.0\",\"method\":\"exit\"}"
+	stdout, stderr, code := runTestCli(t, stdin, "lsp", "--stdio")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Empty(t, stderr)
+	require.Equal(t, "Content-Length: 38\r\n\r\n{\"jsonrpc\":\"2.0\",\"id\":1,\"result\":null}", stdout)
+}
+
+func TestCliLspFilesFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, "", "lsp", "test.tsh")
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "lsp doesn't accept files")
+}
+
+func TestCliVersionSuccess(t *testing.T) {
+	stdout, _, code := runTestCli(t, "", "version")
+
+	require.Equal(t, exitCodeSuccess, code)
+	require.Equal(t, "tsh dev\n", stdout)
+}
+
+func TestCliUnknownCommandFail(t *testing.T) {
+	_, stderr, code := runTestCli(t, "", "transpile")
+
+	require.Equal(t, exitCodeUsage, code)
+	require.Contains(t, stderr, "unknown command transpile")
+}